@@ -2,6 +2,10 @@ package stripe
 
 import (
 	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/stripe/stripe-go/form"
 )
@@ -22,6 +26,27 @@ type IdentityVerificationDetailsCode string
 // Allowed values are "pending", "verified", "unverified".
 type IdentityVerificationStatus string
 
+// AccountBusinessType describes the business type of an account.
+// Allowed values are "company", "government_entity", "individual",
+// "non_profit".
+type AccountBusinessType string
+
+// AccountCapabilityStatus describes the status of a capability of an
+// account. Allowed values are "active", "disabled", "inactive", "pending",
+// "unrequested".
+type AccountCapabilityStatus string
+
+// AccountRequirementsDisabledReason describes why an account can't create
+// charges or receive payouts. Allowed values include "listed",
+// "rejected.fraud", "rejected.listed", "rejected.other",
+// "rejected.terms_of_service", "requirements.past_due",
+// "requirements.pending_verification", "under_review".
+type AccountRequirementsDisabledReason string
+
+// AccountRejectReason describes why a platform rejected one of its accounts.
+// Allowed values are "fraud", "other", "terms_of_service".
+type AccountRejectReason string
+
 // Interval describes the payout interval.
 // Allowed values are "manual", "daily", "weekly", "monthly".
 type Interval string
@@ -59,11 +84,26 @@ const (
 	Week Interval = "weekly"
 )
 
+// AccountBusinessProfileParams are the parameters allowed for the business
+// profile of an account.
+type AccountBusinessProfileParams struct {
+	MCC          string         `form:"mcc"`
+	Name         string         `form:"name"`
+	ProductDesc  string         `form:"product_description"`
+	SupportAddr  *AddressParams `form:"support_address"`
+	SupportEmail string         `form:"support_email"`
+	SupportPhone string         `form:"support_phone"`
+	SupportURL   string         `form:"support_url"`
+	URL          string         `form:"url"`
+}
+
 // AccountParams are the parameters allowed during account creation/updates.
 type AccountParams struct {
 	Params               `form:"*"`
 	BusinessName         string                        `form:"business_name"`
 	BusinessPrimaryColor string                        `form:"business_primary_color"`
+	BusinessProfile      *AccountBusinessProfileParams `form:"business_profile"`
+	BusinessType         AccountBusinessType           `form:"business_type"`
 	BusinessUrl          string                        `form:"business_url"`
 	Country              string                        `form:"country"`
 	DebitNegativeBal     bool                          `form:"debit_negative_balances"`
@@ -75,6 +115,7 @@ type AccountParams struct {
 	NoDebitNegativeBal   bool                          `form:"debit_negative_balances,invert"`
 	PayoutSchedule       *PayoutScheduleParams         `form:"payout_schedule"`
 	PayoutStatement      string                        `form:"payout_statement_descriptor"`
+	RequestedCaps        []string                      `form:"requested_capabilities"`
 	Statement            string                        `form:"statement_descriptor"`
 	SupportEmail         string                        `form:"support_email"`
 	SupportPhone         string                        `form:"support_phone"`
@@ -85,7 +126,9 @@ type AccountParams struct {
 
 // AccountListParams are the parameters allowed during account listing.
 type AccountListParams struct {
-	ListParams `form:"*"`
+	ListParams   `form:"*"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
 }
 
 // AccountExternalAccountParams are the parameters allowed to reference an
@@ -117,22 +160,58 @@ func (p *PayoutScheduleParams) AppendTo(body *form.Values, keyParts []string) {
 	}
 }
 
+// AccountBusinessProfile is the public information about the business of an
+// account, shown to its customers.
+type AccountBusinessProfile struct {
+	MCC          string   `json:"mcc"`
+	Name         string   `json:"name"`
+	ProductDesc  string   `json:"product_description"`
+	SupportAddr  *Address `json:"support_address"`
+	SupportEmail string   `json:"support_email"`
+	SupportPhone string   `json:"support_phone"`
+	SupportURL   string   `json:"support_url"`
+	URL          string   `json:"url"`
+}
+
+// AccountRequirements describes the information that an account needs to
+// provide to keep its capabilities enabled. Each field holds the names of
+// fields of the account or of its persons, like "business_profile.url".
+type AccountRequirements struct {
+	// CurrentDeadline is the time by which CurrentlyDue must be provided to
+	// keep the account enabled.
+	CurrentDeadline Timestamp `json:"current_deadline"`
+
+	CurrentlyDue        []string                          `json:"currently_due"`
+	DisabledReason      AccountRequirementsDisabledReason `json:"disabled_reason"`
+	EventuallyDue       []string                          `json:"eventually_due"`
+	PastDue             []string                          `json:"past_due"`
+	PendingVerification []string                          `json:"pending_verification"`
+}
+
 // Account is the resource representing your Stripe account.
 // For more details see https://stripe.com/docs/api/#account.
 type Account struct {
-	BusinessLogo         string               `json:"business_logo"`
-	BusinessName         string               `json:"business_name"`
-	BusinessPrimaryColor string               `json:"business_primary_color"`
-	BusinessUrl          string               `json:"business_url"`
-	ChargesEnabled       bool                 `json:"charges_enabled"`
-	Country              string               `json:"country"`
-	DebitNegativeBal     bool                 `json:"debit_negative_balances"`
-	DefaultCurrency      string               `json:"default_currency"`
-	Deleted              bool                 `json:"deleted"`
-	DetailsSubmitted     bool                 `json:"details_submitted"`
-	Email                string               `json:"email"`
-	ExternalAccounts     *ExternalAccountList `json:"external_accounts"`
-	ID                   string               `json:"id"`
+	APIResource
+	BusinessLogo         string                  `json:"business_logo"`
+	BusinessName         string                  `json:"business_name"`
+	BusinessPrimaryColor string                  `json:"business_primary_color"`
+	BusinessProfile      *AccountBusinessProfile `json:"business_profile"`
+	BusinessType         AccountBusinessType     `json:"business_type"`
+	BusinessUrl          string                  `json:"business_url"`
+
+	// Capabilities maps the name of each capability requested for the
+	// account, like "card_payments", to its status.
+	Capabilities map[string]AccountCapabilityStatus `json:"capabilities"`
+
+	ChargesEnabled   bool                 `json:"charges_enabled"`
+	Country          string               `json:"country"`
+	DebitNegativeBal bool                 `json:"debit_negative_balances"`
+	DefaultCurrency  string               `json:"default_currency"`
+	Deleted          bool                 `json:"deleted"`
+	DetailsSubmitted bool                 `json:"details_submitted"`
+	Email            string               `json:"email"`
+	ExternalAccounts *ExternalAccountList `json:"external_accounts"`
+	ID               string               `json:"id"`
 
 	Keys *struct {
 		Publish string `json:"publishable"`
@@ -146,25 +225,35 @@ type Account struct {
 	PayoutStatement string            `json:"payout_statement_descriptor"`
 	PayoutsEnabled  bool              `json:"payouts_enabled"`
 	ProductDesc     string            `json:"product_description"`
-	Statement       string            `json:"statement_descriptor"`
-	SupportAddress  *Address          `json:"support_address"`
-	SupportEmail    string            `json:"support_email"`
-	SupportPhone    string            `json:"support_phone"`
-	SupportUrl      string            `json:"support_url"`
-	Timezone        string            `json:"timezone"`
+
+	// FutureRequirements is the information that the account will need to
+	// provide once upcoming requirements take effect, so that it can be
+	// collected before the account gets disabled.
+	FutureRequirements *AccountRequirements `json:"future_requirements"`
+
+	// Requirements is the information that the account needs to provide.
+	// It replaces Verification on recent API versions.
+	Requirements *AccountRequirements `json:"requirements"`
+
+	Statement      string   `json:"statement_descriptor"`
+	SupportAddress *Address `json:"support_address"`
+	SupportEmail   string   `json:"support_email"`
+	SupportPhone   string   `json:"support_phone"`
+	SupportUrl     string   `json:"support_url"`
+	Timezone       string   `json:"timezone"`
 
 	TOSAcceptance *struct {
-		Date      int64  `json:"date"`
-		IP        string `json:"ip"`
-		UserAgent string `json:"user_agent"`
+		Date      Timestamp `json:"date"`
+		IP        string    `json:"ip"`
+		UserAgent string    `json:"user_agent"`
 	} `json:"tos_acceptance"`
 
 	Type AccountType
 
 	Verification *struct {
-		DisabledReason string   `json:"disabled_reason"`
-		Due            *int64   `json:"due_by"`
-		Fields         []string `json:"fields_needed"`
+		DisabledReason string     `json:"disabled_reason"`
+		Due            *Timestamp `json:"due_by"`
+		Fields         []string   `json:"fields_needed"`
 	} `json:"verification"`
 }
 
@@ -172,15 +261,19 @@ type Account struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an ID or the full struct if it was expanded.
 func (a *Account) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		a.ID = id
+		return nil
+	}
+
 	type account Account
 	var aa account
 	err := json.Unmarshal(data, &aa)
-	if err == nil {
-		*a = Account(aa)
-	} else {
-		// the ID is surrounded by "\" characters, so strip them
-		a.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
+
+	*a = Account(aa)
 	return nil
 }
 
@@ -211,8 +304,105 @@ const (
 	AccountTypeStandard AccountType = "standard"
 )
 
+const (
+	// AccountBusinessTypeCompany is a constant value representing a company.
+	AccountBusinessTypeCompany AccountBusinessType = "company"
+
+	// AccountBusinessTypeGovernmentEntity is a constant value representing a
+	// government entity.
+	AccountBusinessTypeGovernmentEntity AccountBusinessType = "government_entity"
+
+	// AccountBusinessTypeIndividual is a constant value representing an
+	// individual.
+	AccountBusinessTypeIndividual AccountBusinessType = "individual"
+
+	// AccountBusinessTypeNonProfit is a constant value representing a non
+	// profit organization.
+	AccountBusinessTypeNonProfit AccountBusinessType = "non_profit"
+)
+
+const (
+	// AccountCapabilityStatusActive is a constant value representing a
+	// capability that can be used.
+	AccountCapabilityStatusActive AccountCapabilityStatus = "active"
+
+	// AccountCapabilityStatusDisabled is a constant value representing a
+	// capability that was disabled by Stripe.
+	AccountCapabilityStatusDisabled AccountCapabilityStatus = "disabled"
+
+	// AccountCapabilityStatusInactive is a constant value representing a
+	// capability that can't be used.
+	AccountCapabilityStatusInactive AccountCapabilityStatus = "inactive"
+
+	// AccountCapabilityStatusPending is a constant value representing a
+	// capability waiting for the account to be verified.
+	AccountCapabilityStatusPending AccountCapabilityStatus = "pending"
+
+	// AccountCapabilityStatusUnrequested is a constant value representing a
+	// capability that wasn't requested for the account.
+	AccountCapabilityStatusUnrequested AccountCapabilityStatus = "unrequested"
+)
+
+const (
+	// AccountRejectReasonFraud is a constant value representing an account
+	// rejected because it's suspected of fraud.
+	AccountRejectReasonFraud AccountRejectReason = "fraud"
+
+	// AccountRejectReasonOther is a constant value representing an account
+	// rejected for another reason.
+	AccountRejectReasonOther AccountRejectReason = "other"
+
+	// AccountRejectReasonTermsOfService is a constant value representing an
+	// account rejected because it violated the terms of service.
+	AccountRejectReasonTermsOfService AccountRejectReason = "terms_of_service"
+)
+
+const (
+	// AccountRequirementsDisabledReasonListed is a constant value
+	// representing an account disabled because it matched a third-party
+	// prohibited persons or companies list, pending review.
+	AccountRequirementsDisabledReasonListed AccountRequirementsDisabledReason = "listed"
+
+	// AccountRequirementsDisabledReasonOther is a constant value representing
+	// an account disabled for another reason.
+	AccountRequirementsDisabledReasonOther AccountRequirementsDisabledReason = "other"
+
+	// AccountRequirementsDisabledReasonRejectedFraud is a constant value
+	// representing an account rejected because it's suspected of fraud.
+	AccountRequirementsDisabledReasonRejectedFraud AccountRequirementsDisabledReason = "rejected.fraud"
+
+	// AccountRequirementsDisabledReasonRejectedListed is a constant value
+	// representing an account rejected because it's on a third-party
+	// prohibited persons or companies list.
+	AccountRequirementsDisabledReasonRejectedListed AccountRequirementsDisabledReason = "rejected.listed"
+
+	// AccountRequirementsDisabledReasonRejectedOther is a constant value
+	// representing an account rejected for another reason.
+	AccountRequirementsDisabledReasonRejectedOther AccountRequirementsDisabledReason = "rejected.other"
+
+	// AccountRequirementsDisabledReasonRejectedTermsOfService is a constant
+	// value representing an account rejected because it violated the terms
+	// of service.
+	AccountRequirementsDisabledReasonRejectedTermsOfService AccountRequirementsDisabledReason = "rejected.terms_of_service"
+
+	// AccountRequirementsDisabledReasonRequirementsPastDue is a constant
+	// value representing an account disabled because some of its
+	// requirements are past due.
+	AccountRequirementsDisabledReasonRequirementsPastDue AccountRequirementsDisabledReason = "requirements.past_due"
+
+	// AccountRequirementsDisabledReasonRequirementsPendingVerification is a
+	// constant value representing an account disabled while the information
+	// it provided is being verified.
+	AccountRequirementsDisabledReasonRequirementsPendingVerification AccountRequirementsDisabledReason = "requirements.pending_verification"
+
+	// AccountRequirementsDisabledReasonUnderReview is a constant value
+	// representing an account disabled while Stripe reviews it for risk.
+	AccountRequirementsDisabledReasonUnderReview AccountRequirementsDisabledReason = "under_review"
+)
+
 // AccountList is a list of accounts as returned from a list endpoint.
 type AccountList struct {
+	APIResource
 	ListMeta
 	Values []*Account `json:"data"`
 }
@@ -220,6 +410,7 @@ type AccountList struct {
 // ExternalAccountList is a list of external accounts that may be either bank
 // accounts or cards.
 type ExternalAccountList struct {
+	APIResource
 	ListMeta
 
 	// Values contains any external accounts (bank accounts and/or cards)
@@ -348,9 +539,9 @@ type IdentityVerification struct {
 
 // IdentityDocument is the structure for an identity document.
 type IdentityDocument struct {
-	Created int64  `json:"created" form:"-"`
-	ID      string `json:"id" form:"-"` // See custom AppendTo implementation
-	Size    int64  `json:"size" form:"-"`
+	Created Timestamp `json:"created" form:"-"`
+	ID      string    `json:"id" form:"-"` // See custom AppendTo implementation
+	Size    int64     `json:"size" form:"-"`
 }
 
 // AppendTo implements custom form encoding for IdentityDocument. In the Go
@@ -385,27 +576,70 @@ type TOSAcceptanceParams struct {
 	UserAgent string `json:"user_agent" form:"user_agent"`
 }
 
+// NewTOSAcceptanceParams returns the TOS acceptance of the user who sent req,
+// accepted now, to be set on the AccountParams of a Custom account.
+//
+// The IP address is taken from the connection that req was received on. If
+// requests reach the server through a proxy or a load balancer, set IP to the
+// address of the user instead.
+func NewTOSAcceptanceParams(req *http.Request) *TOSAcceptanceParams {
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		ip = req.RemoteAddr
+	}
+
+	return &TOSAcceptanceParams{
+		Date:      time.Now().Unix(),
+		IP:        ip,
+		UserAgent: req.UserAgent(),
+	}
+}
+
 // AccountRejectParams is the structure for the Reject function.
 type AccountRejectParams struct {
+	Params `form:"*"`
+
 	// Reason is the reason that an account was rejected. It should be given a
 	// value of one of `fraud`, `terms_of_service`, or `other`.
-	Reason string `json:"reason" form:"reason"`
+	Reason AccountRejectReason `form:"reason"`
+}
+
+// Rejected reports whether the account was rejected, either by its platform
+// or by Stripe, as opposed to being disabled until it provides more
+// information or is reviewed.
+func (r AccountRequirementsDisabledReason) Rejected() bool {
+	return strings.HasPrefix(string(r), "rejected.")
+}
+
+// Risk reports whether the account was disabled or rejected because of
+// Stripe's risk checks rather than because of missing information.
+func (r AccountRequirementsDisabledReason) Risk() bool {
+	switch r {
+	case AccountRequirementsDisabledReasonListed,
+		AccountRequirementsDisabledReasonRejectedFraud,
+		AccountRequirementsDisabledReasonRejectedListed,
+		AccountRequirementsDisabledReasonUnderReview:
+		return true
+	}
+	return false
 }
 
 // UnmarshalJSON handles deserialization of an IdentityDocument.
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (d *IdentityDocument) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		d.ID = id
+		return nil
+	}
+
 	type identityDocument IdentityDocument
 	var doc identityDocument
 	err := json.Unmarshal(data, &doc)
-
-	if err == nil {
-		*d = IdentityDocument(doc)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		d.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*d = IdentityDocument(doc)
 	return nil
 }
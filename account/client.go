@@ -34,13 +34,22 @@ func (c Client) New(params *stripe.AccountParams) (*stripe.Account, error) {
 }
 
 // Get returns the details of an account.
-func Get() (*stripe.Account, error) {
-	return getC().Get()
+func Get(params *stripe.AccountParams) (*stripe.Account, error) {
+	return getC().Get(params)
 }
 
-func (c Client) Get() (*stripe.Account, error) {
+func (c Client) Get(params *stripe.AccountParams) (*stripe.Account, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
 	account := &stripe.Account{}
-	err := c.B.Call("GET", "/account", c.Key, nil, nil, account)
+	err := c.B.Call("GET", "/account", c.Key, body, commonParams, account)
 
 	return account, err
 }
@@ -114,12 +123,17 @@ func Reject(id string, params *stripe.AccountRejectParams) (*stripe.Account, err
 }
 
 func (c Client) Reject(id string, params *stripe.AccountRejectParams) (*stripe.Account, error) {
-	body := &form.Values{}
-	if len(params.Reason) > 0 {
-		body.Add("reason", params.Reason)
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
 	}
+
 	acct := &stripe.Account{}
-	err := c.B.Call("POST", "/accounts/"+id+"/reject", c.Key, body, nil, acct)
+	err := c.B.Call("POST", "/accounts/"+id+"/reject", c.Key, body, commonParams, acct)
 
 	return acct, err
 }
@@ -169,5 +183,5 @@ func (i *Iter) Account() *stripe.Account {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -6,6 +6,7 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestAccountDel(t *testing.T) {
@@ -15,7 +16,7 @@ func TestAccountDel(t *testing.T) {
 }
 
 func TestAccountGet(t *testing.T) {
-	account, err := Get()
+	account, err := Get(nil)
 	assert.Nil(t, err)
 	assert.NotNil(t, account)
 }
@@ -76,6 +77,26 @@ func TestAccountReject(t *testing.T) {
 	assert.NotNil(t, account)
 }
 
+func TestAccountRejectSendsReason(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/accounts/acct_123/reject", `{
+		"id": "acct_123",
+		"requirements": {"disabled_reason": "rejected.fraud"}
+	}`)
+
+	c := Client{B: b, Key: "sk_test_123"}
+	params := &stripe.AccountRejectParams{Reason: stripe.AccountRejectReasonFraud}
+	params.IdempotencyKey = "reject_acct_123"
+	account, err := c.Reject("acct_123", params)
+	assert.NoError(t, err)
+	assert.Equal(t, stripe.AccountRequirementsDisabledReasonRejectedFraud, account.Requirements.DisabledReason)
+	assert.True(t, account.Requirements.DisabledReason.Rejected())
+
+	call := b.LastCall()
+	assert.Equal(t, "fraud", call.Values().Get("reason"))
+	assert.Equal(t, "reject_acct_123", call.Params.IdempotencyKey)
+}
+
 func TestAccountUpdate(t *testing.T) {
 	account, err := Update("acct_123", &stripe.AccountParams{
 		Type:    stripe.AccountTypeCustom,
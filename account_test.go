@@ -2,12 +2,31 @@ package stripe
 
 import (
 	"encoding/json"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	assert "github.com/stretchr/testify/require"
 	"github.com/stripe/stripe-go/form"
 )
 
+func TestAccountRejectReason(t *testing.T) {
+	assert.Equal(t, AccountRejectReason("fraud"), AccountRejectReasonFraud)
+	assert.Equal(t, AccountRejectReason("other"), AccountRejectReasonOther)
+	assert.Equal(t, AccountRejectReason("terms_of_service"), AccountRejectReasonTermsOfService)
+}
+
+func TestAccountRequirementsDisabledReason(t *testing.T) {
+	assert.True(t, AccountRequirementsDisabledReasonRejectedFraud.Rejected())
+	assert.True(t, AccountRequirementsDisabledReasonRejectedFraud.Risk())
+	assert.False(t, AccountRequirementsDisabledReasonUnderReview.Rejected())
+	assert.True(t, AccountRequirementsDisabledReasonUnderReview.Risk())
+	assert.True(t, AccountRequirementsDisabledReasonRejectedTermsOfService.Rejected())
+	assert.False(t, AccountRequirementsDisabledReasonRejectedTermsOfService.Risk())
+	assert.False(t, AccountRequirementsDisabledReasonRequirementsPastDue.Rejected())
+	assert.False(t, AccountRequirementsDisabledReasonRequirementsPastDue.Risk())
+}
+
 func TestAccountUnmarshal(t *testing.T) {
 	accountData := map[string]interface{}{
 		"id": "acct_123",
@@ -42,6 +61,71 @@ func TestAccountUnmarshal(t *testing.T) {
 	assert.Equal(t, "card_123", account.ExternalAccounts.Values[1].ID)
 }
 
+func TestAccountUnmarshalRequirements(t *testing.T) {
+	data := []byte(`{
+		"id": "acct_123",
+		"business_profile": {"name": "Jenny's Bakery", "url": "https://example.com"},
+		"business_type": "company",
+		"capabilities": {"card_payments": "active", "transfers": "pending"},
+		"requirements": {
+			"current_deadline": 1546300800,
+			"currently_due": ["business_profile.mcc"],
+			"disabled_reason": "requirements.past_due",
+			"past_due": ["external_account"]
+		}
+	}`)
+
+	var account Account
+	err := json.Unmarshal(data, &account)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Jenny's Bakery", account.BusinessProfile.Name)
+	assert.Equal(t, AccountBusinessTypeCompany, account.BusinessType)
+	assert.Equal(t, AccountCapabilityStatusActive, account.Capabilities["card_payments"])
+	assert.Equal(t, AccountCapabilityStatusPending, account.Capabilities["transfers"])
+	assert.Equal(t, Timestamp(1546300800), account.Requirements.CurrentDeadline)
+	assert.Equal(t, []string{"business_profile.mcc"}, account.Requirements.CurrentlyDue)
+	assert.Equal(t, AccountRequirementsDisabledReason("requirements.past_due"), account.Requirements.DisabledReason)
+	assert.Equal(t, []string{"external_account"}, account.Requirements.PastDue)
+}
+
+func TestAccountUnmarshalFutureRequirements(t *testing.T) {
+	data := []byte(`{
+		"id": "acct_123",
+		"future_requirements": {
+			"current_deadline": 1577836800,
+			"disabled_reason": "requirements.past_due",
+			"eventually_due": ["company.tax_id"]
+		}
+	}`)
+
+	var account Account
+	err := json.Unmarshal(data, &account)
+	assert.NoError(t, err)
+
+	assert.Equal(t, Timestamp(1577836800), account.FutureRequirements.CurrentDeadline)
+	assert.Equal(t, AccountRequirementsDisabledReasonRequirementsPastDue, account.FutureRequirements.DisabledReason)
+	assert.Equal(t, []string{"company.tax_id"}, account.FutureRequirements.EventuallyDue)
+}
+
+func TestAccountParams_AppendTo(t *testing.T) {
+	params := &AccountParams{
+		BusinessProfile: &AccountBusinessProfileParams{
+			MCC: "5812",
+			URL: "https://example.com",
+		},
+		BusinessType:  AccountBusinessTypeCompany,
+		RequestedCaps: []string{"card_payments", "transfers"},
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	assert.Equal(t, []string{"5812"}, body.Get("business_profile[mcc]"))
+	assert.Equal(t, []string{"https://example.com"}, body.Get("business_profile[url]"))
+	assert.Equal(t, []string{"company"}, body.Get("business_type"))
+	assert.Equal(t, []string{"card_payments", "transfers"}, body.Get("requested_capabilities[]"))
+}
+
 func TestIdentityDocument_Appendto(t *testing.T) {
 	{
 		params := &IdentityDocument{ID: "file_123"}
@@ -65,3 +149,19 @@ func TestPayoutScheduleParams_AppendTo(t *testing.T) {
 		assert.Equal(t, []string{"minimum"}, body.Get("delay_days"))
 	}
 }
+
+func TestNewTOSAcceptanceParams(t *testing.T) {
+	req := httptest.NewRequest("POST", "/onboarding", nil)
+	req.RemoteAddr = "192.0.2.1:51234"
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	before := time.Now().Unix()
+	params := NewTOSAcceptanceParams(req)
+	assert.Equal(t, "192.0.2.1", params.IP)
+	assert.Equal(t, "Mozilla/5.0", params.UserAgent)
+	assert.True(t, params.Date >= before && params.Date <= time.Now().Unix())
+
+	body := &form.Values{}
+	form.AppendTo(body, &AccountParams{TOSAcceptance: params})
+	assert.Equal(t, []string{"192.0.2.1"}, body.Get("tos_acceptance[ip]"))
+}
@@ -0,0 +1,31 @@
+package stripe
+
+// AccountLinkCollect describes the information collected from the user by an
+// account link. Allowed values are "currently_due", "eventually_due".
+type AccountLinkCollect string
+
+// AccountLinkType describes the flow an account link sends the user through.
+// Allowed values are "account_onboarding", "account_update".
+type AccountLinkType string
+
+// AccountLinkParams is the set of parameters that can be used when creating
+// an account link.
+// For more details see https://stripe.com/docs/api#create_account_link.
+type AccountLinkParams struct {
+	Params     `form:"*"`
+	Account    string             `form:"account"`
+	Collect    AccountLinkCollect `form:"collect"`
+	RefreshURL string             `form:"refresh_url"`
+	ReturnURL  string             `form:"return_url"`
+	Type       AccountLinkType    `form:"type"`
+}
+
+// AccountLink is the resource representing an account link, a single-use URL
+// that sends the user of a connected account through hosted onboarding.
+// For more details see https://stripe.com/docs/api#account_links.
+type AccountLink struct {
+	APIResource
+	Created   Timestamp `json:"created"`
+	ExpiresAt Timestamp `json:"expires_at"`
+	URL       string    `json:"url"`
+}
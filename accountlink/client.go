@@ -0,0 +1,47 @@
+// Package accountlink provides the /account_links APIs
+package accountlink
+
+import (
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	CollectCurrentlyDue  stripe.AccountLinkCollect = "currently_due"
+	CollectEventuallyDue stripe.AccountLinkCollect = "eventually_due"
+
+	TypeAccountOnboarding stripe.AccountLinkType = "account_onboarding"
+	TypeAccountUpdate     stripe.AccountLinkType = "account_update"
+)
+
+// Client is used to invoke /account_links APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new account link.
+// For more details see https://stripe.com/docs/api#create_account_link.
+func New(params *stripe.AccountLinkParams) (*stripe.AccountLink, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.AccountLinkParams) (*stripe.AccountLink, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	accountLink := &stripe.AccountLink{}
+	err := c.B.Call("POST", "/account_links", c.Key, body, commonParams, accountLink)
+
+	return accountLink, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
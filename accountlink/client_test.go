@@ -0,0 +1,21 @@
+package accountlink
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestAccountLinkNew(t *testing.T) {
+	link, err := New(&stripe.AccountLinkParams{
+		Account:    "acct_123",
+		Collect:    CollectCurrentlyDue,
+		RefreshURL: "https://example.com/reauth",
+		ReturnURL:  "https://example.com/return",
+		Type:       TypeAccountOnboarding,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, link)
+}
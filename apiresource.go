@@ -0,0 +1,137 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// APIResponse describes the HTTP response a resource was decoded from.
+type APIResponse struct {
+	// Header holds the headers of the response, like the rate limiting
+	// headers.
+	Header http.Header
+
+	// IdempotencyKey is the idempotency key of the request, if any.
+	IdempotencyKey string
+
+	// RawJSON is the body of the response.
+	RawJSON []byte
+
+	// RequestID is the ID that Stripe gave the request, which support needs
+	// to look into it.
+	RequestID string
+
+	// Status is the status line of the response, like "200 OK".
+	Status string
+
+	// StatusCode is the status code of the response, like 200.
+	StatusCode int
+}
+
+// newAPIResponse returns the APIResponse describing res and its body.
+func newAPIResponse(res *http.Response, body []byte) *APIResponse {
+	return &APIResponse{
+		Header:         res.Header,
+		IdempotencyKey: res.Header.Get("Idempotency-Key"),
+		RawJSON:        body,
+		RequestID:      res.Header.Get("Request-Id"),
+		Status:         res.Status,
+		StatusCode:     res.StatusCode,
+	}
+}
+
+// APIResource is embedded by every resource returned by the API to give
+// access to the response it was decoded from:
+//
+//	ch, err := charge.Get("ch_123", nil)
+//	if err == nil {
+//	    log.Printf("Retrieved charge in request %v", ch.LastResponse.RequestID)
+//	}
+type APIResource struct {
+	// LastResponse is the response the resource was decoded from. It's nil
+	// for resources that weren't returned directly by a call, like expanded
+	// objects or objects of a list, for resources served from a store by
+	// CachingBackend or FallbackBackend, since no request was made for them,
+	// and for resources served by a backend that doesn't set it.
+	LastResponse *APIResponse `json:"-"`
+}
+
+// SetLastResponse sets the response the resource was decoded from.
+func (r *APIResource) SetLastResponse(response *APIResponse) {
+	r.LastResponse = response
+}
+
+// LastResponseSetter is implemented by the types that embed APIResource.
+// Backends call SetLastResponse on the values they decode responses into.
+type LastResponseSetter interface {
+	SetLastResponse(response *APIResponse)
+}
+
+// errorResponseSetter is implemented by the values that want to know about
+// the response to a failed call, which backends don't set as LastResponse.
+type errorResponseSetter interface {
+	setErrorResponse(response *APIResponse)
+}
+
+// capturedResponse is what backends wrapping another backend, like
+// CachingBackend, decode responses into. It keeps the raw body of the
+// response, to be stored and decoded into the caller's value, along with the
+// response set by the wrapped backend.
+type capturedResponse struct {
+	data     json.RawMessage
+	response *APIResponse
+
+	// errResponse is the response to the call if it failed, when the
+	// wrapped backend is a BackendConfiguration.
+	errResponse *APIResponse
+}
+
+// UnmarshalJSON keeps the raw body of the response.
+func (c *capturedResponse) UnmarshalJSON(data []byte) error {
+	c.data = append(c.data[:0], data...)
+	return nil
+}
+
+// SetLastResponse keeps the response set by the wrapped backend.
+func (c *capturedResponse) SetLastResponse(response *APIResponse) {
+	c.response = response
+}
+
+// setErrorResponse keeps the response to a failed call.
+func (c *capturedResponse) setErrorResponse(response *APIResponse) {
+	c.errResponse = response
+}
+
+// errStatusCode returns the status code of the response to a failed call, or
+// 0 if it's unknown.
+func (c *capturedResponse) errStatusCode() int {
+	if c.errResponse == nil {
+		return 0
+	}
+	return c.errResponse.StatusCode
+}
+
+// decodeInto decodes the captured body into v and sets the captured response
+// on it, like the wrapped backend would have.
+func (c *capturedResponse) decodeInto(v interface{}) error {
+	if err := unmarshalResponse(c.data, v); err != nil {
+		return err
+	}
+
+	if setter, ok := v.(LastResponseSetter); ok && c.response != nil {
+		setter.SetLastResponse(c.response)
+	}
+	return nil
+}
+
+// isList reports whether the captured body is a page of a list rather than a
+// single object.
+func (c *capturedResponse) isList() bool {
+	var obj struct {
+		Object string `json:"object"`
+	}
+	if json.Unmarshal(c.data, &obj) != nil {
+		return false
+	}
+	return obj.Object == "list" || obj.Object == "search_result"
+}
@@ -8,20 +8,23 @@ type ApplePayDomainParams struct {
 
 // ApplePayDomain is the resource representing a Stripe ApplePayDomain object
 type ApplePayDomain struct {
-	Created    int64  `json:"created"`
-	Deleted    bool   `json:"deleted"`
-	DomainName string `json:"domain_name"`
-	ID         string `json:"id"`
-	Live       bool   `json:"livemode"`
+	APIResource
+	Created    Timestamp `json:"created"`
+	Deleted    bool      `json:"deleted"`
+	DomainName string    `json:"domain_name"`
+	ID         string    `json:"id"`
+	Live       bool      `json:"livemode"`
 }
 
 // ApplePayDomainListParams are the parameters allowed during ApplePayDomain listing.
 type ApplePayDomainListParams struct {
 	ListParams `form:"*"`
+	DomainName string `form:"domain_name"`
 }
 
 // ApplePayDomainList is a list of ApplePayDomains as returned from a list endpoint.
 type ApplePayDomainList struct {
+	APIResource
 	ListMeta
 	Values []*ApplePayDomain `json:"data"`
 }
@@ -2,6 +2,8 @@
 package applepaydomain
 
 import (
+	"fmt"
+
 	stripe "github.com/stripe/stripe-go"
 	"github.com/stripe/stripe-go/form"
 )
@@ -18,6 +20,10 @@ func New(params *stripe.ApplePayDomainParams) (*stripe.ApplePayDomain, error) {
 }
 
 func (c Client) New(params *stripe.ApplePayDomainParams) (*stripe.ApplePayDomain, error) {
+	if params == nil || params.DomainName == "" {
+		return nil, fmt.Errorf("params.DomainName must be set")
+	}
+
 	body := &form.Values{}
 	form.AppendTo(body, params)
 
@@ -112,5 +118,5 @@ func (i *Iter) ApplePayDomain() *stripe.ApplePayDomain {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
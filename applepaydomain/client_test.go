@@ -6,6 +6,7 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestApplePayDomainDel(t *testing.T) {
@@ -29,6 +30,19 @@ func TestApplePayDomainList(t *testing.T) {
 	assert.NotNil(t, i.ApplePayDomain())
 }
 
+func TestApplePayDomainListByDomainName(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/apple_pay/domains", `{"data": [{"id": "apwc_123", "domain_name": "shop.example.com"}]}`)
+
+	i := Client{B: b}.List(&stripe.ApplePayDomainListParams{
+		DomainName: "shop.example.com",
+	})
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, "shop.example.com", i.ApplePayDomain().DomainName)
+	assert.Equal(t, "shop.example.com", b.LastCall().Values().Get("domain_name"))
+}
+
 func TestApplePayDomainNew(t *testing.T) {
 	domain, err := New(&stripe.ApplePayDomainParams{
 		DomainName: "example.com",
@@ -36,3 +50,8 @@ func TestApplePayDomainNew(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, domain)
 }
+
+func TestApplePayDomainNewWithoutDomainName(t *testing.T) {
+	_, err := New(&stripe.ApplePayDomainParams{})
+	assert.NotNil(t, err)
+}
@@ -3,6 +3,7 @@ package stripe
 import "encoding/json"
 
 type Application struct {
+	APIResource
 	ID   string `json:"id"`
 	Name string `json:"name"`
 }
@@ -11,15 +12,18 @@ type Application struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (a *Application) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		a.ID = id
+		return nil
+	}
+
 	type application Application
 	var aa application
 	err := json.Unmarshal(data, &aa)
-	if err == nil {
-		*a = Application(aa)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		a.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*a = Application(aa)
 	return nil
 }
@@ -11,6 +11,15 @@ type TransactionStatus string
 // "application_fee_refund", "transfer", "transfer_cancel", "transfer_failure".
 type TransactionType string
 
+// TransactionReportingCategory is the list of allowed values for the
+// transaction's reporting category. It groups transactions for reporting and
+// reconciliation purposes.
+type TransactionReportingCategory string
+
+// TxFeeType is the list of allowed values for the type of a fee in a
+// transaction's fee details.
+type TxFeeType string
+
 // TransactionSourceType consts represent valid balance transaction sources.
 type TransactionSourceType string
 
@@ -24,6 +33,15 @@ const (
 	// TransactionSourceFee is a constant representing a transaction source of application_fee
 	TransactionSourceFee TransactionSourceType = "application_fee"
 
+	// TransactionSourceIssuingAuthorization is a constant representing a transaction source of issuing.authorization
+	TransactionSourceIssuingAuthorization TransactionSourceType = "issuing.authorization"
+
+	// TransactionSourceIssuingDispute is a constant representing a transaction source of issuing.dispute
+	TransactionSourceIssuingDispute TransactionSourceType = "issuing.dispute"
+
+	// TransactionSourceIssuingTransaction is a constant representing a transaction source of issuing.transaction
+	TransactionSourceIssuingTransaction TransactionSourceType = "issuing.transaction"
+
 	// TransactionSourcePayout is a constant representing a transaction source of payout
 	TransactionSourcePayout TransactionSourceType = "payout"
 
@@ -36,6 +54,9 @@ const (
 	// TransactionSourceReversal is a constant representing a transaction source of reversal
 	TransactionSourceReversal TransactionSourceType = "reversal"
 
+	// TransactionSourceTopup is a constant representing a transaction source of topup
+	TransactionSourceTopup TransactionSourceType = "topup"
+
 	// TransactionSourceTransfer is a constant representing a transaction source of transfer
 	TransactionSourceTransfer TransactionSourceType = "transfer"
 )
@@ -44,16 +65,20 @@ const (
 // The Type should indicate which object is fleshed out.
 // For more details see https://stripe.com/docs/api#retrieve_balance_transaction
 type TransactionSource struct {
-	Charge            *Charge               `json:"-"`
-	Dispute           *Dispute              `json:"-"`
-	Fee               *Fee                  `json:"-"`
-	ID                string                `json:"id"`
-	Payout            *Payout               `json:"-"`
-	RecipientTransfer *RecipientTransfer    `json:"-"`
-	Refund            *Refund               `json:"-"`
-	Reversal          *Reversal             `json:"-"`
-	Transfer          *Transfer             `json:"-"`
-	Type              TransactionSourceType `json:"object"`
+	Charge               *Charge               `json:"-"`
+	Dispute              *Dispute              `json:"-"`
+	Fee                  *Fee                  `json:"-"`
+	ID                   string                `json:"id"`
+	IssuingAuthorization *IssuingAuthorization `json:"-"`
+	IssuingDispute       *IssuingDispute       `json:"-"`
+	IssuingTransaction   *IssuingTransaction   `json:"-"`
+	Payout               *Payout               `json:"-"`
+	RecipientTransfer    *RecipientTransfer    `json:"-"`
+	Refund               *Refund               `json:"-"`
+	Reversal             *Reversal             `json:"-"`
+	Topup                *Topup                `json:"-"`
+	Transfer             *Transfer             `json:"-"`
+	Type                 TransactionSourceType `json:"object"`
 }
 
 // BalanceParams is the set of parameters that can be used when retrieving a balance.
@@ -85,6 +110,7 @@ type TxListParams struct {
 // Balance is the resource representing your Stripe balance.
 // For more details see https://stripe.com/docs/api/#balance.
 type Balance struct {
+	APIResource
 	Available []Amount `json:"available"`
 	Live      bool     `json:"livemode"`
 	Pending   []Amount `json:"pending"`
@@ -93,23 +119,27 @@ type Balance struct {
 // Transaction is the resource representing the balance transaction.
 // For more details see https://stripe.com/docs/api/#balance.
 type Transaction struct {
-	Amount     int64             `json:"amount"`
-	Available  int64             `json:"available_on"`
-	Created    int64             `json:"created"`
-	Currency   Currency          `json:"currency"`
-	Desc       string            `json:"description"`
-	ID         string            `json:"id"`
-	Fee        int64             `json:"fee"`
-	FeeDetails []TxFee           `json:"fee_details"`
-	Net        int64             `json:"net"`
-	Recipient  string            `json:"recipient"`
-	Src        TransactionSource `json:"source"`
-	Status     TransactionStatus `json:"status"`
-	Type       TransactionType   `json:"type"`
+	APIResource
+	Amount            int64                        `json:"amount"`
+	Available         Timestamp                    `json:"available_on"`
+	Created           Timestamp                    `json:"created"`
+	Currency          Currency                     `json:"currency"`
+	Desc              string                       `json:"description"`
+	ExchangeRate      float64                      `json:"exchange_rate"`
+	ID                string                       `json:"id"`
+	Fee               int64                        `json:"fee"`
+	FeeDetails        []TxFee                      `json:"fee_details"`
+	Net               int64                        `json:"net"`
+	Recipient         string                       `json:"recipient"`
+	ReportingCategory TransactionReportingCategory `json:"reporting_category"`
+	Src               TransactionSource            `json:"source"`
+	Status            TransactionStatus            `json:"status"`
+	Type              TransactionType              `json:"type"`
 }
 
 // TransactionList is a list of transactions as returned from a list endpoint.
 type TransactionList struct {
+	APIResource
 	ListMeta
 	Values []*Transaction `json:"data"`
 }
@@ -122,27 +152,30 @@ type Amount struct {
 
 // TxFee is a structure that breaks down the fees in a transaction.
 type TxFee struct {
-	Application string   `json:"application"`
-	Amount      int64    `json:"amount"`
-	Currency    Currency `json:"currency"`
-	Desc        string   `json:"description"`
-	Type        string   `json:"type"`
+	Application string    `json:"application"`
+	Amount      int64     `json:"amount"`
+	Currency    Currency  `json:"currency"`
+	Desc        string    `json:"description"`
+	Type        TxFeeType `json:"type"`
 }
 
 // UnmarshalJSON handles deserialization of a Transaction.
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (t *Transaction) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
 	type transaction Transaction
 	var tt transaction
 	err := json.Unmarshal(data, &tt)
-	if err == nil {
-		*t = Transaction(tt)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		t.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*t = Transaction(tt)
 	return nil
 }
 
@@ -150,36 +183,48 @@ func (t *Transaction) UnmarshalJSON(data []byte) error {
 // This custom unmarshaling is needed because the specific
 // type of transaction source it refers to is specified in the JSON
 func (s *TransactionSource) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		s.ID = id
+		return nil
+	}
+
 	type source TransactionSource
 	var ss source
 	err := json.Unmarshal(data, &ss)
-	if err == nil {
-		*s = TransactionSource(ss)
-
-		switch s.Type {
-		case TransactionSourceCharge:
-			json.Unmarshal(data, &s.Charge)
-		case TransactionSourceDispute:
-			json.Unmarshal(data, &s.Dispute)
-		case TransactionSourceFee:
-			json.Unmarshal(data, &s.Fee)
-		case TransactionSourcePayout:
-			json.Unmarshal(data, &s.Payout)
-		case TransactionSourceRecipientTransfer:
-			json.Unmarshal(data, &s.RecipientTransfer)
-		case TransactionSourceRefund:
-			json.Unmarshal(data, &s.Refund)
-		case TransactionSourceReversal:
-			json.Unmarshal(data, &s.Reversal)
-		case TransactionSourceTransfer:
-			json.Unmarshal(data, &s.Transfer)
-		}
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		s.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
-	return nil
+	*s = TransactionSource(ss)
+
+	switch s.Type {
+	case TransactionSourceCharge:
+		err = json.Unmarshal(data, &s.Charge)
+	case TransactionSourceDispute:
+		err = json.Unmarshal(data, &s.Dispute)
+	case TransactionSourceFee:
+		err = json.Unmarshal(data, &s.Fee)
+	case TransactionSourceIssuingAuthorization:
+		err = json.Unmarshal(data, &s.IssuingAuthorization)
+	case TransactionSourceIssuingDispute:
+		err = json.Unmarshal(data, &s.IssuingDispute)
+	case TransactionSourceIssuingTransaction:
+		err = json.Unmarshal(data, &s.IssuingTransaction)
+	case TransactionSourcePayout:
+		err = json.Unmarshal(data, &s.Payout)
+	case TransactionSourceRecipientTransfer:
+		err = json.Unmarshal(data, &s.RecipientTransfer)
+	case TransactionSourceRefund:
+		err = json.Unmarshal(data, &s.Refund)
+	case TransactionSourceReversal:
+		err = json.Unmarshal(data, &s.Reversal)
+	case TransactionSourceTopup:
+		err = json.Unmarshal(data, &s.Topup)
+	case TransactionSourceTransfer:
+		err = json.Unmarshal(data, &s.Transfer)
+	}
+
+	return err
 }
 
 // MarshalJSON handles serialization of a TransactionSource.
@@ -120,5 +120,5 @@ func (i *Iter) Transaction() *stripe.Transaction {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
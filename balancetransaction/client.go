@@ -0,0 +1,117 @@
+// Package balancetransaction provides the /balance_transactions APIs
+package balancetransaction
+
+import (
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	FeeTypeApplicationFee stripe.TxFeeType = "application_fee"
+	FeeTypeStripeFee      stripe.TxFeeType = "stripe_fee"
+	FeeTypeTax            stripe.TxFeeType = "tax"
+
+	ReportingCategoryAdvance                     stripe.TransactionReportingCategory = "advance"
+	ReportingCategoryAdvanceFunding              stripe.TransactionReportingCategory = "advance_funding"
+	ReportingCategoryCharge                      stripe.TransactionReportingCategory = "charge"
+	ReportingCategoryChargeFailure               stripe.TransactionReportingCategory = "charge_failure"
+	ReportingCategoryConnectCollectionTransfer   stripe.TransactionReportingCategory = "connect_collection_transfer"
+	ReportingCategoryConnectReservedFunds        stripe.TransactionReportingCategory = "connect_reserved_funds"
+	ReportingCategoryDispute                     stripe.TransactionReportingCategory = "dispute"
+	ReportingCategoryDisputeReversal             stripe.TransactionReportingCategory = "dispute_reversal"
+	ReportingCategoryFee                         stripe.TransactionReportingCategory = "fee"
+	ReportingCategoryIssuingAuthorizationHold    stripe.TransactionReportingCategory = "issuing_authorization_hold"
+	ReportingCategoryIssuingAuthorizationRelease stripe.TransactionReportingCategory = "issuing_authorization_release"
+	ReportingCategoryIssuingTransaction          stripe.TransactionReportingCategory = "issuing_transaction"
+	ReportingCategoryOtherAdjustment             stripe.TransactionReportingCategory = "other_adjustment"
+	ReportingCategoryPartialCaptureReversal      stripe.TransactionReportingCategory = "partial_capture_reversal"
+	ReportingCategoryPayout                      stripe.TransactionReportingCategory = "payout"
+	ReportingCategoryPayoutReversal              stripe.TransactionReportingCategory = "payout_reversal"
+	ReportingCategoryPlatformEarning             stripe.TransactionReportingCategory = "platform_earning"
+	ReportingCategoryPlatformEarningRefund       stripe.TransactionReportingCategory = "platform_earning_refund"
+	ReportingCategoryRefund                      stripe.TransactionReportingCategory = "refund"
+	ReportingCategoryRefundFailure               stripe.TransactionReportingCategory = "refund_failure"
+	ReportingCategoryRiskReservedFunds           stripe.TransactionReportingCategory = "risk_reserved_funds"
+	ReportingCategoryTax                         stripe.TransactionReportingCategory = "tax"
+	ReportingCategoryTopup                       stripe.TransactionReportingCategory = "topup"
+	ReportingCategoryTopupReversal               stripe.TransactionReportingCategory = "topup_reversal"
+	ReportingCategoryTransfer                    stripe.TransactionReportingCategory = "transfer"
+	ReportingCategoryTransferReversal            stripe.TransactionReportingCategory = "transfer_reversal"
+)
+
+// Client is used to invoke /balance_transactions APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of a balance transaction. Use Expand("source")
+// on the params to have the transaction's source fleshed out.
+// For more details see https://stripe.com/docs/api#retrieve_balance_transaction.
+func Get(id string, params *stripe.TxParams) (*stripe.Transaction, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TxParams) (*stripe.Transaction, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	transaction := &stripe.Transaction{}
+	err := c.B.Call("GET", "/balance_transactions/"+id, c.Key, body, commonParams, transaction)
+
+	return transaction, err
+}
+
+// List returns a list of balance transactions.
+// For more details see https://stripe.com/docs/api#balance_history.
+func List(params *stripe.TxListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.TxListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TransactionList{}
+		err := c.B.Call("GET", "/balance_transactions", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of Transactions.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Transaction returns the most recent Transaction
+// visited by a call to Next.
+func (i *Iter) Transaction() *stripe.Transaction {
+	return i.Current().(*stripe.Transaction)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,48 @@
+package balancetransaction
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestBalanceTransactionGet(t *testing.T) {
+	transaction, err := Get("txn_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, transaction)
+}
+
+func TestBalanceTransactionGetExpandSource(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/balance_transactions/txn_123", `{
+		"id": "txn_123",
+		"fee": 59,
+		"fee_details": [
+			{"amount": 59, "currency": "usd", "type": "stripe_fee"}
+		],
+		"reporting_category": "charge",
+		"source": {"id": "ch_123", "object": "charge", "amount": 1000}
+	}`)
+
+	params := &stripe.TxParams{}
+	params.Expand("source")
+	transaction, err := Client{B: b}.Get("txn_123", params)
+	assert.Nil(t, err)
+	assert.Equal(t, FeeTypeStripeFee, transaction.FeeDetails[0].Type)
+	assert.Equal(t, ReportingCategoryCharge, transaction.ReportingCategory)
+	assert.Equal(t, stripe.TransactionSourceCharge, transaction.Src.Type)
+	assert.Equal(t, uint64(1000), transaction.Src.Charge.Amount)
+	assert.Equal(t, "source", b.LastCall().Values().Get("expand[]"))
+}
+
+func TestBalanceTransactionList(t *testing.T) {
+	i := List(&stripe.TxListParams{})
+
+	// Verify that we can get at least one transaction
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.Transaction())
+}
@@ -8,7 +8,8 @@ import (
 )
 
 // BankAccountStatus is the list of allowed values for the bank account's status.
-// Allowed values are "new", "verified", "validated", "errored".
+// Allowed values are "new", "validated", "verified", "verification_failed",
+// "errored".
 type BankAccountStatus string
 
 // BankAccountParams is the set of parameters that can be used when updating a
@@ -101,6 +102,7 @@ type BankAccountListParams struct {
 
 // BankAccount represents a Stripe bank account.
 type BankAccount struct {
+	APIResource
 	AccountHolderName string            `json:"account_holder_name"`
 	AccountHolderType string            `json:"account_holder_type"`
 	Country           string            `json:"country"`
@@ -119,6 +121,7 @@ type BankAccount struct {
 
 // BankAccountList is a list object for bank accounts.
 type BankAccountList struct {
+	APIResource
 	ListMeta
 	Values []*BankAccount `json:"data"`
 }
@@ -127,15 +130,18 @@ type BankAccountList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (b *BankAccount) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		b.ID = id
+		return nil
+	}
+
 	type bankAccount BankAccount
 	var bb bankAccount
 	err := json.Unmarshal(data, &bb)
-	if err == nil {
-		*b = BankAccount(bb)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		b.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*b = BankAccount(bb)
 	return nil
 }
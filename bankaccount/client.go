@@ -16,10 +16,11 @@ type Client struct {
 }
 
 const (
-	NewAccount       stripe.BankAccountStatus = "new"
-	VerifiedAccount  stripe.BankAccountStatus = "verified"
-	ValidatedAccount stripe.BankAccountStatus = "validated"
-	ErroredAccount   stripe.BankAccountStatus = "errored"
+	NewAccount                stripe.BankAccountStatus = "new"
+	VerifiedAccount           stripe.BankAccountStatus = "verified"
+	ValidatedAccount          stripe.BankAccountStatus = "validated"
+	VerificationFailedAccount stripe.BankAccountStatus = "verification_failed"
+	ErroredAccount            stripe.BankAccountStatus = "errored"
 )
 
 // New POSTs a new bank account.
@@ -182,5 +183,5 @@ func (i *Iter) BankAccount() *stripe.BankAccount {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
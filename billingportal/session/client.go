@@ -0,0 +1,58 @@
+// Package session provides the /billing_portal/sessions APIs
+package session
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	FlowAfterCompletionTypeHostedConfirmation stripe.BillingPortalSessionFlowAfterCompletionType = "hosted_confirmation"
+	FlowAfterCompletionTypePortalHomepage     stripe.BillingPortalSessionFlowAfterCompletionType = "portal_homepage"
+	FlowAfterCompletionTypeRedirect           stripe.BillingPortalSessionFlowAfterCompletionType = "redirect"
+
+	FlowTypePaymentMethodUpdate       stripe.BillingPortalSessionFlowType = "payment_method_update"
+	FlowTypeSubscriptionCancel        stripe.BillingPortalSessionFlowType = "subscription_cancel"
+	FlowTypeSubscriptionUpdate        stripe.BillingPortalSessionFlowType = "subscription_update"
+	FlowTypeSubscriptionUpdateConfirm stripe.BillingPortalSessionFlowType = "subscription_update_confirm"
+)
+
+// Client is used to invoke /billing_portal/sessions APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New creates a customer portal session. Set FlowData to deep link the
+// customer straight into a flow, like canceling a subscription, instead of
+// the portal homepage.
+// For more details see https://stripe.com/docs/api/customer_portal/sessions/create.
+func New(params *stripe.BillingPortalSessionParams) (*stripe.BillingPortalSession, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.BillingPortalSessionParams) (*stripe.BillingPortalSession, error) {
+	if params == nil || params.Customer == "" {
+		return nil, fmt.Errorf("params.Customer must be set")
+	}
+
+	if flow := params.FlowData; flow != nil && flow.AfterCompletion != nil &&
+		flow.AfterCompletion.Type == FlowAfterCompletionTypeRedirect &&
+		(flow.AfterCompletion.Redirect == nil || flow.AfterCompletion.Redirect.ReturnURL == "") {
+		return nil, fmt.Errorf("params.FlowData.AfterCompletion.Redirect.ReturnURL must be set for a redirect")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	session := &stripe.BillingPortalSession{}
+	err := c.B.Call("POST", "/billing_portal/sessions", c.Key, body, &params.Params, session)
+
+	return session, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
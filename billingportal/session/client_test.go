@@ -0,0 +1,98 @@
+package session
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestBillingPortalSessionNew(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/billing_portal/sessions", `{
+		"id": "bps_123",
+		"object": "billing_portal.session",
+		"customer": "cus_123",
+		"flow": {
+			"type": "subscription_cancel",
+			"subscription_cancel": {"subscription": "sub_123"},
+			"after_completion": {"type": "redirect", "redirect": {"return_url": "https://example.com/canceled"}}
+		},
+		"url": "https://billing.stripe.com/session/test_123"
+	}`)
+
+	session, err := Client{B: b}.New(&stripe.BillingPortalSessionParams{
+		Customer: "cus_123",
+		FlowData: &stripe.BillingPortalSessionFlowDataParams{
+			AfterCompletion: &stripe.BillingPortalSessionFlowAfterCompletionParams{
+				Redirect: &stripe.BillingPortalSessionFlowAfterCompletionRedirectParams{
+					ReturnURL: "https://example.com/canceled",
+				},
+				Type: FlowAfterCompletionTypeRedirect,
+			},
+			SubscriptionCancel: &stripe.BillingPortalSessionFlowSubscriptionCancelParams{
+				Subscription: "sub_123",
+			},
+			Type: FlowTypeSubscriptionCancel,
+		},
+		ReturnURL: "https://example.com/account",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, FlowTypeSubscriptionCancel, session.Flow.Type)
+	assert.Equal(t, "sub_123", session.Flow.SubscriptionCancel.Subscription)
+	assert.Equal(t, "https://example.com/canceled", session.Flow.AfterCompletion.Redirect.ReturnURL)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "subscription_cancel", values.Get("flow_data[type]"))
+	assert.Equal(t, "sub_123", values.Get("flow_data[subscription_cancel][subscription]"))
+	assert.Equal(t, "https://example.com/canceled", values.Get("flow_data[after_completion][redirect][return_url]"))
+	assert.Equal(t, "https://example.com/account", values.Get("return_url"))
+}
+
+func TestBillingPortalSessionNewSubscriptionUpdateConfirm(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/billing_portal/sessions", `{"id": "bps_123"}`)
+
+	_, err := Client{B: b}.New(&stripe.BillingPortalSessionParams{
+		Customer: "cus_123",
+		FlowData: &stripe.BillingPortalSessionFlowDataParams{
+			SubscriptionUpdateConfirm: &stripe.BillingPortalSessionFlowSubscriptionUpdateConfirmParams{
+				Discounts: []*stripe.BillingPortalSessionFlowSubscriptionUpdateConfirmDiscountParams{
+					{Coupon: "SPRING"},
+				},
+				Items: []*stripe.BillingPortalSessionFlowSubscriptionUpdateConfirmItemParams{
+					{ID: "si_123", Price: "price_456", Quantity: 2},
+				},
+				Subscription: "sub_123",
+			},
+			Type: FlowTypeSubscriptionUpdateConfirm,
+		},
+	})
+	assert.Nil(t, err)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "SPRING", values.Get("flow_data[subscription_update_confirm][discounts][0][coupon]"))
+	assert.Equal(t, "price_456", values.Get("flow_data[subscription_update_confirm][items][0][price]"))
+	assert.Equal(t, "2", values.Get("flow_data[subscription_update_confirm][items][0][quantity]"))
+}
+
+func TestBillingPortalSessionNewValidation(t *testing.T) {
+	b := testbackend.New()
+
+	_, err := Client{B: b}.New(nil)
+	assert.NotNil(t, err)
+
+	_, err = Client{B: b}.New(&stripe.BillingPortalSessionParams{
+		Customer: "cus_123",
+		FlowData: &stripe.BillingPortalSessionFlowDataParams{
+			AfterCompletion: &stripe.BillingPortalSessionFlowAfterCompletionParams{
+				Type: FlowAfterCompletionTypeRedirect,
+			},
+			Type: FlowTypePaymentMethodUpdate,
+		},
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, len(b.Calls()))
+}
@@ -0,0 +1,172 @@
+package stripe
+
+// BillingPortalSessionFlowAfterCompletionType is the list of allowed values
+// for what happens after a customer completes a portal flow. Allowed values
+// are "hosted_confirmation", "portal_homepage" and "redirect".
+type BillingPortalSessionFlowAfterCompletionType string
+
+// BillingPortalSessionFlowType is the list of allowed values for the type of
+// flow a portal session deep links into. Allowed values are
+// "payment_method_update", "subscription_cancel", "subscription_update" and
+// "subscription_update_confirm".
+type BillingPortalSessionFlowType string
+
+// BillingPortalSessionFlowAfterCompletionHostedConfirmationParams is the set
+// of parameters for the confirmation message shown after a portal flow.
+type BillingPortalSessionFlowAfterCompletionHostedConfirmationParams struct {
+	CustomMessage string `form:"custom_message"`
+}
+
+// BillingPortalSessionFlowAfterCompletionRedirectParams is the set of
+// parameters for where the customer is sent after a portal flow.
+type BillingPortalSessionFlowAfterCompletionRedirectParams struct {
+	ReturnURL string `form:"return_url"`
+}
+
+// BillingPortalSessionFlowAfterCompletionParams is the set of parameters
+// describing what happens after a customer completes a portal flow.
+type BillingPortalSessionFlowAfterCompletionParams struct {
+	HostedConfirmation *BillingPortalSessionFlowAfterCompletionHostedConfirmationParams `form:"hosted_confirmation"`
+	Redirect           *BillingPortalSessionFlowAfterCompletionRedirectParams           `form:"redirect"`
+	Type               BillingPortalSessionFlowAfterCompletionType                      `form:"type"`
+}
+
+// BillingPortalSessionFlowSubscriptionCancelParams is the set of parameters
+// for a flow that cancels a subscription.
+type BillingPortalSessionFlowSubscriptionCancelParams struct {
+	Subscription string `form:"subscription"`
+}
+
+// BillingPortalSessionFlowSubscriptionUpdateParams is the set of parameters
+// for a flow that lets the customer pick a new plan for a subscription.
+type BillingPortalSessionFlowSubscriptionUpdateParams struct {
+	Subscription string `form:"subscription"`
+}
+
+// BillingPortalSessionFlowSubscriptionUpdateConfirmDiscountParams is the set
+// of parameters for a discount applied by a confirmed subscription update.
+type BillingPortalSessionFlowSubscriptionUpdateConfirmDiscountParams struct {
+	Coupon        string `form:"coupon"`
+	PromotionCode string `form:"promotion_code"`
+}
+
+// BillingPortalSessionFlowSubscriptionUpdateConfirmItemParams is the set of
+// parameters for a subscription item changed by a confirmed subscription
+// update.
+type BillingPortalSessionFlowSubscriptionUpdateConfirmItemParams struct {
+	ID       string `form:"id"`
+	Price    string `form:"price"`
+	Quantity uint64 `form:"quantity"`
+}
+
+// BillingPortalSessionFlowSubscriptionUpdateConfirmParams is the set of
+// parameters for a flow that asks the customer to confirm a specific
+// subscription update.
+type BillingPortalSessionFlowSubscriptionUpdateConfirmParams struct {
+	Discounts    []*BillingPortalSessionFlowSubscriptionUpdateConfirmDiscountParams `form:"discounts,indexed"`
+	Items        []*BillingPortalSessionFlowSubscriptionUpdateConfirmItemParams     `form:"items,indexed"`
+	Subscription string                                                             `form:"subscription"`
+}
+
+// BillingPortalSessionFlowDataParams is the set of parameters describing the
+// flow a portal session deep links into, instead of the portal homepage.
+type BillingPortalSessionFlowDataParams struct {
+	AfterCompletion           *BillingPortalSessionFlowAfterCompletionParams           `form:"after_completion"`
+	SubscriptionCancel        *BillingPortalSessionFlowSubscriptionCancelParams        `form:"subscription_cancel"`
+	SubscriptionUpdate        *BillingPortalSessionFlowSubscriptionUpdateParams        `form:"subscription_update"`
+	SubscriptionUpdateConfirm *BillingPortalSessionFlowSubscriptionUpdateConfirmParams `form:"subscription_update_confirm"`
+	Type                      BillingPortalSessionFlowType                             `form:"type"`
+}
+
+// BillingPortalSessionParams is the set of parameters that can be used when
+// creating a customer portal session.
+// For more details see https://stripe.com/docs/api/customer_portal/sessions/create.
+type BillingPortalSessionParams struct {
+	Params        `form:"*"`
+	Configuration string                              `form:"configuration"`
+	Customer      string                              `form:"customer"`
+	FlowData      *BillingPortalSessionFlowDataParams `form:"flow_data"`
+	Locale        string                              `form:"locale"`
+	OnBehalfOf    string                              `form:"on_behalf_of"`
+
+	// ReturnURL is where the customer goes when they click the portal's link
+	// back to the site. A flow that redirects after completion needs its own
+	// return URL in FlowData.AfterCompletion.Redirect.
+	ReturnURL string `form:"return_url"`
+}
+
+// BillingPortalSessionFlowAfterCompletionHostedConfirmation is the
+// confirmation message shown after a portal flow.
+type BillingPortalSessionFlowAfterCompletionHostedConfirmation struct {
+	CustomMessage string `json:"custom_message"`
+}
+
+// BillingPortalSessionFlowAfterCompletionRedirect is where the customer is
+// sent after a portal flow.
+type BillingPortalSessionFlowAfterCompletionRedirect struct {
+	ReturnURL string `json:"return_url"`
+}
+
+// BillingPortalSessionFlowAfterCompletion is what happens after a customer
+// completes a portal flow.
+type BillingPortalSessionFlowAfterCompletion struct {
+	HostedConfirmation *BillingPortalSessionFlowAfterCompletionHostedConfirmation `json:"hosted_confirmation"`
+	Redirect           *BillingPortalSessionFlowAfterCompletionRedirect           `json:"redirect"`
+	Type               BillingPortalSessionFlowAfterCompletionType                `json:"type"`
+}
+
+// BillingPortalSessionFlowSubscription is the subscription a portal flow acts
+// on.
+type BillingPortalSessionFlowSubscription struct {
+	Subscription string `json:"subscription"`
+}
+
+// BillingPortalSessionFlowSubscriptionUpdateConfirmDiscount is a discount
+// applied by a confirmed subscription update.
+type BillingPortalSessionFlowSubscriptionUpdateConfirmDiscount struct {
+	Coupon        string `json:"coupon"`
+	PromotionCode string `json:"promotion_code"`
+}
+
+// BillingPortalSessionFlowSubscriptionUpdateConfirmItem is a subscription
+// item changed by a confirmed subscription update.
+type BillingPortalSessionFlowSubscriptionUpdateConfirmItem struct {
+	ID       string `json:"id"`
+	Price    string `json:"price"`
+	Quantity uint64 `json:"quantity"`
+}
+
+// BillingPortalSessionFlowSubscriptionUpdateConfirm is the subscription update
+// a portal flow asks the customer to confirm.
+type BillingPortalSessionFlowSubscriptionUpdateConfirm struct {
+	Discounts    []*BillingPortalSessionFlowSubscriptionUpdateConfirmDiscount `json:"discounts"`
+	Items        []*BillingPortalSessionFlowSubscriptionUpdateConfirmItem     `json:"items"`
+	Subscription string                                                       `json:"subscription"`
+}
+
+// BillingPortalSessionFlow is the flow a portal session deep links into.
+type BillingPortalSessionFlow struct {
+	AfterCompletion           *BillingPortalSessionFlowAfterCompletion           `json:"after_completion"`
+	SubscriptionCancel        *BillingPortalSessionFlowSubscription              `json:"subscription_cancel"`
+	SubscriptionUpdate        *BillingPortalSessionFlowSubscription              `json:"subscription_update"`
+	SubscriptionUpdateConfirm *BillingPortalSessionFlowSubscriptionUpdateConfirm `json:"subscription_update_confirm"`
+	Type                      BillingPortalSessionFlowType                       `json:"type"`
+}
+
+// BillingPortalSession is the resource representing a Stripe customer portal
+// session. Sessions are short-lived: the customer should be sent to URL
+// right after the session is created.
+// For more details see https://stripe.com/docs/api/customer_portal/sessions.
+type BillingPortalSession struct {
+	APIResource
+	Configuration string                    `json:"configuration"`
+	Created       Timestamp                 `json:"created"`
+	Customer      string                    `json:"customer"`
+	Flow          *BillingPortalSessionFlow `json:"flow"`
+	ID            string                    `json:"id"`
+	Live          bool                      `json:"livemode"`
+	Locale        string                    `json:"locale"`
+	OnBehalfOf    string                    `json:"on_behalf_of"`
+	ReturnURL     string                    `json:"return_url"`
+	URL           string                    `json:"url"`
+}
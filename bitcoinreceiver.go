@@ -36,13 +36,14 @@ type BitcoinReceiverUpdateParams struct {
 // BitcoinReceiver is the resource representing a Stripe bitcoin receiver.
 // For more details see https://stripe.com/docs/api/#bitcoin_receivers
 type BitcoinReceiver struct {
+	APIResource
 	Active                bool                    `json:"active"`
 	Amount                uint64                  `json:"amount"`
 	AmountReceived        uint64                  `json:"amount_received"`
 	BitcoinAmount         uint64                  `json:"bitcoin_amount"`
 	BitcoinAmountReceived uint64                  `json:"bitcoin_amount_received"`
 	BitcoinUri            string                  `json:"bitcoin_uri"`
-	Created               int64                   `json:"created"`
+	Created               Timestamp               `json:"created"`
 	Currency              Currency                `json:"currency"`
 	Customer              string                  `json:"customer"`
 	Desc                  string                  `json:"description"`
@@ -59,6 +60,7 @@ type BitcoinReceiver struct {
 
 // BitcoinReceiverList is a list of bitcoin receivers as retrieved from a list endpoint.
 type BitcoinReceiverList struct {
+	APIResource
 	ListMeta
 	Values []*BitcoinReceiver `json:"data"`
 }
@@ -67,15 +69,18 @@ type BitcoinReceiverList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (br *BitcoinReceiver) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		br.ID = id
+		return nil
+	}
+
 	type bitcoinReceiver BitcoinReceiver
 	var r bitcoinReceiver
 	err := json.Unmarshal(data, &r)
-	if err == nil {
-		*br = BitcoinReceiver(r)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		br.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*br = BitcoinReceiver(r)
 	return nil
 }
@@ -40,14 +40,17 @@ func Get(id string, params *stripe.BitcoinReceiverParams) (*stripe.BitcoinReceiv
 }
 
 func (c Client) Get(id string, params *stripe.BitcoinReceiverParams) (*stripe.BitcoinReceiver, error) {
+	var body *form.Values
 	var commonParams *stripe.Params
 
 	if params != nil {
 		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
 	}
 
 	bitcoinReceiver := &stripe.BitcoinReceiver{}
-	err := c.B.Call("GET", "/bitcoin/receivers/"+id, c.Key, nil, commonParams, bitcoinReceiver)
+	err := c.B.Call("GET", "/bitcoin/receivers/"+id, c.Key, body, commonParams, bitcoinReceiver)
 
 	return bitcoinReceiver, err
 }
@@ -113,5 +116,5 @@ func (i *Iter) BitcoinReceiver() *stripe.BitcoinReceiver {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -13,6 +13,7 @@ type BitcoinTransactionListParams struct {
 // It is a child object of BitcoinRecievers
 // For more details see https://stripe.com/docs/api/#retrieve_bitcoin_receiver
 type BitcoinTransactionList struct {
+	APIResource
 	ListMeta
 	Values []*BitcoinTransaction `json:"data"`
 }
@@ -20,28 +21,32 @@ type BitcoinTransactionList struct {
 // BitcoinTransaction is the resource representing a Stripe bitcoin transaction.
 // For more details see https://stripe.com/docs/api/#bitcoin_receivers
 type BitcoinTransaction struct {
-	Amount        uint64   `json:"amount"`
-	BitcoinAmount uint64   `json:"bitcoin_amount"`
-	Created       int64    `json:"created"`
-	Currency      Currency `json:"currency"`
-	Customer      string   `json:"customer"`
-	ID            string   `json:"id"`
-	Receiver      string   `json:"receiver"`
+	APIResource
+	Amount        uint64    `json:"amount"`
+	BitcoinAmount uint64    `json:"bitcoin_amount"`
+	Created       Timestamp `json:"created"`
+	Currency      Currency  `json:"currency"`
+	Customer      string    `json:"customer"`
+	ID            string    `json:"id"`
+	Receiver      string    `json:"receiver"`
 }
 
 // UnmarshalJSON handles deserialization of a BitcoinTransaction.
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (bt *BitcoinTransaction) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		bt.ID = id
+		return nil
+	}
+
 	type bitcoinTransaction BitcoinTransaction
 	var t bitcoinTransaction
 	err := json.Unmarshal(data, &t)
-	if err == nil {
-		*bt = BitcoinTransaction(t)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		bt.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*bt = BitcoinTransaction(t)
 	return nil
 }
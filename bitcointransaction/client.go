@@ -59,5 +59,5 @@ func (i *Iter) BitcoinTransaction() *stripe.BitcoinTransaction {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -0,0 +1,249 @@
+package stripe
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stripe/stripe-go/form"
+)
+
+// CacheStore is the interface implemented by stores that can hold the
+// responses cached by a CachingBackend. Implementations must be safe for
+// concurrent use.
+type CacheStore interface {
+	// Get returns the value stored under key, or false if there's no value
+	// or it has expired.
+	Get(key string) ([]byte, bool)
+
+	// Set stores value under key for at most ttl.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// CacheRule describes a family of objects that can be cached: every object
+// retrieved from a path starting with Prefix is kept for TTL.
+type CacheRule struct {
+	Prefix string
+	TTL    time.Duration
+}
+
+// DefaultCacheRules are the rules used by NewCachingBackend. They cover
+// objects which never change once they've been created (balance
+// transactions and events), objects that change very rarely (country specs)
+// and exchange rates, which Stripe only updates every few minutes.
+var DefaultCacheRules = []CacheRule{
+	{Prefix: "/balance/history/", TTL: 24 * time.Hour},
+	{Prefix: "/balance_transactions/", TTL: 24 * time.Hour},
+	{Prefix: "/country_specs/", TTL: time.Hour},
+	{Prefix: "/events/", TTL: 24 * time.Hour},
+	{Prefix: "/exchange_rates/", TTL: 5 * time.Minute},
+}
+
+// CachingBackend is a Backend that keeps the responses of retrieve calls for
+// effectively immutable objects in a CacheStore, so that retrieving the same
+// object again doesn't need a request to Stripe. All other calls are passed
+// through to the wrapped backend unchanged.
+//
+// Responses are only cached when the wrapped backend decodes them as JSON,
+// which is the case for BackendConfiguration. LastResponse is set on objects
+// fetched from Stripe but not on objects served from the store, since no
+// request was made for them.
+type CachingBackend struct {
+	Backend Backend
+	Rules   []CacheRule
+	Store   CacheStore
+}
+
+// NewCachingBackend returns a CachingBackend wrapping b which caches the
+// objects described by DefaultCacheRules in store. A typical use is:
+//
+//	stripe.SetBackend(stripe.APIBackend, stripe.NewCachingBackend(
+//	    stripe.GetBackend(stripe.APIBackend), stripe.NewMemoryCacheStore()))
+func NewCachingBackend(b Backend, store CacheStore) *CachingBackend {
+	return &CachingBackend{Backend: b, Rules: DefaultCacheRules, Store: store}
+}
+
+// Call is the Backend.Call implementation for the caching backend.
+func (c *CachingBackend) Call(method, path, key string, body *form.Values, params *Params, v interface{}) error {
+	ttl, ok := c.ttl(method, path)
+	if !ok || v == nil {
+		return c.Backend.Call(method, path, key, body, params, v)
+	}
+
+	cacheKey := cacheKey(path, key, body, params)
+	if data, ok := c.Store.Get(cacheKey); ok {
+		return unmarshalResponse(data, v)
+	}
+
+	captured := &capturedResponse{}
+	if err := c.Backend.Call(method, path, key, body, params, captured); err != nil {
+		return err
+	}
+
+	if err := captured.decodeInto(v); err != nil {
+		return err
+	}
+
+	c.Store.Set(cacheKey, captured.data, ttl)
+	return nil
+}
+
+// CallMultipart is the Backend.CallMultipart implementation for the caching
+// backend. Multipart calls are never cached.
+func (c *CachingBackend) CallMultipart(method, path, key, boundary string, body io.Reader, params *Params, v interface{}) error {
+	return c.Backend.CallMultipart(method, path, key, boundary, body, params, v)
+}
+
+// CallStreaming is the StreamingBackend.CallStreaming implementation for the
+// caching backend. Streamed responses are never cached.
+func (c *CachingBackend) CallStreaming(method, path, key string, body *form.Values, params *Params) (io.ReadCloser, error) {
+	b, ok := c.Backend.(StreamingBackend)
+	if !ok {
+		return nil, errors.New("Wrapped backend doesn't support streaming responses.")
+	}
+
+	return b.CallStreaming(method, path, key, body, params)
+}
+
+// ttl returns how long the response to a request can be cached for, or false
+// if it can't be cached at all. Only retrieve calls are cached; lists and
+// nested resources aren't.
+func (c *CachingBackend) ttl(method, path string) (time.Duration, bool) {
+	if strings.ToUpper(method) != "GET" {
+		return 0, false
+	}
+
+	for _, rule := range c.Rules {
+		if !strings.HasPrefix(path, rule.Prefix) {
+			continue
+		}
+
+		id := path[len(rule.Prefix):]
+		if id == "" || strings.Contains(id, "/") {
+			return 0, false
+		}
+		return rule.TTL, true
+	}
+
+	return 0, false
+}
+
+// cacheKey builds the key that a response is stored under. Objects are
+// scoped to the API key and connected account they were retrieved with, and
+// to the parameters (like expansions) that they were retrieved with.
+//
+// The API key itself never appears in the cache key, since stores like Redis
+// can expose their keyspace; only a truncated hash of it does.
+func cacheKey(path, key string, body *form.Values, params *Params) string {
+	sum := sha256.Sum256([]byte(key))
+	parts := []string{hex.EncodeToString(sum[:16]), "", path, ""}
+
+	if params != nil {
+		parts[1] = params.StripeAccount
+		if parts[1] == "" {
+			parts[1] = params.Account
+		}
+	}
+
+	if body != nil {
+		parts[3] = body.Encode()
+	}
+
+	return strings.Join(parts, "\n")
+}
+
+// DefaultMemoryCacheStoreSize is the number of entries that a
+// MemoryCacheStore returned by NewMemoryCacheStore holds at most.
+const DefaultMemoryCacheStoreSize = 1000
+
+// MemoryCacheStore is a CacheStore that keeps values in memory. Once it holds
+// MaxEntries values, storing another evicts the least recently used one, so
+// that a long-running process doesn't grow without bound.
+type MemoryCacheStore struct {
+	// MaxEntries is the number of values the store holds at most. Zero means
+	// DefaultMemoryCacheStoreSize.
+	MaxEntries int
+
+	entries map[string]*list.Element
+	lru     *list.List
+	mu      sync.Mutex
+}
+
+type memoryCacheEntry struct {
+	expires time.Time
+	key     string
+	value   []byte
+}
+
+// NewMemoryCacheStore returns an empty MemoryCacheStore which holds at most
+// DefaultMemoryCacheStoreSize values.
+func NewMemoryCacheStore() *MemoryCacheStore {
+	return &MemoryCacheStore{MaxEntries: DefaultMemoryCacheStoreSize}
+}
+
+// Get is the CacheStore.Get implementation for the in-memory store.
+func (s *MemoryCacheStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*memoryCacheEntry)
+	if time.Now().After(entry.expires) {
+		s.remove(elem)
+		return nil, false
+	}
+
+	s.lru.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Len returns the number of values held by the store, including expired ones
+// that haven't been evicted yet.
+func (s *MemoryCacheStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.entries)
+}
+
+// Set is the CacheStore.Set implementation for the in-memory store.
+func (s *MemoryCacheStore) Set(key string, value []byte, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.entries == nil {
+		s.entries = make(map[string]*list.Element)
+		s.lru = list.New()
+	}
+
+	entry := &memoryCacheEntry{expires: time.Now().Add(ttl), key: key, value: value}
+	if elem, ok := s.entries[key]; ok {
+		elem.Value = entry
+		s.lru.MoveToFront(elem)
+		return
+	}
+	s.entries[key] = s.lru.PushFront(entry)
+
+	maxEntries := s.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = DefaultMemoryCacheStoreSize
+	}
+	for len(s.entries) > maxEntries {
+		s.remove(s.lru.Back())
+	}
+}
+
+// remove deletes an entry from the store. The caller must hold mu.
+func (s *MemoryCacheStore) remove(elem *list.Element) {
+	s.lru.Remove(elem)
+	delete(s.entries, elem.Value.(*memoryCacheEntry).key)
+}
@@ -0,0 +1,169 @@
+package stripe_test
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// countingBackend is a backend that answers every call with a balance
+// transaction and counts the calls made.
+type countingBackend struct {
+	calls int
+}
+
+func (b *countingBackend) Call(method, path, key string, body *form.Values, params *stripe.Params, v interface{}) error {
+	b.calls++
+	if err := json.Unmarshal([]byte(`{"id":"txn_123","amount":100}`), v); err != nil {
+		return err
+	}
+
+	if setter, ok := v.(stripe.LastResponseSetter); ok {
+		setter.SetLastResponse(&stripe.APIResponse{RequestID: "req_123", StatusCode: 200})
+	}
+	return nil
+}
+
+func (b *countingBackend) CallMultipart(method, path, key, boundary string, body io.Reader, params *stripe.Params, v interface{}) error {
+	return nil
+}
+
+func TestCachingBackend(t *testing.T) {
+	b := &countingBackend{}
+	c := stripe.NewCachingBackend(b, stripe.NewMemoryCacheStore())
+
+	for i := 0; i < 2; i++ {
+		tx := &stripe.Transaction{}
+		err := c.Call("GET", "/balance/history/txn_123", "sk_test_123", nil, nil, tx)
+		assert.NoError(t, err)
+		assert.Equal(t, "txn_123", tx.ID)
+		assert.Equal(t, int64(100), tx.Amount)
+	}
+	assert.Equal(t, 1, b.calls)
+
+	// Balance transactions are cached under both of their paths
+	for i := 0; i < 2; i++ {
+		err := c.Call("GET", "/balance_transactions/txn_123", "sk_test_123", nil, nil, &stripe.Transaction{})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, b.calls)
+
+	for i := 0; i < 2; i++ {
+		err := c.Call("GET", "/exchange_rates/usd", "sk_test_123", nil, nil, &stripe.ExchangeRate{})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 3, b.calls)
+
+	// Objects are scoped to the connected account they were retrieved for
+	err := c.Call("GET", "/balance/history/txn_123", "sk_test_123", nil,
+		&stripe.Params{StripeAccount: "acct_123"}, &stripe.Transaction{})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, b.calls)
+}
+
+func TestCachingBackendLastResponse(t *testing.T) {
+	b := &countingBackend{}
+	c := stripe.NewCachingBackend(b, stripe.NewMemoryCacheStore())
+
+	tx := &stripe.Transaction{}
+	err := c.Call("GET", "/balance/history/txn_123", "sk_test_123", nil, nil, tx)
+	assert.NoError(t, err)
+	assert.NotNil(t, tx.LastResponse)
+	assert.Equal(t, "req_123", tx.LastResponse.RequestID)
+
+	// No request is made for objects served from the store
+	tx = &stripe.Transaction{}
+	err = c.Call("GET", "/balance/history/txn_123", "sk_test_123", nil, nil, tx)
+	assert.NoError(t, err)
+	assert.Equal(t, "txn_123", tx.ID)
+	assert.Nil(t, tx.LastResponse)
+}
+
+func TestCachingBackendPassThrough(t *testing.T) {
+	b := &countingBackend{}
+	c := stripe.NewCachingBackend(b, stripe.NewMemoryCacheStore())
+
+	// Lists, writes and objects without a rule are never cached
+	calls := []struct{ method, path string }{
+		{"GET", "/balance/history"},
+		{"GET", "/balance/history"},
+		{"GET", "/exchange_rates"},
+		{"GET", "/exchange_rates"},
+		{"POST", "/events/evt_123"},
+		{"POST", "/events/evt_123"},
+		{"GET", "/charges/ch_123"},
+		{"GET", "/charges/ch_123"},
+	}
+	for _, call := range calls {
+		err := c.Call(call.method, call.path, "sk_test_123", nil, nil, &stripe.Transaction{})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, len(calls), b.calls)
+}
+
+func TestMemoryCacheStore(t *testing.T) {
+	s := stripe.NewMemoryCacheStore()
+
+	s.Set("fresh", []byte("a"), time.Hour)
+	s.Set("stale", []byte("b"), -time.Second)
+
+	value, ok := s.Get("fresh")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("a"), value)
+
+	_, ok = s.Get("stale")
+	assert.False(t, ok)
+
+	_, ok = s.Get("missing")
+	assert.False(t, ok)
+}
+
+// recordingStore is a store that records the keys it's given.
+type recordingStore struct {
+	*stripe.MemoryCacheStore
+	keys []string
+}
+
+func (s *recordingStore) Set(key string, value []byte, ttl time.Duration) {
+	s.keys = append(s.keys, key)
+	s.MemoryCacheStore.Set(key, value, ttl)
+}
+
+func TestCachingBackendKeyHidesAPIKey(t *testing.T) {
+	store := &recordingStore{MemoryCacheStore: stripe.NewMemoryCacheStore()}
+	c := stripe.NewCachingBackend(&countingBackend{}, store)
+
+	err := c.Call("GET", "/balance/history/txn_123", "sk_test_secret", nil, nil, &stripe.Transaction{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(store.keys))
+	assert.False(t, strings.Contains(store.keys[0], "sk_test_secret"))
+	assert.True(t, strings.Contains(store.keys[0], "/balance/history/txn_123"))
+}
+
+func TestMemoryCacheStoreEvictsLeastRecentlyUsed(t *testing.T) {
+	s := stripe.NewMemoryCacheStore()
+	s.MaxEntries = 2
+
+	s.Set("a", []byte("a"), time.Hour)
+	s.Set("b", []byte("b"), time.Hour)
+
+	// Reading a makes b the least recently used entry
+	_, ok := s.Get("a")
+	assert.True(t, ok)
+
+	s.Set("c", []byte("c"), time.Hour)
+	assert.Equal(t, 2, s.Len())
+
+	_, ok = s.Get("b")
+	assert.False(t, ok)
+	_, ok = s.Get("a")
+	assert.True(t, ok)
+	_, ok = s.Get("c")
+	assert.True(t, ok)
+}
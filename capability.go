@@ -0,0 +1,61 @@
+package stripe
+
+import "encoding/json"
+
+// CapabilityParams is the set of parameters that can be used when updating a
+// capability of an account.
+// For more details see https://stripe.com/docs/api#update_capability.
+type CapabilityParams struct {
+	Params    `form:"*"`
+	Account   string `form:"-"` // Included in URL
+	Requested *bool  `form:"requested"`
+}
+
+// CapabilityListParams is the set of parameters that can be used when listing
+// the capabilities of an account.
+// For more details see https://stripe.com/docs/api#list_capabilities.
+type CapabilityListParams struct {
+	ListParams `form:"*"`
+	Account    string `form:"-"` // Included in URL
+}
+
+// Capability is the resource representing a capability of a connected
+// account, like "card_payments" or "transfers". Its ID is the name of the
+// capability.
+// For more details see https://stripe.com/docs/api#capabilities.
+type Capability struct {
+	APIResource
+	Account      *Account                `json:"account"`
+	ID           string                  `json:"id"`
+	Requested    bool                    `json:"requested"`
+	RequestedAt  Timestamp               `json:"requested_at"`
+	Requirements *AccountRequirements    `json:"requirements"`
+	Status       AccountCapabilityStatus `json:"status"`
+}
+
+// CapabilityList is a list of capabilities as retrieved from a list endpoint.
+type CapabilityList struct {
+	APIResource
+	ListMeta
+	Values []*Capability `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a Capability.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (c *Capability) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		c.ID = id
+		return nil
+	}
+
+	type capability Capability
+	var cc capability
+	err := json.Unmarshal(data, &cc)
+	if err != nil {
+		return err
+	}
+
+	*c = Capability(cc)
+	return nil
+}
@@ -0,0 +1,106 @@
+// Package capability provides the /accounts/capabilities APIs
+package capability
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /accounts/capabilities APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of a capability.
+// For more details see https://stripe.com/docs/api#retrieve_capability.
+func Get(id string, params *stripe.CapabilityParams) (*stripe.Capability, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.CapabilityParams) (*stripe.Capability, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Account == "" {
+		return nil, fmt.Errorf("params.Account must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	capability := &stripe.Capability{}
+	err := c.B.Call("GET", fmt.Sprintf("/accounts/%v/capabilities/%v", params.Account, id), c.Key, body, &params.Params, capability)
+
+	return capability, err
+}
+
+// Update updates a capability, typically to request it for the account.
+// For more details see https://stripe.com/docs/api#update_capability.
+func Update(id string, params *stripe.CapabilityParams) (*stripe.Capability, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.CapabilityParams) (*stripe.Capability, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Account == "" {
+		return nil, fmt.Errorf("params.Account must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	capability := &stripe.Capability{}
+	err := c.B.Call("POST", fmt.Sprintf("/accounts/%v/capabilities/%v", params.Account, id), c.Key, body, &params.Params, capability)
+
+	return capability, err
+}
+
+// List returns a list of capabilities.
+// For more details see https://stripe.com/docs/api#list_capabilities.
+func List(params *stripe.CapabilityListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.CapabilityListParams) *Iter {
+	body := &form.Values{}
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+	p = params.ToParams()
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.CapabilityList{}
+		err := c.B.Call("GET", fmt.Sprintf("/accounts/%v/capabilities", params.Account), c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of Capabilities.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Capability returns the most recent Capability
+// visited by a call to Next.
+func (i *Iter) Capability() *stripe.Capability {
+	return i.Current().(*stripe.Capability)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
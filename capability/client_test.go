@@ -0,0 +1,39 @@
+package capability
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestCapabilityGet(t *testing.T) {
+	capability, err := Get("card_payments", &stripe.CapabilityParams{Account: "acct_123"})
+	assert.Nil(t, err)
+	assert.NotNil(t, capability)
+}
+
+func TestCapabilityGetNoAccount(t *testing.T) {
+	_, err := Get("card_payments", &stripe.CapabilityParams{})
+	assert.NotNil(t, err)
+}
+
+func TestCapabilityList(t *testing.T) {
+	i := List(&stripe.CapabilityListParams{Account: "acct_123"})
+
+	// Verify that we can get at least one capability
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.Capability())
+}
+
+func TestCapabilityUpdate(t *testing.T) {
+	requested := true
+	capability, err := Update("card_payments", &stripe.CapabilityParams{
+		Account:   "acct_123",
+		Requested: &requested,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, capability)
+}
@@ -147,6 +147,7 @@ type CardListParams struct {
 // Card is the resource representing a Stripe credit/debit card.
 // For more details see https://stripe.com/docs/api#cards.
 type Card struct {
+	APIResource
 	Address1      string       `json:"address_line1"`
 	Address1Check Verification `json:"address_line1_check"`
 	Address2      string       `json:"address_line2"`
@@ -198,6 +199,7 @@ type Card struct {
 
 // CardList is a list object for cards.
 type CardList struct {
+	APIResource
 	ListMeta
 	Values []*Card `json:"data"`
 }
@@ -206,15 +208,18 @@ type CardList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (c *Card) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		c.ID = id
+		return nil
+	}
+
 	type card Card
 	var cc card
 	err := json.Unmarshal(data, &cc)
-	if err == nil {
-		*c = Card(cc)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		c.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*c = Card(cc)
 	return nil
 }
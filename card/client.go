@@ -18,9 +18,10 @@ const (
 	JCB          stripe.CardBrand = "JCB"
 	DinersClub   stripe.CardBrand = "Diners Club"
 
-	Pass      stripe.Verification = "pass"
-	Fail      stripe.Verification = "fail"
-	Unchecked stripe.Verification = "unchecked"
+	Pass        stripe.Verification = "pass"
+	Fail        stripe.Verification = "fail"
+	Unchecked   stripe.Verification = "unchecked"
+	Unavailable stripe.Verification = "unavailable"
 
 	Credit         stripe.CardFunding = "credit"
 	Debit          stripe.CardFunding = "debit"
@@ -207,5 +208,5 @@ func (i *Iter) Card() *stripe.Card {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
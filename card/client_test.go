@@ -6,6 +6,7 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestCardDel(t *testing.T) {
@@ -24,6 +25,23 @@ func TestCardGet(t *testing.T) {
 	assert.NotNil(t, card)
 }
 
+func TestCardGetChecks(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/customers/cus_123/cards/card_123", `{
+		"id": "card_123",
+		"address_zip_check": "unavailable",
+		"cvc_check": "fail",
+		"funding": "prepaid"
+	}`)
+
+	c := Client{B: b, Key: "sk_test_123"}
+	card, err := c.Get("card_123", &stripe.CardParams{Customer: "cus_123"})
+	assert.NoError(t, err)
+	assert.Equal(t, Fail, card.CVCCheck)
+	assert.Equal(t, Prepaid, card.Funding)
+	assert.Equal(t, Unavailable, card.ZipCheck)
+}
+
 func TestCardListByCustomer(t *testing.T) {
 	i := List(&stripe.CardListParams{Customer: "cus_123"})
 
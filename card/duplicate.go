@@ -0,0 +1,110 @@
+package card
+
+import (
+	"errors"
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/token"
+)
+
+// UniqueResult is the result of NewUnique.
+type UniqueResult struct {
+	// Card is the card that the customer should use. It's the newly attached
+	// card if Created is true, and the existing duplicate otherwise.
+	Card *stripe.Card
+
+	// Created is true if the card was attached to the customer.
+	Created bool
+
+	// Detached contains the duplicates that were removed from the customer
+	// in favor of the new card.
+	Detached []*stripe.Card
+
+	// Duplicates contains the customer's cards that had the same fingerprint
+	// as the new card before it was attached.
+	Duplicates []*stripe.Card
+}
+
+// Duplicates returns the cards of a customer that have the given
+// fingerprint. Cards with the same fingerprint have the same card number, even
+// if they were tokenized separately.
+func Duplicates(params *stripe.CardListParams, fingerprint string) ([]*stripe.Card, error) {
+	return getC().Duplicates(params, fingerprint)
+}
+
+func (c Client) Duplicates(params *stripe.CardListParams, fingerprint string) ([]*stripe.Card, error) {
+	var duplicates []*stripe.Card
+
+	i := c.List(params)
+	for i.Next() {
+		card := i.Card()
+		if card.Fingerprint == fingerprint {
+			duplicates = append(duplicates, card)
+		}
+	}
+
+	return duplicates, i.Err()
+}
+
+// NewUnique attaches the card tokenized in params.Token to params.Customer
+// unless the customer already has a card with the same fingerprint, in which
+// case the existing card is returned instead so that the same card is never
+// saved twice.
+//
+// If replace is true, the new card is attached regardless and the older
+// duplicates are detached from the customer afterwards. This is useful to
+// pick up a new expiry date or billing address for a card that's already on
+// file.
+func NewUnique(params *stripe.CardParams, replace bool) (*UniqueResult, error) {
+	return getC().NewUnique(params, replace)
+}
+
+func (c Client) NewUnique(params *stripe.CardParams, replace bool) (*UniqueResult, error) {
+	if params == nil || params.Customer == "" || params.Token == "" {
+		return nil, errors.New("params.Customer and params.Token must be set")
+	}
+
+	tokenParams := &stripe.TokenParams{}
+	tokenParams.StripeAccount = params.StripeAccount
+
+	tok, err := token.Client{B: c.B, Key: c.Key}.Get(params.Token, tokenParams)
+	if err != nil {
+		return nil, err
+	}
+	if tok.Card == nil {
+		return nil, fmt.Errorf("token %v isn't a card token", params.Token)
+	}
+
+	listParams := &stripe.CardListParams{Customer: params.Customer}
+	listParams.StripeAccount = params.StripeAccount
+
+	duplicates, err := c.Duplicates(listParams, tok.Card.Fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UniqueResult{Duplicates: duplicates}
+	if len(duplicates) > 0 && !replace {
+		result.Card = duplicates[0]
+		return result, nil
+	}
+
+	result.Card, err = c.New(params)
+	if err != nil {
+		return result, err
+	}
+	result.Created = true
+
+	for _, duplicate := range duplicates {
+		delParams := &stripe.CardParams{Customer: params.Customer}
+		delParams.StripeAccount = params.StripeAccount
+
+		if _, err := c.Del(duplicate.ID, delParams); err != nil {
+			return result, err
+		}
+		result.Detached = append(result.Detached, duplicate)
+	}
+
+	return result, nil
+}
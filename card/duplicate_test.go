@@ -0,0 +1,75 @@
+package card
+
+import (
+	"io"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// walletBackend is a backend holding the cards of a single customer. It
+// records the method and path of every call.
+type walletBackend struct {
+	calls []string
+	cards []*stripe.Card
+}
+
+func (b *walletBackend) Call(method, path, key string, body *form.Values, params *stripe.Params, v interface{}) error {
+	b.calls = append(b.calls, method+" "+path)
+
+	switch v := v.(type) {
+	case *stripe.Token:
+		v.Card = &stripe.Card{Fingerprint: "fp_dup"}
+	case *stripe.CardList:
+		v.Values = b.cards
+	case *stripe.Card:
+		v.ID = "card_new"
+	}
+	return nil
+}
+
+func (b *walletBackend) CallMultipart(method, path, key, boundary string, body io.Reader, params *stripe.Params, v interface{}) error {
+	return nil
+}
+
+func TestCardDuplicateExisting(t *testing.T) {
+	b := &walletBackend{cards: []*stripe.Card{
+		{ID: "card_other", Fingerprint: "fp_other"},
+		{ID: "card_old", Fingerprint: "fp_dup"},
+	}}
+	c := Client{B: b}
+
+	result, err := c.NewUnique(&stripe.CardParams{Customer: "cus_123", Token: "tok_123"}, false)
+	assert.Nil(t, err)
+	assert.False(t, result.Created)
+	assert.Equal(t, "card_old", result.Card.ID)
+	assert.Equal(t, 1, len(result.Duplicates))
+	assert.Equal(t, []string{"GET /tokens/tok_123", "GET /customers/cus_123/cards"}, b.calls)
+}
+
+func TestCardDuplicateReplace(t *testing.T) {
+	b := &walletBackend{cards: []*stripe.Card{
+		{ID: "card_old", Fingerprint: "fp_dup"},
+	}}
+	c := Client{B: b}
+
+	result, err := c.NewUnique(&stripe.CardParams{Customer: "cus_123", Token: "tok_123"}, true)
+	assert.Nil(t, err)
+	assert.True(t, result.Created)
+	assert.Equal(t, "card_new", result.Card.ID)
+	assert.Equal(t, 1, len(result.Detached))
+	assert.Equal(t, "DELETE /customers/cus_123/cards/card_old", b.calls[len(b.calls)-1])
+}
+
+func TestCardDuplicateNone(t *testing.T) {
+	b := &walletBackend{}
+	c := Client{B: b}
+
+	result, err := c.NewUnique(&stripe.CardParams{Customer: "cus_123", Token: "tok_123"}, false)
+	assert.Nil(t, err)
+	assert.True(t, result.Created)
+	assert.Equal(t, "card_new", result.Card.ID)
+	assert.Equal(t, 0, len(result.Duplicates))
+}
@@ -15,21 +15,22 @@ type FraudReport string
 // ChargeParams is the set of parameters that can be used when creating or updating a charge.
 // For more details see https://stripe.com/docs/api#create_charge and https://stripe.com/docs/api#update_charge.
 type ChargeParams struct {
-	Params        `form:"*"`
-	Amount        uint64              `form:"amount"`
-	Currency      Currency            `form:"currency"`
-	Customer      string              `form:"customer"`
-	Desc          string              `form:"description"`
-	Destination   *DestinationParams  `form:"destination"`
-	Email         string              `form:"receipt_email"`
-	Fee           uint64              `form:"application_fee"`
-	FraudDetails  *FraudDetailsParams `form:"fraud_details"`
-	NoCapture     bool                `form:"capture,invert"`
-	OnBehalfOf    string              `form:"on_behalf_of"`
-	Shipping      *ShippingDetails    `form:"shipping"`
-	Source        *SourceParams       `form:"*"` // SourceParams has custom encoding so brought to top level with "*"
-	Statement     string              `form:"statement_descriptor"`
-	TransferGroup string              `form:"transfer_group"`
+	Params          `form:"*"`
+	Amount          uint64              `form:"amount"`
+	Currency        Currency            `form:"currency"`
+	Customer        string              `form:"customer"`
+	Desc            string              `form:"description"`
+	Destination     *DestinationParams  `form:"destination"`
+	Email           string              `form:"receipt_email"`
+	Fee             uint64              `form:"application_fee"`
+	FraudDetails    *FraudDetailsParams `form:"fraud_details"`
+	NoCapture       bool                `form:"capture,invert"`
+	OnBehalfOf      string              `form:"on_behalf_of"`
+	Shipping        *ShippingDetails    `form:"shipping"`
+	Source          *SourceParams       `form:"*"` // SourceParams has custom encoding so brought to top level with "*"
+	Statement       string              `form:"statement_descriptor"`
+	StatementSuffix string              `form:"statement_descriptor_suffix"`
+	TransferGroup   string              `form:"transfer_group"`
 }
 
 // SetSource adds valid sources to a ChargeParams object,
@@ -57,75 +58,129 @@ type ChargeListParams struct {
 	Created       int64             `form:"created"`
 	CreatedRange  *RangeQueryParams `form:"created"`
 	Customer      string            `form:"customer"`
+	PaymentIntent string            `form:"payment_intent"`
 	TransferGroup string            `form:"transfer_group"`
 }
 
 // CaptureParams is the set of parameters that can be used when capturing a charge.
 // For more details see https://stripe.com/docs/api#charge_capture.
 type CaptureParams struct {
-	Params    `form:"*"`
-	Amount    uint64 `form:"amount"`
-	Email     string `form:"receipt_email"`
-	Fee       uint64 `form:"application_fee"`
-	Statement string `form:"statement_descriptor"`
+	Params          `form:"*"`
+	Amount          uint64 `form:"amount"`
+	Email           string `form:"receipt_email"`
+	Fee             uint64 `form:"application_fee"`
+	Statement       string `form:"statement_descriptor"`
+	StatementSuffix string `form:"statement_descriptor_suffix"`
+}
+
+// ChargePaymentMethodDetailsCardMulticapture describes whether a card charge
+// can be captured more than once.
+type ChargePaymentMethodDetailsCardMulticapture struct {
+	Status string `json:"status"`
+}
+
+// ChargePaymentMethodDetailsCardOvercapture describes whether a card charge
+// can be captured for more than its authorized amount, and up to how much.
+type ChargePaymentMethodDetailsCardOvercapture struct {
+	MaximumAmountCapturable uint64 `json:"maximum_amount_capturable"`
+	Status                  string `json:"status"`
+}
+
+// ChargePaymentMethodDetailsCard is the card-specific information about the
+// payment method of a charge.
+type ChargePaymentMethodDetailsCard struct {
+	AmountAuthorized uint64                                      `json:"amount_authorized"`
+	Brand            string                                      `json:"brand"`
+	Last4            string                                      `json:"last4"`
+	Multicapture     *ChargePaymentMethodDetailsCardMulticapture `json:"multicapture"`
+	Overcapture      *ChargePaymentMethodDetailsCardOvercapture  `json:"overcapture"`
+}
+
+// ChargePaymentMethodDetails is the information about the payment method of
+// a charge at the time of the transaction. Type indicates which of the other
+// fields, if any, is filled in.
+type ChargePaymentMethodDetails struct {
+	Card *ChargePaymentMethodDetailsCard `json:"card"`
+	Type string                          `json:"type"`
+}
+
+// BillingDetails is the billing information associated with the payment
+// method of a charge.
+type BillingDetails struct {
+	Address *Address `json:"address"`
+	Email   string   `json:"email"`
+	Name    string   `json:"name"`
+	Phone   string   `json:"phone"`
 }
 
 // Charge is the resource representing a Stripe charge.
 // For more details see https://stripe.com/docs/api#charges.
 type Charge struct {
-	Amount         uint64            `json:"amount"`
-	AmountRefunded uint64            `json:"amount_refunded"`
-	Application    *Application      `json:"application"`
-	Captured       bool              `json:"captured"`
-	Created        int64             `json:"created"`
-	Currency       Currency          `json:"currency"`
-	Customer       *Customer         `json:"customer"`
-	Desc           string            `json:"description"`
-	Dest           *Account          `json:"destination"`
-	Dispute        *Dispute          `json:"dispute"`
-	Email          string            `json:"receipt_email"`
-	FailCode       string            `json:"failure_code"`
-	FailMsg        string            `json:"failure_message"`
-	Fee            *Fee              `json:"application_fee"`
-	FraudDetails   *FraudDetails     `json:"fraud_details"`
-	ID             string            `json:"id"`
-	Invoice        *Invoice          `json:"invoice"`
-	Live           bool              `json:"livemode"`
-	Meta           map[string]string `json:"metadata"`
-	Outcome        *ChargeOutcome    `json:"outcome"`
-	Paid           bool              `json:"paid"`
-	ReceiptNumber  string            `json:"receipt_number"`
-	Refunded       bool              `json:"refunded"`
-	Refunds        *RefundList       `json:"refunds"`
-	Review         *Review           `json:"review"`
-	Shipping       *ShippingDetails  `json:"shipping"`
-	Source         *PaymentSource    `json:"source"`
-	SourceTransfer *Transfer         `json:"source_transfer"`
-	Statement      string            `json:"statement_descriptor"`
-	Status         string            `json:"status"`
-	Transfer       *Transfer         `json:"transfer"`
-	TransferGroup  string            `json:"transfer_group"`
-	Tx             *Transaction      `json:"balance_transaction"`
+	APIResource
+	Amount               uint64                      `json:"amount"`
+	AmountCaptured       uint64                      `json:"amount_captured"`
+	AmountRefunded       uint64                      `json:"amount_refunded"`
+	Application          *Application                `json:"application"`
+	BillingDetails       *BillingDetails             `json:"billing_details"`
+	CalculatedStatement  string                      `json:"calculated_statement_descriptor"`
+	Captured             bool                        `json:"captured"`
+	Created              Timestamp                   `json:"created"`
+	Currency             Currency                    `json:"currency"`
+	Customer             *Customer                   `json:"customer"`
+	Desc                 string                      `json:"description"`
+	Dest                 *Account                    `json:"destination"`
+	Dispute              *Dispute                    `json:"dispute"`
+	Email                string                      `json:"receipt_email"`
+	FailCode             string                      `json:"failure_code"`
+	FailMsg              string                      `json:"failure_message"`
+	Fee                  *Fee                        `json:"application_fee"`
+	FraudDetails         *FraudDetails               `json:"fraud_details"`
+	ID                   string                      `json:"id"`
+	Invoice              *Invoice                    `json:"invoice"`
+	Live                 bool                        `json:"livemode"`
+	Meta                 map[string]string           `json:"metadata"`
+	Outcome              *ChargeOutcome              `json:"outcome"`
+	Paid                 bool                        `json:"paid"`
+	PaymentIntent        *PaymentIntent              `json:"payment_intent"`
+	PaymentMethodDetails *ChargePaymentMethodDetails `json:"payment_method_details"`
+	ReceiptNumber        string                      `json:"receipt_number"`
+	Refunded             bool                        `json:"refunded"`
+	Refunds              *RefundList                 `json:"refunds"`
+	Review               *Review                     `json:"review"`
+	Shipping             *ShippingDetails            `json:"shipping"`
+	Source               *PaymentSource              `json:"source"`
+	SourceTransfer       *Transfer                   `json:"source_transfer"`
+	Statement            string                      `json:"statement_descriptor"`
+	StatementSuffix      string                      `json:"statement_descriptor_suffix"`
+	Status               string                      `json:"status"`
+	Transfer             *Transfer                   `json:"transfer"`
+	TransferGroup        string                      `json:"transfer_group"`
+	Tx                   *Transaction                `json:"balance_transaction"`
 }
 
 // UnmarshalJSON handles deserialization of a charge.
 // This custom unmarshaling is needed because the resulting
 // property may be an ID or the full struct if it was expanded.
 func (c *Charge) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		c.ID = id
+		return nil
+	}
+
 	type charge Charge
 	var cc charge
 	err := json.Unmarshal(data, &cc)
-	if err == nil {
-		*c = Charge(cc)
-	} else {
-		// the ID is surrounded by "\" characters, so strip them
-		c.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
+
+	*c = Charge(cc)
 	return nil
 }
 
 // ChargeList is a list of charges as retrieved from a list endpoint.
 type ChargeList struct {
+	APIResource
 	ListMeta
 	Values []*Charge `json:"data"`
 }
@@ -169,15 +224,18 @@ var depth int = -1
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (c *ChargeOutcomeRule) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		c.ID = id
+		return nil
+	}
+
 	type chargeOutcomeRule ChargeOutcomeRule
 	var cc chargeOutcomeRule
 	err := json.Unmarshal(data, &cc)
-	if err == nil {
-		*c = ChargeOutcomeRule(cc)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		c.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*c = ChargeOutcomeRule(cc)
 	return nil
 }
@@ -0,0 +1,158 @@
+package charge
+
+import (
+	"time"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/refund"
+)
+
+// DefaultCaptureWindow is how long an uncaptured charge remains capturable
+// when its payment method doesn't have an entry in CaptureWindows. After this
+// window the authorization expires and the charge is refunded automatically.
+const DefaultCaptureWindow = 7 * 24 * time.Hour
+
+// CaptureWindows maps payment method types (the type of a charge's source,
+// like "card", the type of a source object, or a payment method type of a
+// payment intent) to how long an uncaptured charge made with them remains
+// capturable. It may be amended if some of the payment methods in use have a
+// different window.
+var CaptureWindows = map[string]time.Duration{
+	string(stripe.PaymentSourceCard): DefaultCaptureWindow,
+}
+
+// NeedsCapture returns true if the charge was authorized but hasn't been
+// captured, refunded, or failed yet.
+func NeedsCapture(ch *stripe.Charge) bool {
+	return ch != nil && !ch.Captured && !ch.Refunded && ch.Status != "failed"
+}
+
+// CaptureBefore returns the time by which an uncaptured charge must be
+// captured before its authorization expires. It returns a zero time if the
+// charge doesn't need to be captured.
+func CaptureBefore(ch *stripe.Charge) time.Time {
+	if !NeedsCapture(ch) {
+		return time.Time{}
+	}
+
+	return ch.Created.Time().Add(captureWindow(ch))
+}
+
+// ExpiringCharges lists charges and returns the ones that need to be captured
+// within the given duration from now. Params may be used to narrow down the
+// charges that are listed; if it doesn't specify a creation range, only
+// charges created recently enough to still be capturable are listed.
+func ExpiringCharges(params *stripe.ChargeListParams, within time.Duration) ([]*stripe.Charge, error) {
+	return getC().ExpiringCharges(params, within)
+}
+
+func (c Client) ExpiringCharges(params *stripe.ChargeListParams, within time.Duration) ([]*stripe.Charge, error) {
+	p := stripe.ChargeListParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.Created == 0 && p.CreatedRange == nil {
+		p.CreatedRange = &stripe.RangeQueryParams{
+			GreaterThanOrEqual: time.Now().Add(-longestCaptureWindow()).Unix(),
+		}
+	}
+
+	deadline := time.Now().Add(within)
+
+	var charges []*stripe.Charge
+	i := c.List(&p)
+	for i.Next() {
+		ch := i.Charge()
+		captureBefore := CaptureBefore(ch)
+		if !captureBefore.IsZero() && !captureBefore.After(deadline) {
+			charges = append(charges, ch)
+		}
+	}
+
+	return charges, i.Err()
+}
+
+// SettleResult is the result of settling a single charge with Settle.
+type SettleResult struct {
+	// Captured is the captured charge, if the charge was captured.
+	Captured *stripe.Charge
+
+	// Charge is the charge that was settled.
+	Charge *stripe.Charge
+
+	// Err is the error encountered while capturing or canceling the charge,
+	// if any.
+	Err error
+
+	// Refund is the refund that released the authorization, if the charge was
+	// canceled.
+	Refund *stripe.Refund
+}
+
+// Settle captures or cancels each of the given uncaptured charges, which
+// will typically have come from ExpiringCharges. The capture function decides
+// what happens to each charge: it's captured in full if capture returns true,
+// and canceled by refunding it (which releases the authorization) otherwise.
+//
+// Charges are settled one after the other, and an error settling one of them
+// doesn't prevent the others from being settled; check the Err of each result.
+//
+// Only the StripeAccount of params is used, for charges of a connected
+// account; params may be nil. Uncaptured payment intents are settled with
+// paymentintent.Settle instead.
+func Settle(charges []*stripe.Charge, capture func(*stripe.Charge) bool, params *stripe.Params) []*SettleResult {
+	return getC().Settle(charges, capture, params)
+}
+
+func (c Client) Settle(charges []*stripe.Charge, capture func(*stripe.Charge) bool, params *stripe.Params) []*SettleResult {
+	refunds := refund.Client{B: c.B, Key: c.Key}
+
+	var stripeAccount string
+	if params != nil {
+		stripeAccount = params.StripeAccount
+	}
+
+	results := make([]*SettleResult, len(charges))
+	for i, ch := range charges {
+		result := &SettleResult{Charge: ch}
+
+		if capture(ch) {
+			captureParams := &stripe.CaptureParams{}
+			captureParams.StripeAccount = stripeAccount
+			result.Captured, result.Err = c.Capture(ch.ID, captureParams)
+		} else {
+			refundParams := &stripe.RefundParams{Charge: ch.ID}
+			refundParams.StripeAccount = stripeAccount
+			result.Refund, result.Err = refunds.New(refundParams)
+		}
+
+		results[i] = result
+	}
+
+	return results
+}
+
+func captureWindow(ch *stripe.Charge) time.Duration {
+	if ch.Source != nil {
+		paymentMethodType := string(ch.Source.Type)
+		if ch.Source.Type == stripe.PaymentSourceObject && ch.Source.SourceObject != nil {
+			paymentMethodType = ch.Source.SourceObject.Type
+		}
+
+		if window, ok := CaptureWindows[paymentMethodType]; ok {
+			return window
+		}
+	}
+
+	return DefaultCaptureWindow
+}
+
+func longestCaptureWindow() time.Duration {
+	longest := DefaultCaptureWindow
+	for _, window := range CaptureWindows {
+		if window > longest {
+			longest = window
+		}
+	}
+	return longest
+}
@@ -0,0 +1,77 @@
+package charge
+
+import (
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestCaptureBefore(t *testing.T) {
+	created := time.Date(2017, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	ch := &stripe.Charge{
+		Created: stripe.NewTimestamp(created),
+		Source:  &stripe.PaymentSource{Type: stripe.PaymentSourceCard},
+	}
+	assert.Equal(t, created.Add(DefaultCaptureWindow).Unix(), CaptureBefore(ch).Unix())
+
+	// Captured charges don't have a deadline
+	ch.Captured = true
+	assert.True(t, CaptureBefore(ch).IsZero())
+}
+
+func TestCaptureBefore_SourceObject(t *testing.T) {
+	created := time.Date(2017, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	CaptureWindows["test_method"] = 2 * time.Hour
+	defer delete(CaptureWindows, "test_method")
+
+	ch := &stripe.Charge{
+		Created: stripe.NewTimestamp(created),
+		Source: &stripe.PaymentSource{
+			Type:         stripe.PaymentSourceObject,
+			SourceObject: &stripe.Source{Type: "test_method"},
+		},
+	}
+	assert.Equal(t, created.Add(2*time.Hour).Unix(), CaptureBefore(ch).Unix())
+}
+
+func TestSettle(t *testing.T) {
+	charges := []*stripe.Charge{{ID: "ch_capture"}, {ID: "ch_cancel"}}
+
+	results := Settle(charges, func(ch *stripe.Charge) bool {
+		return ch.ID == "ch_capture"
+	}, nil)
+	assert.Equal(t, 2, len(results))
+
+	assert.Nil(t, results[0].Err)
+	assert.NotNil(t, results[0].Captured)
+	assert.Nil(t, results[0].Refund)
+
+	assert.Nil(t, results[1].Err)
+	assert.Nil(t, results[1].Captured)
+	assert.NotNil(t, results[1].Refund)
+}
+
+func TestSettleStripeAccount(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/charges/ch_capture/capture", `{"id": "ch_capture", "captured": true}`)
+	b.Respond("POST", "/refunds", `{"id": "re_123", "charge": "ch_cancel"}`)
+
+	charges := []*stripe.Charge{{ID: "ch_capture"}, {ID: "ch_cancel"}}
+	results := Client{B: b}.Settle(charges, func(ch *stripe.Charge) bool {
+		return ch.ID == "ch_capture"
+	}, &stripe.Params{StripeAccount: "acct_123"})
+	assert.Nil(t, results[0].Err)
+	assert.Nil(t, results[1].Err)
+
+	// Both the capture and the refund are made on the connected account
+	calls := b.Calls()
+	assert.Equal(t, 2, len(calls))
+	assert.Equal(t, "acct_123", calls[0].Params.StripeAccount)
+	assert.Equal(t, "acct_123", calls[1].Params.StripeAccount)
+	assert.Equal(t, "ch_cancel", calls[1].Values().Get("charge"))
+}
@@ -133,6 +133,24 @@ func (c Client) List(params *stripe.ChargeListParams) *Iter {
 	})}
 }
 
+// ListByPaymentIntent returns a list of the charges created by a payment
+// intent, which are usually retries of the payment. The other filters of
+// params, which may be nil, still apply.
+func ListByPaymentIntent(id string, params *stripe.ChargeListParams) *Iter {
+	return getC().ListByPaymentIntent(id, params)
+}
+
+func (c Client) ListByPaymentIntent(id string, params *stripe.ChargeListParams) *Iter {
+	// Copy the params so that the caller's aren't changed
+	var p stripe.ChargeListParams
+	if params != nil {
+		p = *params
+	}
+	p.PaymentIntent = id
+
+	return c.List(&p)
+}
+
 // MarkFraudulent reports the charge as fraudulent.
 func MarkFraudulent(id string) (*stripe.Charge, error) {
 	return getC().MarkFraudulent(id)
@@ -214,5 +232,5 @@ func (i *Iter) Charge() *stripe.Charge {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
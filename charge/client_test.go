@@ -6,6 +6,7 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestChargeCapture(t *testing.T) {
@@ -35,6 +36,24 @@ func TestChargeList(t *testing.T) {
 	assert.NotNil(t, i.Charge())
 }
 
+func TestChargeListByPaymentIntent(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/charges", `{"data": [{"id": "ch_123", "payment_intent": "pi_123"}]}`)
+
+	i := Client{B: b}.ListByPaymentIntent("pi_123", nil)
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, "pi_123", i.Charge().PaymentIntentID())
+	assert.Equal(t, "pi_123", b.LastCall().Values().Get("payment_intent"))
+
+	// The caller's params aren't changed
+	params := &stripe.ChargeListParams{Customer: "cus_123"}
+	i = Client{B: b}.ListByPaymentIntent("pi_123", params)
+	assert.True(t, i.Next())
+	assert.Equal(t, "", params.PaymentIntent)
+	assert.Equal(t, "cus_123", b.LastCall().Values().Get("customer"))
+}
+
 func TestChargeMarkFraudulent(t *testing.T) {
 	charge, err := MarkFraudulent("ch_123")
 	assert.Nil(t, err)
@@ -0,0 +1,235 @@
+package charge
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	stripe "github.com/stripe/stripe-go"
+)
+
+// OutcomeStatus is the final classification of a charge attempt made through
+// CreateWithRetry.
+type OutcomeStatus string
+
+const (
+	// OutcomeSucceeded indicates that the charge was created.
+	OutcomeSucceeded OutcomeStatus = "succeeded"
+
+	// OutcomeDeclined indicates that the card was declined. The decline code
+	// is available on the outcome. Declines are never retried.
+	OutcomeDeclined OutcomeStatus = "declined"
+
+	// OutcomeRequiresAction indicates that the charge couldn't be completed
+	// without further action from the customer (like authenticating the
+	// payment).
+	OutcomeRequiresAction OutcomeStatus = "requires_action"
+
+	// OutcomeGaveUp indicates that the charge couldn't be completed because
+	// of an error that wasn't safe to retry, because the retry budget was
+	// exhausted, or because the context was done.
+	OutcomeGaveUp OutcomeStatus = "gave_up"
+)
+
+// codeAuthenticationRequired is the code returned by the API when a card
+// issuer requires the customer to authenticate before a charge can succeed.
+const codeAuthenticationRequired = "authentication_required"
+
+// RetryPolicy controls how CreateWithRetry retries failed charge attempts.
+type RetryPolicy struct {
+	// InitialBackoff is how long to wait before the first retry. The wait
+	// doubles after every subsequent attempt.
+	InitialBackoff time.Duration
+
+	// MaxAttempts is the maximum number of times that creating the charge
+	// will be attempted, including the first attempt.
+	MaxAttempts int
+
+	// MaxBackoff caps the wait between two attempts.
+	MaxBackoff time.Duration
+
+	// OnRetry, if set, is called right before every retry with the number of
+	// the attempt about to be made and the error that caused it, which lets
+	// retries be counted by metrics.
+	OnRetry func(attempt int, err error)
+}
+
+// DefaultRetryPolicy is the policy used by CreateWithRetry when none is
+// given.
+var DefaultRetryPolicy = &RetryPolicy{
+	InitialBackoff: 500 * time.Millisecond,
+	MaxAttempts:    3,
+	MaxBackoff:     5 * time.Second,
+}
+
+// Outcome is the final result of CreateWithRetry.
+type Outcome struct {
+	// Attempts is the number of requests that were made to create the
+	// charge.
+	Attempts int
+
+	// Charge is the created charge. It's only set when Status is
+	// OutcomeSucceeded.
+	Charge *stripe.Charge
+
+	// DeclineCode is the code explaining why the card was declined. It's
+	// only set when Status is OutcomeDeclined or OutcomeRequiresAction.
+	DeclineCode string
+
+	// Err is the last error that was encountered. It's set for every status
+	// except OutcomeSucceeded.
+	Err error
+
+	// IdempotencyKey is the key that was sent with every attempt.
+	IdempotencyKey string
+
+	Status OutcomeStatus
+}
+
+// CreateWithRetry POSTs a new charge, retrying failures that are safe to
+// retry (network errors, rate limiting, and server errors) with the same
+// idempotency key so that the customer can never be charged twice. If
+// params doesn't carry an idempotency key, one is generated and set on it.
+//
+// Card declines and invalid requests are never retried. A rate limited
+// attempt is retried after the wait asked by the API, if it's longer than the
+// backoff, unless the backend is a BackendConfiguration with
+// MaxRateLimitRetries set: it has already retried the request then. Note that
+// ctx is only checked between attempts; a request in flight isn't
+// interrupted.
+func CreateWithRetry(ctx context.Context, params *stripe.ChargeParams, policy *RetryPolicy) *Outcome {
+	return getC().CreateWithRetry(ctx, params, policy)
+}
+
+func (c Client) CreateWithRetry(ctx context.Context, params *stripe.ChargeParams, policy *RetryPolicy) *Outcome {
+	if params == nil {
+		return &Outcome{Status: OutcomeGaveUp, Err: errors.New("params must be set")}
+	}
+
+	if policy == nil {
+		policy = DefaultRetryPolicy
+	}
+
+	if params.IdempotencyKey == "" {
+		params.IdempotencyKey = stripe.NewIdempotencyKey()
+	}
+
+	outcome := &Outcome{IdempotencyKey: params.IdempotencyKey}
+	backoff := policy.InitialBackoff
+
+	for {
+		if err := ctx.Err(); err != nil {
+			outcome.Status = OutcomeGaveUp
+			outcome.Err = err
+			return outcome
+		}
+
+		outcome.Attempts++
+		ch, err := c.New(params)
+		if err == nil {
+			outcome.Status = OutcomeSucceeded
+			outcome.Charge = ch
+			outcome.Err = nil
+			return outcome
+		}
+
+		outcome.Err = err
+		if classifyError(outcome, err, !c.retriesRateLimits()) || outcome.Attempts >= policy.MaxAttempts {
+			return outcome
+		}
+
+		wait := backoff
+		if retryAfter := rateLimitRetryAfter(err); retryAfter > wait {
+			wait = retryAfter
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			outcome.Err = ctx.Err()
+			return outcome
+		case <-timer.C:
+		}
+
+		if policy.OnRetry != nil {
+			policy.OnRetry(outcome.Attempts+1, outcome.Err)
+		}
+
+		backoff *= 2
+		if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+	}
+}
+
+// retriesRateLimits reports whether the backend of the client retries rate
+// limited requests itself.
+func (c Client) retriesRateLimits() bool {
+	switch b := c.B.(type) {
+	case stripe.BackendConfiguration:
+		return b.MaxRateLimitRetries > 0
+	case *stripe.BackendConfiguration:
+		return b.MaxRateLimitRetries > 0
+	}
+	return false
+}
+
+// rateLimitRetryAfter returns how long the API asked to wait before retrying
+// the request that failed with err, if it was rate limited.
+func rateLimitRetryAfter(err error) time.Duration {
+	if stripeErr, ok := err.(*stripe.Error); ok {
+		if rateLimitErr, ok := stripeErr.Err.(*stripe.RateLimitError); ok {
+			return rateLimitErr.RetryAfter
+		}
+	}
+	return 0
+}
+
+// classifyError sets the status of the outcome according to err and returns
+// true if the error is final, or false if the request is safe to retry. Rate
+// limiting errors are only retried if retryRateLimits is set.
+func classifyError(outcome *Outcome, err error, retryRateLimits bool) bool {
+	outcome.Status = OutcomeGaveUp
+
+	stripeErr, ok := err.(*stripe.Error)
+	if !ok {
+		// The request didn't make it to Stripe or we never got a response
+		// back. With an idempotency key it's safe to try again.
+		return false
+	}
+
+	if stripeErr.Type == stripe.ErrorTypeRateLimit || stripeErr.HTTPStatusCode == 429 {
+		return !retryRateLimits
+	}
+
+	switch stripeErr.Type {
+	case stripe.ErrorTypeCard:
+		var declineCode string
+		if cardErr, ok := stripeErr.Err.(*stripe.CardError); ok {
+			declineCode = cardErr.DeclineCode
+		}
+		if declineCode == "" {
+			declineCode = string(stripeErr.Code)
+		}
+		outcome.DeclineCode = declineCode
+
+		if stripeErr.Code == codeAuthenticationRequired || declineCode == codeAuthenticationRequired {
+			outcome.Status = OutcomeRequiresAction
+		} else {
+			outcome.Status = OutcomeDeclined
+		}
+		return true
+
+	case stripe.ErrorTypeAPI, stripe.ErrorTypeAPIConnection:
+		return false
+	}
+
+	// A conflict means that another request with the same idempotency key is
+	// still being processed, so it'll resolve itself shortly.
+	if stripeErr.HTTPStatusCode == 409 || stripeErr.HTTPStatusCode >= 500 {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,194 @@
+package charge
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// sequenceBackend is a backend that returns the given errors in order and
+// then succeeds. It records the idempotency key of every call.
+type sequenceBackend struct {
+	errs []error
+	keys []string
+}
+
+func (b *sequenceBackend) Call(method, path, key string, body *form.Values, params *stripe.Params, v interface{}) error {
+	b.keys = append(b.keys, params.IdempotencyKey)
+	if len(b.errs) == 0 {
+		v.(*stripe.Charge).ID = "ch_123"
+		return nil
+	}
+	err := b.errs[0]
+	b.errs = b.errs[1:]
+	return err
+}
+
+func (b *sequenceBackend) CallMultipart(method, path, key, boundary string, body io.Reader, params *stripe.Params, v interface{}) error {
+	return nil
+}
+
+var testRetryPolicy = &RetryPolicy{MaxAttempts: 3}
+
+func TestChargeCreateWithRetry_Succeeded(t *testing.T) {
+	b := &sequenceBackend{errs: []error{
+		errors.New("connection reset"),
+		&stripe.Error{Type: stripe.ErrorTypeAPI, HTTPStatusCode: 500},
+	}}
+	c := Client{B: b}
+
+	outcome := c.CreateWithRetry(context.Background(), &stripe.ChargeParams{Amount: 123}, testRetryPolicy)
+	assert.Equal(t, OutcomeSucceeded, outcome.Status)
+	assert.Equal(t, "ch_123", outcome.Charge.ID)
+	assert.Equal(t, 3, outcome.Attempts)
+	assert.Nil(t, outcome.Err)
+
+	// The same idempotency key must be reused across attempts
+	assert.NotEqual(t, "", outcome.IdempotencyKey)
+	assert.Equal(t, []string{outcome.IdempotencyKey, outcome.IdempotencyKey, outcome.IdempotencyKey}, b.keys)
+}
+
+func TestChargeCreateWithRetry_Declined(t *testing.T) {
+	b := &sequenceBackend{errs: []error{
+		&stripe.Error{
+			Type: stripe.ErrorTypeCard,
+			Code: stripe.CardDeclined,
+			Err:  &stripe.CardError{DeclineCode: "insufficient_funds"},
+		},
+	}}
+	c := Client{B: b}
+
+	outcome := c.CreateWithRetry(context.Background(), &stripe.ChargeParams{Amount: 123}, testRetryPolicy)
+	assert.Equal(t, OutcomeDeclined, outcome.Status)
+	assert.Equal(t, "insufficient_funds", outcome.DeclineCode)
+	assert.Equal(t, 1, outcome.Attempts)
+}
+
+func TestChargeCreateWithRetry_RequiresAction(t *testing.T) {
+	b := &sequenceBackend{errs: []error{
+		&stripe.Error{
+			Type: stripe.ErrorTypeCard,
+			Code: stripe.CardDeclined,
+			Err:  &stripe.CardError{DeclineCode: "authentication_required"},
+		},
+	}}
+	c := Client{B: b}
+
+	outcome := c.CreateWithRetry(context.Background(), &stripe.ChargeParams{Amount: 123}, testRetryPolicy)
+	assert.Equal(t, OutcomeRequiresAction, outcome.Status)
+	assert.Equal(t, 1, outcome.Attempts)
+}
+
+func TestChargeCreateWithRetry_GaveUp(t *testing.T) {
+	// Invalid requests are never retried
+	b := &sequenceBackend{errs: []error{
+		&stripe.Error{Type: stripe.ErrorTypeInvalidRequest, HTTPStatusCode: 400},
+	}}
+	c := Client{B: b}
+
+	outcome := c.CreateWithRetry(context.Background(), &stripe.ChargeParams{Amount: 123}, testRetryPolicy)
+	assert.Equal(t, OutcomeGaveUp, outcome.Status)
+	assert.Equal(t, 1, outcome.Attempts)
+	assert.NotNil(t, outcome.Err)
+
+	// Retryable errors stop once the budget is exhausted
+	b = &sequenceBackend{errs: []error{
+		&stripe.Error{Type: stripe.ErrorTypeRateLimit, HTTPStatusCode: 429},
+		&stripe.Error{Type: stripe.ErrorTypeRateLimit, HTTPStatusCode: 429},
+		&stripe.Error{Type: stripe.ErrorTypeRateLimit, HTTPStatusCode: 429},
+	}}
+	c = Client{B: b}
+
+	outcome = c.CreateWithRetry(context.Background(), &stripe.ChargeParams{Amount: 123}, testRetryPolicy)
+	assert.Equal(t, OutcomeGaveUp, outcome.Status)
+	assert.Equal(t, 3, outcome.Attempts)
+}
+
+func TestChargeCreateWithRetry_OnRetry(t *testing.T) {
+	connErr := errors.New("connection reset")
+	b := &sequenceBackend{errs: []error{connErr}}
+	c := Client{B: b}
+
+	var attempts []int
+	var errs []error
+	policy := &RetryPolicy{
+		MaxAttempts: 3,
+		OnRetry: func(attempt int, err error) {
+			attempts = append(attempts, attempt)
+			errs = append(errs, err)
+		},
+	}
+
+	outcome := c.CreateWithRetry(context.Background(), &stripe.ChargeParams{Amount: 123}, policy)
+	assert.Equal(t, OutcomeSucceeded, outcome.Status)
+	assert.Equal(t, []int{2}, attempts)
+	assert.Equal(t, []error{connErr}, errs)
+}
+
+func TestChargeCreateWithRetry_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := Client{B: &sequenceBackend{}}
+
+	outcome := c.CreateWithRetry(ctx, &stripe.ChargeParams{Amount: 123}, testRetryPolicy)
+	assert.Equal(t, OutcomeGaveUp, outcome.Status)
+	assert.Equal(t, 0, outcome.Attempts)
+	assert.Equal(t, context.Canceled, outcome.Err)
+}
+
+func TestChargeCreateWithRetry_NilParams(t *testing.T) {
+	b := &sequenceBackend{}
+	c := Client{B: b}
+
+	outcome := c.CreateWithRetry(context.Background(), nil, testRetryPolicy)
+	assert.Equal(t, OutcomeGaveUp, outcome.Status)
+	assert.Equal(t, 0, outcome.Attempts)
+	assert.NotNil(t, outcome.Err)
+	assert.Equal(t, 0, len(b.keys))
+}
+
+func TestChargeCreateWithRetry_RetryAfter(t *testing.T) {
+	b := &sequenceBackend{errs: []error{
+		&stripe.Error{
+			Type:           stripe.ErrorTypeRateLimit,
+			HTTPStatusCode: 429,
+			Err:            &stripe.RateLimitError{RetryAfter: 50 * time.Millisecond},
+		},
+	}}
+	c := Client{B: b}
+
+	start := time.Now()
+	outcome := c.CreateWithRetry(context.Background(), &stripe.ChargeParams{Amount: 123}, testRetryPolicy)
+	assert.Equal(t, OutcomeSucceeded, outcome.Status)
+	assert.Equal(t, 2, outcome.Attempts)
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+}
+
+func TestChargeCreateWithRetry_BackendRetriesRateLimits(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error":{"type":"invalid_request_error","code":"rate_limit","message":"Too many requests"}}`))
+	}))
+	defer server.Close()
+
+	c := Client{B: &stripe.BackendConfiguration{URL: server.URL + "/v1", MaxRateLimitRetries: 1}}
+
+	// The backend has already retried the request, so it isn't retried again
+	outcome := c.CreateWithRetry(context.Background(), &stripe.ChargeParams{Amount: 123}, testRetryPolicy)
+	assert.Equal(t, OutcomeGaveUp, outcome.Status)
+	assert.Equal(t, 1, outcome.Attempts)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
@@ -1,6 +1,7 @@
 package stripe
 
 import (
+	"encoding/json"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
@@ -24,4 +25,24 @@ func TestChargeParams_AppendTo(t *testing.T) {
 		assert.Equal(t, []string{"4242424242424242"}, body.Get("source[number]"))
 		assert.Equal(t, []string{"card"}, body.Get("source[object]"))
 	}
+
+	{
+		params := &ChargeParams{StatementSuffix: "ORDER 1234"}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"ORDER 1234"}, body.Get("statement_descriptor_suffix"))
+	}
+}
+
+func TestChargeUnmarshal_CalculatedStatement(t *testing.T) {
+	var charge Charge
+	err := json.Unmarshal([]byte(`{
+		"id": "ch_123",
+		"calculated_statement_descriptor": "ACME* ORDER 1234",
+		"statement_descriptor_suffix": "ORDER 1234"
+	}`), &charge)
+	assert.NoError(t, err)
+	assert.Equal(t, "ACME* ORDER 1234", charge.CalculatedStatement)
+	assert.Equal(t, "ORDER 1234", charge.StatementSuffix)
 }
@@ -4,40 +4,94 @@ package client
 import (
 	. "github.com/stripe/stripe-go"
 	"github.com/stripe/stripe-go/account"
+	"github.com/stripe/stripe-go/accountlink"
+	"github.com/stripe/stripe-go/applepaydomain"
 	"github.com/stripe/stripe-go/balance"
+	"github.com/stripe/stripe-go/balancetransaction"
 	"github.com/stripe/stripe-go/bankaccount"
+	"github.com/stripe/stripe-go/billingportal/session"
 	"github.com/stripe/stripe-go/bitcoinreceiver"
 	"github.com/stripe/stripe-go/bitcointransaction"
+	"github.com/stripe/stripe-go/capability"
 	"github.com/stripe/stripe-go/card"
 	"github.com/stripe/stripe-go/charge"
+	climateorder "github.com/stripe/stripe-go/climate/order"
+	climateproduct "github.com/stripe/stripe-go/climate/product"
+	climatesupplier "github.com/stripe/stripe-go/climate/supplier"
 	"github.com/stripe/stripe-go/countryspec"
 	"github.com/stripe/stripe-go/coupon"
+	"github.com/stripe/stripe-go/creditnote"
 	"github.com/stripe/stripe-go/customer"
+	"github.com/stripe/stripe-go/customerbalancetransaction"
 	"github.com/stripe/stripe-go/discount"
 	"github.com/stripe/stripe-go/dispute"
 	"github.com/stripe/stripe-go/ephemeralkey"
 	"github.com/stripe/stripe-go/event"
+	"github.com/stripe/stripe-go/exchangerate"
 	"github.com/stripe/stripe-go/fee"
 	"github.com/stripe/stripe-go/feerefund"
+	"github.com/stripe/stripe-go/file"
+	"github.com/stripe/stripe-go/filelink"
 	"github.com/stripe/stripe-go/fileupload"
+	"github.com/stripe/stripe-go/identity/verificationreport"
+	"github.com/stripe/stripe-go/identity/verificationsession"
 	"github.com/stripe/stripe-go/invoice"
 	"github.com/stripe/stripe-go/invoiceitem"
+	"github.com/stripe/stripe-go/issuing/authorization"
+	issuingcard "github.com/stripe/stripe-go/issuing/card"
+	"github.com/stripe/stripe-go/issuing/cardholder"
+	issuingdispute "github.com/stripe/stripe-go/issuing/dispute"
+	issuingtransaction "github.com/stripe/stripe-go/issuing/transaction"
 	"github.com/stripe/stripe-go/loginlink"
+	"github.com/stripe/stripe-go/mandate"
+	"github.com/stripe/stripe-go/oauth"
 	"github.com/stripe/stripe-go/order"
 	"github.com/stripe/stripe-go/orderreturn"
+	"github.com/stripe/stripe-go/paymentintent"
+	"github.com/stripe/stripe-go/paymentlink"
 	"github.com/stripe/stripe-go/paymentsource"
 	"github.com/stripe/stripe-go/payout"
+	"github.com/stripe/stripe-go/person"
 	"github.com/stripe/stripe-go/plan"
+	"github.com/stripe/stripe-go/price"
 	"github.com/stripe/stripe-go/product"
+	"github.com/stripe/stripe-go/quote"
+	"github.com/stripe/stripe-go/radar/earlyfraudwarning"
+	"github.com/stripe/stripe-go/radar/valuelist"
+	"github.com/stripe/stripe-go/radar/valuelistitem"
 	"github.com/stripe/stripe-go/recipient"
 	"github.com/stripe/stripe-go/refund"
+	"github.com/stripe/stripe-go/reporting/reportrun"
+	"github.com/stripe/stripe-go/reporting/reporttype"
 	"github.com/stripe/stripe-go/reversal"
+	"github.com/stripe/stripe-go/review"
+	"github.com/stripe/stripe-go/shippingrate"
 	"github.com/stripe/stripe-go/sku"
 	"github.com/stripe/stripe-go/source"
 	"github.com/stripe/stripe-go/sub"
 	"github.com/stripe/stripe-go/subitem"
+	"github.com/stripe/stripe-go/subschedule"
+	"github.com/stripe/stripe-go/tax/calculation"
+	"github.com/stripe/stripe-go/tax/registration"
+	"github.com/stripe/stripe-go/tax/settings"
+	taxtransaction "github.com/stripe/stripe-go/tax/transaction"
+	"github.com/stripe/stripe-go/taxid"
+	"github.com/stripe/stripe-go/taxrate"
+	"github.com/stripe/stripe-go/terminal/connectiontoken"
+	"github.com/stripe/stripe-go/terminal/location"
+	"github.com/stripe/stripe-go/terminal/reader"
+	testhelpersauthorization "github.com/stripe/stripe-go/testhelpers/issuing/authorization"
+	testhelperscard "github.com/stripe/stripe-go/testhelpers/issuing/card"
+	testhelperstransaction "github.com/stripe/stripe-go/testhelpers/issuing/transaction"
+	testhelpersreader "github.com/stripe/stripe-go/testhelpers/terminal/reader"
+	"github.com/stripe/stripe-go/testhelpers/testclock"
+	"github.com/stripe/stripe-go/threedsecure"
 	"github.com/stripe/stripe-go/token"
+	"github.com/stripe/stripe-go/topup"
 	"github.com/stripe/stripe-go/transfer"
+	"github.com/stripe/stripe-go/usagerecord"
+	"github.com/stripe/stripe-go/usagerecordsummary"
+	"github.com/stripe/stripe-go/webhookendpoint"
 )
 
 // API is the Stripe client. It contains all the different resources available.
@@ -45,9 +99,21 @@ type API struct {
 	// Charges is the client used to invoke /charges APIs.
 	// For more details see https://stripe.com/docs/api#charges.
 	Charges *charge.Client
+	// ClimateOrders is the client used to invoke /climate/orders APIs.
+	// For more details see https://stripe.com/docs/api/climate/order.
+	ClimateOrders *climateorder.Client
+	// ClimateProducts is the client used to invoke /climate/products APIs.
+	// For more details see https://stripe.com/docs/api/climate/product.
+	ClimateProducts *climateproduct.Client
+	// ClimateSuppliers is the client used to invoke /climate/suppliers APIs.
+	// For more details see https://stripe.com/docs/api/climate/supplier.
+	ClimateSuppliers *climatesupplier.Client
 	// Customers is the client used to invoke /customers APIs.
 	// For more details see https://stripe.com/docs/api#customers.
 	Customers *customer.Client
+	// CustomerBalanceTransactions is the client used to invoke /customers/balance_transactions APIs.
+	// For more details see https://stripe.com/docs/api#customer_balance_transactions.
+	CustomerBalanceTransactions *customerbalancetransaction.Client
 	// Cards is the client used to invoke /cards APIs.
 	// For more details see https://stripe.com/docs/api#cards.
 	Cards *card.Client
@@ -57,12 +123,36 @@ type API struct {
 	// SubItems is the client used to invoke subscription's items-related APIs.
 	// For more details see https://stripe.com/docs/api#subscription_items.
 	SubItems *subitem.Client
+	// UsageRecords is the client used to invoke /subscription_items/usage_records APIs.
+	// For more details see https://stripe.com/docs/api#usage_records.
+	UsageRecords *usagerecord.Client
+	// UsageRecordSummaries is the client used to invoke /subscription_items/usage_record_summaries APIs.
+	// For more details see https://stripe.com/docs/api#usage_record_summaries.
+	UsageRecordSummaries *usagerecordsummary.Client
+	// SubscriptionSchedules is the client used to invoke /subscription_schedules APIs.
+	// For more details see https://stripe.com/docs/api#subscription_schedules.
+	SubscriptionSchedules *subschedule.Client
+	// Capabilities is the client used to invoke /accounts/capabilities APIs.
+	// For more details see https://stripe.com/docs/api#capabilities.
+	Capabilities *capability.Client
+	// Persons is the client used to invoke /accounts/persons APIs.
+	// For more details see https://stripe.com/docs/api#persons.
+	Persons *person.Client
 	// Plans is the client used to invoke /plans APIs.
 	// For more details see https://stripe.com/docs/api#plans.
 	Plans *plan.Client
+	// Prices is the client used to invoke /prices APIs.
+	// For more details see https://stripe.com/docs/api#prices.
+	Prices *price.Client
+	// Quotes is the client used to invoke /quotes APIs.
+	// For more details see https://stripe.com/docs/api#quotes.
+	Quotes *quote.Client
 	// Coupons is the client used to invoke /coupons APIs.
 	// For more details see https://stripe.com/docs/api#coupons.
 	Coupons *coupon.Client
+	// CreditNotes is the client used to invoke /credit_notes APIs.
+	// For more details see https://stripe.com/docs/api#credit_notes.
+	CreditNotes *creditnote.Client
 	// Discounts is the client used to invoke discount-related APIs.
 	// For mode details see https://stripe.com/docs/api#discounts.
 	Discounts *discount.Client
@@ -72,6 +162,27 @@ type API struct {
 	// InvoiceItems is the client used to invoke /invoiceitems APIs.
 	// For more details see https://stripe.com/docs/api#invoiceitems.
 	InvoiceItems *invoiceitem.Client
+	// IdentityVerificationReports is the client used to invoke /identity/verification_reports APIs.
+	// For more details see https://stripe.com/docs/api#identity_verification_reports.
+	IdentityVerificationReports *verificationreport.Client
+	// IdentityVerificationSessions is the client used to invoke /identity/verification_sessions APIs.
+	// For more details see https://stripe.com/docs/api#identity_verification_sessions.
+	IdentityVerificationSessions *verificationsession.Client
+	// IssuingAuthorizations is the client used to invoke /issuing/authorizations APIs.
+	// For more details see https://stripe.com/docs/api#issuing_authorizations.
+	IssuingAuthorizations *authorization.Client
+	// IssuingCardholders is the client used to invoke /issuing/cardholders APIs.
+	// For more details see https://stripe.com/docs/api#issuing_cardholders.
+	IssuingCardholders *cardholder.Client
+	// IssuingCards is the client used to invoke /issuing/cards APIs.
+	// For more details see https://stripe.com/docs/api#issuing_cards.
+	IssuingCards *issuingcard.Client
+	// IssuingDisputes is the client used to invoke /issuing/disputes APIs.
+	// For more details see https://stripe.com/docs/api#issuing_disputes.
+	IssuingDisputes *issuingdispute.Client
+	// IssuingTransactions is the client used to invoke /issuing/transactions APIs.
+	// For more details see https://stripe.com/docs/api#issuing_transactions.
+	IssuingTransactions *issuingtransaction.Client
 	// LoginLinks is the client used to invoke /v1/accounts/<account_id>/login_links APIs.
 	// For more details see https://stripe.com/docs/api#login_link_object.
 	LoginLinks *loginlink.Client
@@ -81,9 +192,21 @@ type API struct {
 	// Transfers is the client used to invoke /transfers APIs.
 	// For more details see https://stripe.com/docs/api#transfers.
 	Transfers *transfer.Client
+	// PaymentIntents is the client used to invoke /payment_intents APIs.
+	// For more details see https://stripe.com/docs/api#payment_intents.
+	PaymentIntents *paymentintent.Client
+	// PaymentLinks is the client used to invoke /payment_links APIs.
+	// For more details see https://stripe.com/docs/api/payment_links/payment_links.
+	PaymentLinks *paymentlink.Client
+	// Mandates is the client used to invoke /mandates APIs.
+	// For more details see https://stripe.com/docs/api#mandates.
+	Mandates *mandate.Client
 	// Payouts is the client used to invoke /payouts APIs.
 	// For more details see https://stripe.com/docs/api#payouts.
 	Payouts *payout.Client
+	// Topups is the client used to invoke /topups APIs.
+	// For more details see https://stripe.com/docs/api#topups.
+	Topups *topup.Client
 	// Recipients is the client used to invoke /recipients APIs.
 	// For more details see https://stripe.com/docs/api#recipients.
 	Recipients *recipient.Client
@@ -99,21 +222,84 @@ type API struct {
 	// Account is the client used to invoke /account APIs.
 	// For more details see https://stripe.com/docs/api#account.
 	Account *account.Client
+	// AccountLinks is the client used to invoke /account_links APIs.
+	// For more details see https://stripe.com/docs/api#account_links.
+	AccountLinks *accountlink.Client
+	// ApplePayDomains is the client used to invoke /apple_pay/domains APIs.
+	// For more details see https://stripe.com/docs/apple-pay/web.
+	ApplePayDomains *applepaydomain.Client
 	// CountrySpec is the client used to invoke /country_specs APIs.
 	// For more details see https://stripe.com/docs/api#country_specs.
 	CountrySpec *countryspec.Client
 	// Balance is the client used to invoke /balance and transaction-related APIs.
 	// For more details see https://stripe.com/docs/api#balance.
 	Balance *balance.Client
+	// BalanceTransactions is the client used to invoke /balance_transactions APIs.
+	// For more details see https://stripe.com/docs/api#balance_transaction_object.
+	BalanceTransactions *balancetransaction.Client
+	// ExchangeRates is the client used to invoke /exchange_rates APIs.
+	// For more details see https://stripe.com/docs/api/exchange_rates.
+	ExchangeRates *exchangerate.Client
 	// EphemeralKeys is the client used to invoke /ephemeral_keys APIs.
 	// For more details see https://stripe.com/docs/api#ephemeral_keys.
 	EphemeralKeys *ephemeralkey.Client
 	// Events is the client used to invoke /events APIs.
 	// For more details see https://stripe.com/docs/api#events.
 	Events *event.Client
+	// TaxIDs is the client used to invoke /customers/tax_ids APIs.
+	// For more details see https://stripe.com/docs/api#tax_ids.
+	TaxIDs *taxid.Client
+	// TaxRates is the client used to invoke /tax_rates APIs.
+	// For more details see https://stripe.com/docs/api#tax_rates.
+	TaxRates *taxrate.Client
+	// TaxCalculations is the client used to invoke /tax/calculations APIs.
+	// For more details see https://stripe.com/docs/api/tax/calculations.
+	TaxCalculations *calculation.Client
+	// TaxRegistrations is the client used to invoke /tax/registrations APIs.
+	// For more details see https://stripe.com/docs/api/tax/registrations.
+	TaxRegistrations *registration.Client
+	// TaxSettings is the client used to invoke /tax/settings APIs.
+	// For more details see https://stripe.com/docs/api/tax/settings.
+	TaxSettings *settings.Client
+	// TaxTransactions is the client used to invoke /tax/transactions APIs.
+	// For more details see https://stripe.com/docs/api/tax/transactions.
+	TaxTransactions *taxtransaction.Client
+	// TerminalConnectionTokens is the client used to invoke /terminal/connection_tokens APIs.
+	// For more details see https://stripe.com/docs/api#terminal_connection_tokens.
+	TerminalConnectionTokens *connectiontoken.Client
+	// TerminalLocations is the client used to invoke /terminal/locations APIs.
+	// For more details see https://stripe.com/docs/api#terminal_locations.
+	TerminalLocations *location.Client
+	// TerminalReaders is the client used to invoke /terminal/readers APIs.
+	// For more details see https://stripe.com/docs/api#terminal_readers.
+	TerminalReaders *reader.Client
+	// TestHelpersIssuingAuthorizations is the client used to invoke /test_helpers/issuing/authorizations APIs.
+	// For more details see https://stripe.com/docs/api/issuing/authorizations/test_mode_create.
+	TestHelpersIssuingAuthorizations *testhelpersauthorization.Client
+	// TestHelpersIssuingCards is the client used to invoke /test_helpers/issuing/cards APIs.
+	// For more details see https://stripe.com/docs/api/issuing/cards/test_mode_ship.
+	TestHelpersIssuingCards *testhelperscard.Client
+	// TestHelpersIssuingTransactions is the client used to invoke /test_helpers/issuing/transactions APIs.
+	// For more details see https://stripe.com/docs/api/issuing/transactions/test_mode_create_force_capture.
+	TestHelpersIssuingTransactions *testhelperstransaction.Client
+	// TestHelpersTerminalReaders is the client used to invoke /test_helpers/terminal/readers APIs.
+	// For more details see https://stripe.com/docs/api/terminal/readers/present_payment_method.
+	TestHelpersTerminalReaders *testhelpersreader.Client
+	// TestHelpersTestClocks is the client used to invoke /test_helpers/test_clocks APIs.
+	// For more details see https://stripe.com/docs/api/test_clocks.
+	TestHelpersTestClocks *testclock.Client
+	// ThreeDSecures is the client used to invoke /3d_secure APIs.
+	// For more details see https://stripe.com/docs/api#three_d_secure.
+	ThreeDSecures *threedsecure.Client
 	// Tokens is the client used to invoke /tokens APIs.
 	// For more details see https://stripe.com/docs/api#tokens.
 	Tokens *token.Client
+	// Files is the client used to invoke /files APIs.
+	// For more details see https://stripe.com/docs/api#files.
+	Files *file.Client
+	// FileLinks is the client used to invoke /file_links APIs.
+	// For more details see https://stripe.com/docs/api#file_links.
+	FileLinks *filelink.Client
 	// FileUploads is the client used to invoke the uploads /files APIs.
 	// For more details see https://stripe.com/docs/api#file_uploads.
 	FileUploads *fileupload.Client
@@ -127,15 +313,24 @@ type API struct {
 	Reversals *reversal.Client
 	// BankAccounts is the client used to invoke /accounts/bank_accounts APIs.
 	BankAccounts *bankaccount.Client
+	// BillingPortalSessions is the client used to invoke /billing_portal/sessions APIs.
+	// For more details see https://stripe.com/docs/api/customer_portal/sessions.
+	BillingPortalSessions *session.Client
 	// Products is the client used to invoke /products APIs.
 	// For more details see https://stripe.com/docs/api#products.
 	Products *product.Client
+	// OAuth is the client used to invoke the Connect /oauth APIs.
+	// For more details see https://stripe.com/docs/connect/oauth-reference.
+	OAuth *oauth.Client
 	// Orders is the client used to invoke /orders APIs.
 	// For more details see https://stripe.com/docs/api#orders.
 	Orders *order.Client
 	// OrderReturns is the client used to invoke /order_returns APIs.
 	// For more details, see https://stripe.com/docs/api#order_returns.
 	OrderReturns *orderreturn.Client
+	// ShippingRates is the client used to invoke /shipping_rates APIs.
+	// For more details see https://stripe.com/docs/api/shipping_rates.
+	ShippingRates *shippingrate.Client
 	// Skus is the client used to invoke /skus APIs.
 	// For more details see https://stripe.com/docs/api#skus.
 	Skus *sku.Client
@@ -145,50 +340,125 @@ type API struct {
 	// PaymentSource is used to invoke /sources APIs.
 	// For more details see https://stripe.com/docs/api.
 	PaymentSource *paymentsource.Client
+	// RadarEarlyFraudWarnings is the client used to invoke /radar/early_fraud_warnings APIs.
+	// For more details see https://stripe.com/docs/api#early_fraud_warnings.
+	RadarEarlyFraudWarnings *earlyfraudwarning.Client
+	// RadarValueLists is the client used to invoke /radar/value_lists APIs.
+	// For more details see https://stripe.com/docs/api#radar_value_lists.
+	RadarValueLists *valuelist.Client
+	// RadarValueListItems is the client used to invoke /radar/value_list_items APIs.
+	// For more details see https://stripe.com/docs/api#radar_value_list_items.
+	RadarValueListItems *valuelistitem.Client
+	// ReportRuns is the client used to invoke /reporting/report_runs APIs.
+	// For more details see https://stripe.com/docs/api#reporting_report_runs.
+	ReportRuns *reportrun.Client
+	// ReportTypes is the client used to invoke /reporting/report_types APIs.
+	// For more details see https://stripe.com/docs/api#reporting_report_types.
+	ReportTypes *reporttype.Client
+	// Reviews is the client used to invoke /reviews APIs.
+	// For more details see https://stripe.com/docs/api#reviews.
+	Reviews *review.Client
+	// WebhookEndpoints is the client used to invoke /webhook_endpoints APIs.
+	// For more details see https://stripe.com/docs/api#webhook_endpoints.
+	WebhookEndpoints *webhookendpoint.Client
 }
 
 // Init initializes the Stripe client with the appropriate secret key
 // as well as providing the ability to override the backend as needed.
 func (a *API) Init(key string, backends *Backends) {
 	if backends == nil {
-		backends = &Backends{API: GetBackend(APIBackend), Uploads: GetBackend(UploadsBackend)}
+		backends = &Backends{API: GetBackend(APIBackend), Connect: GetBackend(ConnectBackend), Files: GetBackend(FilesBackend), Uploads: GetBackend(UploadsBackend)}
 	}
 
 	a.Charges = &charge.Client{B: backends.API, Key: key}
+	a.ClimateOrders = &climateorder.Client{B: backends.API, Key: key}
+	a.ClimateProducts = &climateproduct.Client{B: backends.API, Key: key}
+	a.ClimateSuppliers = &climatesupplier.Client{B: backends.API, Key: key}
 	a.Customers = &customer.Client{B: backends.API, Key: key}
+	a.CustomerBalanceTransactions = &customerbalancetransaction.Client{B: backends.API, Key: key}
 	a.Cards = &card.Client{B: backends.API, Key: key}
 	a.Subs = &sub.Client{B: backends.API, Key: key}
 	a.SubItems = &subitem.Client{B: backends.API, Key: key}
+	a.UsageRecords = &usagerecord.Client{B: backends.API, Key: key}
+	a.UsageRecordSummaries = &usagerecordsummary.Client{B: backends.API, Key: key}
+	a.SubscriptionSchedules = &subschedule.Client{B: backends.API, Key: key}
+	a.Capabilities = &capability.Client{B: backends.API, Key: key}
+	a.Persons = &person.Client{B: backends.API, Key: key}
 	a.Plans = &plan.Client{B: backends.API, Key: key}
+	a.Prices = &price.Client{B: backends.API, Key: key}
+	a.Quotes = &quote.Client{B: backends.API, Key: key, FilesB: backends.Files}
 	a.Coupons = &coupon.Client{B: backends.API, Key: key}
+	a.CreditNotes = &creditnote.Client{B: backends.API, Key: key}
 	a.Discounts = &discount.Client{B: backends.API, Key: key}
 	a.Invoices = &invoice.Client{B: backends.API, Key: key}
 	a.InvoiceItems = &invoiceitem.Client{B: backends.API, Key: key}
+	a.IdentityVerificationReports = &verificationreport.Client{B: backends.API, Key: key}
+	a.IdentityVerificationSessions = &verificationsession.Client{B: backends.API, Key: key}
+	a.IssuingAuthorizations = &authorization.Client{B: backends.API, Key: key}
+	a.IssuingCardholders = &cardholder.Client{B: backends.API, Key: key}
+	a.IssuingCards = &issuingcard.Client{B: backends.API, Key: key}
+	a.IssuingDisputes = &issuingdispute.Client{B: backends.API, Key: key}
+	a.IssuingTransactions = &issuingtransaction.Client{B: backends.API, Key: key}
 	a.LoginLinks = &loginlink.Client{B: backends.API, Key: key}
 	a.Disputes = &dispute.Client{B: backends.API, Key: key}
 	a.Transfers = &transfer.Client{B: backends.API, Key: key}
+	a.PaymentIntents = &paymentintent.Client{B: backends.API, Key: key}
+	a.PaymentLinks = &paymentlink.Client{B: backends.API, Key: key}
+	a.Mandates = &mandate.Client{B: backends.API, Key: key}
 	a.Payouts = &payout.Client{B: backends.API, Key: key}
+	a.Topups = &topup.Client{B: backends.API, Key: key}
 	a.Recipients = &recipient.Client{B: backends.API, Key: key}
 	a.Refunds = &refund.Client{B: backends.API, Key: key}
 	a.Fees = &fee.Client{B: backends.API, Key: key}
 	a.FeeRefunds = &feerefund.Client{B: backends.API, Key: key}
 	a.Account = &account.Client{B: backends.API, Key: key}
+	a.AccountLinks = &accountlink.Client{B: backends.API, Key: key}
+	a.ApplePayDomains = &applepaydomain.Client{B: backends.API, Key: key}
 	a.CountrySpec = &countryspec.Client{B: backends.API, Key: key}
 	a.Balance = &balance.Client{B: backends.API, Key: key}
+	a.BalanceTransactions = &balancetransaction.Client{B: backends.API, Key: key}
+	a.ExchangeRates = &exchangerate.Client{B: backends.API, Key: key}
 	a.EphemeralKeys = &ephemeralkey.Client{B: backends.API, Key: key}
 	a.Events = &event.Client{B: backends.API, Key: key}
+	a.TaxIDs = &taxid.Client{B: backends.API, Key: key}
+	a.TaxRates = &taxrate.Client{B: backends.API, Key: key}
+	a.TaxCalculations = &calculation.Client{B: backends.API, Key: key}
+	a.TaxRegistrations = &registration.Client{B: backends.API, Key: key}
+	a.TaxSettings = &settings.Client{B: backends.API, Key: key}
+	a.TaxTransactions = &taxtransaction.Client{B: backends.API, Key: key}
+	a.TerminalConnectionTokens = &connectiontoken.Client{B: backends.API, Key: key}
+	a.TerminalLocations = &location.Client{B: backends.API, Key: key}
+	a.TerminalReaders = &reader.Client{B: backends.API, Key: key}
+	a.TestHelpersIssuingAuthorizations = &testhelpersauthorization.Client{B: backends.API, Key: key}
+	a.TestHelpersIssuingCards = &testhelperscard.Client{B: backends.API, Key: key}
+	a.TestHelpersIssuingTransactions = &testhelperstransaction.Client{B: backends.API, Key: key}
+	a.TestHelpersTerminalReaders = &testhelpersreader.Client{B: backends.API, Key: key}
+	a.TestHelpersTestClocks = &testclock.Client{B: backends.API, Key: key}
+	a.ThreeDSecures = &threedsecure.Client{B: backends.API, Key: key}
 	a.Tokens = &token.Client{B: backends.API, Key: key}
+	a.Files = &file.Client{B: backends.API, Key: key, FilesB: backends.Files}
+	a.FileLinks = &filelink.Client{B: backends.API, Key: key}
 	a.FileUploads = &fileupload.Client{B: backends.Uploads, Key: key}
 	a.BitcoinReceivers = &bitcoinreceiver.Client{B: backends.API, Key: key}
 	a.BitcoinTransactions = &bitcointransaction.Client{B: backends.API, Key: key}
 	a.Reversals = &reversal.Client{B: backends.API, Key: key}
 	a.BankAccounts = &bankaccount.Client{B: backends.API, Key: key}
+	a.BillingPortalSessions = &session.Client{B: backends.API, Key: key}
 	a.Products = &product.Client{B: backends.API, Key: key}
+	a.OAuth = &oauth.Client{B: backends.Connect, Key: key}
 	a.Orders = &order.Client{B: backends.API, Key: key}
 	a.OrderReturns = &orderreturn.Client{B: backends.API, Key: key}
+	a.ShippingRates = &shippingrate.Client{B: backends.API, Key: key}
 	a.Skus = &sku.Client{B: backends.API, Key: key}
 	a.Sources = &source.Client{B: backends.API, Key: key}
 	a.PaymentSource = &paymentsource.Client{B: backends.API, Key: key}
+	a.RadarEarlyFraudWarnings = &earlyfraudwarning.Client{B: backends.API, Key: key}
+	a.RadarValueLists = &valuelist.Client{B: backends.API, Key: key}
+	a.RadarValueListItems = &valuelistitem.Client{B: backends.API, Key: key}
+	a.ReportRuns = &reportrun.Client{B: backends.API, Key: key}
+	a.ReportTypes = &reporttype.Client{B: backends.API, Key: key}
+	a.Reviews = &review.Client{B: backends.API, Key: key}
+	a.WebhookEndpoints = &webhookendpoint.Client{B: backends.API, Key: key}
 }
 
 // New creates a new Stripe client with the appropriate secret key
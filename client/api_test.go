@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestAPIInit(t *testing.T) {
@@ -16,3 +18,23 @@ func TestAPINew(t *testing.T) {
 	api := New("sk_test_123", nil)
 	assert.Equal(t, "sk_test_123", api.Charges.Key)
 }
+
+func TestAPIKeysAreIndependent(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/charges/ch_123", `{"id": "ch_123"}`)
+	backends := &stripe.Backends{API: b, Connect: b, Files: b, Uploads: b}
+
+	first := New("sk_test_first", backends)
+	second := New("sk_test_second", backends)
+
+	_, err := first.Charges.Get("ch_123", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "sk_test_first", b.LastCall().Key)
+
+	_, err = second.Charges.Get("ch_123", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "sk_test_second", b.LastCall().Key)
+
+	assert.Equal(t, "sk_test_second", second.ApplePayDomains.Key)
+	assert.Equal(t, "sk_test_second", second.ThreeDSecures.Key)
+}
@@ -0,0 +1,164 @@
+// Package order provides the /climate/orders APIs
+package order
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	CancellationReasonExpired            stripe.ClimateOrderCancellationReason = "expired"
+	CancellationReasonProductUnavailable stripe.ClimateOrderCancellationReason = "product_unavailable"
+	CancellationReasonRequested          stripe.ClimateOrderCancellationReason = "requested"
+
+	StatusAwaitingFunds stripe.ClimateOrderStatus = "awaiting_funds"
+	StatusCanceled      stripe.ClimateOrderStatus = "canceled"
+	StatusConfirmed     stripe.ClimateOrderStatus = "confirmed"
+	StatusDelivered     stripe.ClimateOrderStatus = "delivered"
+	StatusOpen          stripe.ClimateOrderStatus = "open"
+)
+
+// Client is used to invoke /climate/orders APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New creates a climate order, which buys carbon removal from a climate product.
+// For more details see https://stripe.com/docs/api/climate/order/create.
+func New(params *stripe.ClimateOrderParams) (*stripe.ClimateOrder, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.ClimateOrderParams) (*stripe.ClimateOrder, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	climateOrder := &stripe.ClimateOrder{}
+	err := c.B.Call("POST", "/climate/orders", c.Key, body, commonParams, climateOrder)
+
+	return climateOrder, err
+}
+
+// Get returns the details of a climate order.
+// For more details see https://stripe.com/docs/api/climate/order/retrieve.
+func Get(id string, params *stripe.ClimateOrderParams) (*stripe.ClimateOrder, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.ClimateOrderParams) (*stripe.ClimateOrder, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	climateOrder := &stripe.ClimateOrder{}
+	err := c.B.Call("GET", fmt.Sprintf("/climate/orders/%v", id), c.Key, body, commonParams, climateOrder)
+
+	return climateOrder, err
+}
+
+// Update updates a climate order's properties.
+// For more details see https://stripe.com/docs/api/climate/order/update.
+func Update(id string, params *stripe.ClimateOrderParams) (*stripe.ClimateOrder, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.ClimateOrderParams) (*stripe.ClimateOrder, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	climateOrder := &stripe.ClimateOrder{}
+	err := c.B.Call("POST", fmt.Sprintf("/climate/orders/%v", id), c.Key, body, commonParams, climateOrder)
+
+	return climateOrder, err
+}
+
+// Cancel cancels a climate order. Orders can only be canceled before
+// they're confirmed.
+// For more details see https://stripe.com/docs/api/climate/order/cancel.
+func Cancel(id string, params *stripe.ClimateOrderCancelParams) (*stripe.ClimateOrder, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.ClimateOrderCancelParams) (*stripe.ClimateOrder, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	climateOrder := &stripe.ClimateOrder{}
+	err := c.B.Call("POST", fmt.Sprintf("/climate/orders/%v/cancel", id), c.Key, body, commonParams, climateOrder)
+
+	return climateOrder, err
+}
+
+// List returns a list of climate orders.
+// For more details see https://stripe.com/docs/api/climate/order/list.
+func List(params *stripe.ClimateOrderListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.ClimateOrderListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.ClimateOrderList{}
+		err := c.B.Call("GET", "/climate/orders", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of ClimateOrders.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// ClimateOrder returns the most recent ClimateOrder
+// visited by a call to Next.
+func (i *Iter) ClimateOrder() *stripe.ClimateOrder {
+	return i.Current().(*stripe.ClimateOrder)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
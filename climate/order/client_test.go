@@ -0,0 +1,94 @@
+package order
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestClimateOrderCancel(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/climate/orders/climorder_123/cancel", `{"id": "climorder_123", "status": "canceled", "cancellation_reason": "requested"}`)
+
+	order, err := Client{B: b}.Cancel("climorder_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, StatusCanceled, order.Status)
+	assert.Equal(t, CancellationReasonRequested, order.CancellationReason)
+}
+
+func TestClimateOrderGet(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/climate/orders/climorder_123", `{
+		"id": "climorder_123",
+		"object": "climate.order",
+		"metric_tons": "0.5",
+		"product": "climsku_123",
+		"status": "delivered",
+		"delivery_details": [{
+			"delivered_at": 1700000000,
+			"location": {"city": "Reykjavik", "country": "IS", "latitude": 64.1, "longitude": -21.9},
+			"metric_tons": "0.5",
+			"registry_url": "https://registry.example.com/123",
+			"supplier": {"id": "climsup_123", "name": "Climeworks", "removal_pathway": "direct_air_capture"}
+		}]
+	}`)
+
+	order, err := Client{B: b}.Get("climorder_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.5, order.MetricTons)
+	assert.Equal(t, "climsku_123", order.Product.ID)
+	assert.Equal(t, 1, len(order.DeliveryDetails))
+
+	delivery := order.DeliveryDetails[0]
+	assert.Equal(t, stripe.Timestamp(1700000000), delivery.DeliveredAt)
+	assert.Equal(t, "Reykjavik", delivery.Location.City)
+	assert.Equal(t, 0.5, delivery.MetricTons)
+	assert.Equal(t, "climsup_123", delivery.Supplier.ID)
+	assert.Equal(t, stripe.ClimateSupplierRemovalPathway("direct_air_capture"), delivery.Supplier.RemovalPathway)
+}
+
+func TestClimateOrderList(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/climate/orders", `{"data": [{"id": "climorder_123"}]}`)
+
+	i := Client{B: b}.List(&stripe.ClimateOrderListParams{})
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, "climorder_123", i.ClimateOrder().ID)
+}
+
+func TestClimateOrderNew(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/climate/orders", `{"id": "climorder_123", "status": "open"}`)
+
+	order, err := Client{B: b}.New(&stripe.ClimateOrderParams{
+		Beneficiary: &stripe.ClimateOrderBeneficiaryParams{
+			PublicName: "Example Co",
+		},
+		MetricTons: 0.25,
+		Product:    "climsku_123",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, StatusOpen, order.Status)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "Example Co", values.Get("beneficiary[public_name]"))
+	assert.Equal(t, "0.2500", values.Get("metric_tons"))
+	assert.Equal(t, "climsku_123", values.Get("product"))
+}
+
+func TestClimateOrderUpdate(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/climate/orders/climorder_123", `{"id": "climorder_123"}`)
+
+	_, err := Client{B: b}.Update("climorder_123", &stripe.ClimateOrderParams{
+		Beneficiary: &stripe.ClimateOrderBeneficiaryParams{
+			PublicName: "Example Co",
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Example Co", b.LastCall().Values().Get("beneficiary[public_name]"))
+}
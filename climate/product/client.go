@@ -0,0 +1,85 @@
+// Package product provides the /climate/products APIs
+package product
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /climate/products APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of a climate product.
+// For more details see https://stripe.com/docs/api/climate/product/retrieve.
+func Get(id string, params *stripe.ClimateProductParams) (*stripe.ClimateProduct, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.ClimateProductParams) (*stripe.ClimateProduct, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	climateProduct := &stripe.ClimateProduct{}
+	err := c.B.Call("GET", fmt.Sprintf("/climate/products/%v", id), c.Key, body, commonParams, climateProduct)
+
+	return climateProduct, err
+}
+
+// List returns a list of climate products.
+// For more details see https://stripe.com/docs/api/climate/product/list.
+func List(params *stripe.ClimateProductListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.ClimateProductListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.ClimateProductList{}
+		err := c.B.Call("GET", "/climate/products", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of ClimateProducts.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// ClimateProduct returns the most recent ClimateProduct
+// visited by a call to Next.
+func (i *Iter) ClimateProduct() *stripe.ClimateProduct {
+	return i.Current().(*stripe.ClimateProduct)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,38 @@
+package product
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestClimateProductGet(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/climate/products/climsku_123", `{
+		"id": "climsku_123",
+		"object": "climate.product",
+		"current_prices_per_metric_ton": {"usd": {"amount_fees": 1000, "amount_subtotal": 50000, "amount_total": 51000}},
+		"delivery_year": 2027,
+		"metric_tons_available": "10000",
+		"suppliers": [{"id": "climsup_123"}]
+	}`)
+
+	product, err := Client{B: b}.Get("climsku_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(51000), product.CurrentPricesPerMetricTon[stripe.Currency("usd")].AmountTotal)
+	assert.Equal(t, 10000.0, product.MetricTonsAvailable)
+	assert.Equal(t, "climsup_123", product.Suppliers[0].ID)
+}
+
+func TestClimateProductList(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/climate/products", `{"data": [{"id": "climsku_123"}]}`)
+
+	i := Client{B: b}.List(&stripe.ClimateProductListParams{})
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, "climsku_123", i.ClimateProduct().ID)
+}
@@ -0,0 +1,91 @@
+// Package supplier provides the /climate/suppliers APIs
+package supplier
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	RemovalPathwayBiomassCarbonRemovalAndStorage stripe.ClimateSupplierRemovalPathway = "biomass_carbon_removal_and_storage"
+	RemovalPathwayDirectAirCapture               stripe.ClimateSupplierRemovalPathway = "direct_air_capture"
+	RemovalPathwayEnhancedWeathering             stripe.ClimateSupplierRemovalPathway = "enhanced_weathering"
+)
+
+// Client is used to invoke /climate/suppliers APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of a climate supplier.
+// For more details see https://stripe.com/docs/api/climate/supplier/retrieve.
+func Get(id string, params *stripe.ClimateSupplierParams) (*stripe.ClimateSupplier, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.ClimateSupplierParams) (*stripe.ClimateSupplier, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	climateSupplier := &stripe.ClimateSupplier{}
+	err := c.B.Call("GET", fmt.Sprintf("/climate/suppliers/%v", id), c.Key, body, commonParams, climateSupplier)
+
+	return climateSupplier, err
+}
+
+// List returns a list of climate suppliers.
+// For more details see https://stripe.com/docs/api/climate/supplier/list.
+func List(params *stripe.ClimateSupplierListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.ClimateSupplierListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.ClimateSupplierList{}
+		err := c.B.Call("GET", "/climate/suppliers", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of ClimateSuppliers.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// ClimateSupplier returns the most recent ClimateSupplier
+// visited by a call to Next.
+func (i *Iter) ClimateSupplier() *stripe.ClimateSupplier {
+	return i.Current().(*stripe.ClimateSupplier)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,30 @@
+package supplier
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestClimateSupplierGet(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/climate/suppliers/climsup_123", `{"id": "climsup_123", "object": "climate.supplier", "removal_pathway": "enhanced_weathering", "locations": [{"country": "US", "region": "CA"}]}`)
+
+	supplier, err := Client{B: b}.Get("climsup_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, RemovalPathwayEnhancedWeathering, supplier.RemovalPathway)
+	assert.Equal(t, "CA", supplier.Locations[0].Region)
+}
+
+func TestClimateSupplierList(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/climate/suppliers", `{"data": [{"id": "climsup_123"}]}`)
+
+	i := Client{B: b}.List(&stripe.ClimateSupplierListParams{})
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, "climsup_123", i.ClimateSupplier().ID)
+}
@@ -0,0 +1,117 @@
+package stripe
+
+import "encoding/json"
+
+// ClimateOrderCancellationReason is the list of allowed values for the reason
+// a climate order was canceled. Allowed values are "expired",
+// "product_unavailable" and "requested".
+type ClimateOrderCancellationReason string
+
+// ClimateOrderStatus is the list of allowed values for the status of a
+// climate order. Allowed values are "awaiting_funds", "canceled",
+// "confirmed", "delivered" and "open".
+type ClimateOrderStatus string
+
+// ClimateOrderBeneficiaryParams is the set of parameters describing who a
+// climate order is made on behalf of.
+type ClimateOrderBeneficiaryParams struct {
+	PublicName string `form:"public_name"`
+}
+
+// ClimateOrderParams is the set of parameters that can be used when creating
+// or updating a climate order. Either Amount or MetricTons must be set when
+// creating an order, and only Beneficiary and metadata can be updated.
+// For more details see https://stripe.com/docs/api/climate/order/create.
+type ClimateOrderParams struct {
+	Params      `form:"*"`
+	Amount      int64                          `form:"amount"`
+	Beneficiary *ClimateOrderBeneficiaryParams `form:"beneficiary"`
+	Currency    Currency                       `form:"currency"`
+	MetricTons  float64                        `form:"metric_tons"`
+	Product     string                         `form:"product"`
+}
+
+// ClimateOrderCancelParams is the set of parameters that can be used when
+// canceling a climate order.
+// For more details see https://stripe.com/docs/api/climate/order/cancel.
+type ClimateOrderCancelParams struct {
+	Params `form:"*"`
+}
+
+// ClimateOrderListParams is the set of parameters that can be used when
+// listing climate orders.
+// For more details see https://stripe.com/docs/api/climate/order/list.
+type ClimateOrderListParams struct {
+	ListParams `form:"*"`
+}
+
+// ClimateOrderBeneficiary is who a climate order is made on behalf of.
+type ClimateOrderBeneficiary struct {
+	PublicName string `json:"public_name"`
+}
+
+// ClimateOrderDeliveryDetail is a delivery of part of the carbon removal of a
+// climate order by one of the product's suppliers.
+type ClimateOrderDeliveryDetail struct {
+	DeliveredAt Timestamp                `json:"delivered_at"`
+	Location    *ClimateSupplierLocation `json:"location"`
+	MetricTons  float64                  `json:"metric_tons,string"`
+	RegistryURL string                   `json:"registry_url"`
+	Supplier    *ClimateSupplier         `json:"supplier"`
+}
+
+// ClimateOrder is the resource representing a Stripe climate order, a
+// purchase of carbon removal.
+// For more details see https://stripe.com/docs/api/climate/order.
+type ClimateOrder struct {
+	APIResource
+	AmountFees           int64                          `json:"amount_fees"`
+	AmountSubtotal       int64                          `json:"amount_subtotal"`
+	AmountTotal          int64                          `json:"amount_total"`
+	Beneficiary          *ClimateOrderBeneficiary       `json:"beneficiary"`
+	CanceledAt           Timestamp                      `json:"canceled_at"`
+	CancellationReason   ClimateOrderCancellationReason `json:"cancellation_reason"`
+	Certificate          string                         `json:"certificate"`
+	ConfirmedAt          Timestamp                      `json:"confirmed_at"`
+	Created              Timestamp                      `json:"created"`
+	Currency             Currency                       `json:"currency"`
+	DelayedAt            Timestamp                      `json:"delayed_at"`
+	DeliveredAt          Timestamp                      `json:"delivered_at"`
+	DeliveryDetails      []*ClimateOrderDeliveryDetail  `json:"delivery_details"`
+	ExpectedDeliveryYear int64                          `json:"expected_delivery_year"`
+	ID                   string                         `json:"id"`
+	Live                 bool                           `json:"livemode"`
+	Meta                 map[string]string              `json:"metadata"`
+	MetricTons           float64                        `json:"metric_tons,string"`
+	Product              *ClimateProduct                `json:"product"`
+	ProductSubstitutedAt Timestamp                      `json:"product_substituted_at"`
+	Status               ClimateOrderStatus             `json:"status"`
+}
+
+// ClimateOrderList is a list of climate orders as retrieved from a list
+// endpoint.
+type ClimateOrderList struct {
+	APIResource
+	ListMeta
+	Values []*ClimateOrder `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a ClimateOrder.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (c *ClimateOrder) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		c.ID = id
+		return nil
+	}
+
+	type climateOrder ClimateOrder
+	var cc climateOrder
+	err := json.Unmarshal(data, &cc)
+	if err != nil {
+		return err
+	}
+
+	*c = ClimateOrder(cc)
+	return nil
+}
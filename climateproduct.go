@@ -0,0 +1,68 @@
+package stripe
+
+import "encoding/json"
+
+// ClimateProductParams is the set of parameters that can be used when
+// retrieving a climate product.
+// For more details see https://stripe.com/docs/api/climate/product/retrieve.
+type ClimateProductParams struct {
+	Params `form:"*"`
+}
+
+// ClimateProductListParams is the set of parameters that can be used when
+// listing climate products.
+// For more details see https://stripe.com/docs/api/climate/product/list.
+type ClimateProductListParams struct {
+	ListParams `form:"*"`
+}
+
+// ClimateProductPrice is the price of a metric ton of carbon removal in a
+// given currency, in its smallest unit.
+type ClimateProductPrice struct {
+	AmountFees     int64 `json:"amount_fees"`
+	AmountSubtotal int64 `json:"amount_subtotal"`
+	AmountTotal    int64 `json:"amount_total"`
+}
+
+// ClimateProduct is the resource representing a Stripe climate product, a
+// carbon removal unit that can be bought with climate orders.
+// For more details see https://stripe.com/docs/api/climate/product.
+type ClimateProduct struct {
+	APIResource
+	Created                   Timestamp                         `json:"created"`
+	CurrentPricesPerMetricTon map[Currency]*ClimateProductPrice `json:"current_prices_per_metric_ton"`
+	DeliveryYear              int64                             `json:"delivery_year"`
+	ID                        string                            `json:"id"`
+	Live                      bool                              `json:"livemode"`
+	MetricTonsAvailable       float64                           `json:"metric_tons_available,string"`
+	Name                      string                            `json:"name"`
+	Suppliers                 []*ClimateSupplier                `json:"suppliers"`
+}
+
+// ClimateProductList is a list of climate products as retrieved from a list
+// endpoint.
+type ClimateProductList struct {
+	APIResource
+	ListMeta
+	Values []*ClimateProduct `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a ClimateProduct.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (c *ClimateProduct) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		c.ID = id
+		return nil
+	}
+
+	type climateProduct ClimateProduct
+	var cc climateProduct
+	err := json.Unmarshal(data, &cc)
+	if err != nil {
+		return err
+	}
+
+	*c = ClimateProduct(cc)
+	return nil
+}
@@ -0,0 +1,73 @@
+package stripe
+
+import "encoding/json"
+
+// ClimateSupplierRemovalPathway is the list of allowed values for the way a
+// climate supplier removes carbon. Allowed values are
+// "biomass_carbon_removal_and_storage", "direct_air_capture" and
+// "enhanced_weathering".
+type ClimateSupplierRemovalPathway string
+
+// ClimateSupplierParams is the set of parameters that can be used when
+// retrieving a climate supplier.
+// For more details see https://stripe.com/docs/api/climate/supplier/retrieve.
+type ClimateSupplierParams struct {
+	Params `form:"*"`
+}
+
+// ClimateSupplierListParams is the set of parameters that can be used when
+// listing climate suppliers.
+// For more details see https://stripe.com/docs/api/climate/supplier/list.
+type ClimateSupplierListParams struct {
+	ListParams `form:"*"`
+}
+
+// ClimateSupplierLocation is a place where a climate supplier removes carbon.
+type ClimateSupplierLocation struct {
+	City      string  `json:"city"`
+	Country   string  `json:"country"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Region    string  `json:"region"`
+}
+
+// ClimateSupplier is the resource representing a Stripe climate supplier,
+// which delivers the carbon removal of climate orders.
+// For more details see https://stripe.com/docs/api/climate/supplier.
+type ClimateSupplier struct {
+	APIResource
+	ID             string                        `json:"id"`
+	InfoURL        string                        `json:"info_url"`
+	Live           bool                          `json:"livemode"`
+	Locations      []*ClimateSupplierLocation    `json:"locations"`
+	Name           string                        `json:"name"`
+	RemovalPathway ClimateSupplierRemovalPathway `json:"removal_pathway"`
+}
+
+// ClimateSupplierList is a list of climate suppliers as retrieved from a list
+// endpoint.
+type ClimateSupplierList struct {
+	APIResource
+	ListMeta
+	Values []*ClimateSupplier `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a ClimateSupplier.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (c *ClimateSupplier) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		c.ID = id
+		return nil
+	}
+
+	type climateSupplier ClimateSupplier
+	var cc climateSupplier
+	err := json.Unmarshal(data, &cc)
+	if err != nil {
+		return err
+	}
+
+	*c = ClimateSupplier(cc)
+	return nil
+}
@@ -13,20 +13,28 @@ type VerificationFieldsList struct {
 // CountrySpec is the resource representing the rules required for a Stripe account.
 // For more details see https://stripe.com/docs/api/#country_specs.
 type CountrySpec struct {
+	APIResource
 	DefaultCurrency                Currency                                   `json:"default_currency"`
 	ID                             string                                     `json:"id"`
-	SupportedBankAccountCurrencies map[Country][]Currency                     `json:"supported_bank_account_currencies"`
+	SupportedBankAccountCurrencies map[Currency][]Country                     `json:"supported_bank_account_currencies"`
 	SupportedPaymentCurrencies     []Currency                                 `json:"supported_payment_currencies"`
 	SupportedPaymentMethods        []string                                   `json:"supported_payment_methods"`
+	SupportedTransferCountries     []Country                                  `json:"supported_transfer_countries"`
 	VerificationFields             map[LegalEntityType]VerificationFieldsList `json:"verification_fields"`
 }
 
 // CountrySpecList is a list of country specs as retrieved from a list endpoint.
 type CountrySpecList struct {
+	APIResource
 	ListMeta
 	Values []*CountrySpec `json:"data"`
 }
 
+// CountrySpecParams are the parameters allowed during CountrySpec retrieval.
+type CountrySpecParams struct {
+	Params `form:"*"`
+}
+
 // CountrySpecListParams are the parameters allowed during CountrySpec listing.
 type CountrySpecListParams struct {
 	ListParams `form:"*"`
@@ -14,13 +14,22 @@ type Client struct {
 
 // Get returns a CountrySpec for a given country code
 // For more details see https://stripe.com/docs/api/ruby#retrieve_country_spec
-func Get(country string) (*stripe.CountrySpec, error) {
-	return getC().Get(country)
+func Get(country string, params *stripe.CountrySpecParams) (*stripe.CountrySpec, error) {
+	return getC().Get(country, params)
 }
 
-func (c Client) Get(country string) (*stripe.CountrySpec, error) {
+func (c Client) Get(country string, params *stripe.CountrySpecParams) (*stripe.CountrySpec, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
 	countrySpec := &stripe.CountrySpec{}
-	err := c.B.Call("GET", "/country_specs/"+country, c.Key, nil, nil, countrySpec)
+	err := c.B.Call("GET", "/country_specs/"+country, c.Key, body, commonParams, countrySpec)
 
 	return countrySpec, err
 }
@@ -69,5 +78,5 @@ func (i *Iter) CountrySpec() *stripe.CountrySpec {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
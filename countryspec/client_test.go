@@ -6,14 +6,39 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestCountrySpecGet(t *testing.T) {
-	spec, err := Get("US")
+	spec, err := Get("US", nil)
 	assert.Nil(t, err)
 	assert.NotNil(t, spec)
 }
 
+func TestCountrySpecGetTyped(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/country_specs/US", `{
+		"id": "US",
+		"default_currency": "usd",
+		"supported_bank_account_currencies": {"usd": ["US"]},
+		"supported_payment_currencies": ["usd", "eur"],
+		"supported_transfer_countries": ["US"],
+		"verification_fields": {
+			"company": {"minimum": ["business_profile.mcc"], "additional": []},
+			"individual": {"minimum": ["individual.dob.day"], "additional": ["individual.id_number"]}
+		}
+	}`)
+
+	spec, err := Client{B: b}.Get("US", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.Currency("usd"), spec.DefaultCurrency)
+	assert.Equal(t, []stripe.Country{"US"}, spec.SupportedBankAccountCurrencies["usd"])
+	assert.Equal(t, []stripe.Currency{"usd", "eur"}, spec.SupportedPaymentCurrencies)
+	assert.Equal(t, []stripe.Country{"US"}, spec.SupportedTransferCountries)
+	assert.Equal(t, []string{"business_profile.mcc"}, spec.VerificationFields[stripe.Company].MinimumFields)
+	assert.Equal(t, []string{"individual.id_number"}, spec.VerificationFields[stripe.Individual].AdditionalFields)
+}
+
 func TestCountrySpecList(t *testing.T) {
 	i := List(&stripe.CountrySpecListParams{})
 
@@ -1,23 +1,44 @@
 package stripe
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
 
 // CouponDuration is the list of allowed values for the coupon's duration.
 // Allowed values are "forever", "once", "repeating".
 type CouponDuration string
 
+// CouponAppliesToParams is the set of parameters that limits a coupon to some
+// products.
+type CouponAppliesToParams struct {
+	Products []string `form:"products"`
+}
+
+// CouponCurrencyOptionsParams is the set of parameters for the amount a
+// coupon takes off in an additional currency.
+type CouponCurrencyOptionsParams struct {
+	Amount uint64 `form:"amount_off"`
+}
+
 // CouponParams is the set of parameters that can be used when creating a coupon.
 // For more details see https://stripe.com/docs/api#create_coupon.
 type CouponParams struct {
 	Params         `form:"*"`
-	Amount         uint64         `form:"amount_off"`
-	Currency       Currency       `form:"currency"`
-	Duration       CouponDuration `form:"duration"`
-	DurationPeriod uint64         `form:"duration_in_months"`
-	ID             string         `form:"id"`
-	Percent        uint64         `form:"percent_off"`
-	RedeemBy       int64          `form:"redeem_by"`
-	Redemptions    uint64         `form:"max_redemptions"`
+	Amount         uint64                 `form:"amount_off"`
+	AppliesTo      *CouponAppliesToParams `form:"applies_to"`
+	Currency       Currency               `form:"currency"`
+	Duration       CouponDuration         `form:"duration"`
+	DurationPeriod uint64                 `form:"duration_in_months"`
+	ID             string                 `form:"id"`
+	Percent        uint64                 `form:"percent_off"`
+	RedeemBy       int64                  `form:"redeem_by"`
+	Redemptions    uint64                 `form:"max_redemptions"`
+
+	// CurrencyOptions sets the amount a fixed amount coupon takes off in
+	// currencies other than Currency, keyed by lowercase currency code.
+	CurrencyOptions map[Currency]*CouponCurrencyOptionsParams `form:"currency_options"`
 }
 
 // CouponListParams is the set of parameters that can be used when listing coupons.
@@ -28,44 +49,120 @@ type CouponListParams struct {
 	CreatedRange *RangeQueryParams `form:"created"`
 }
 
+// CouponAppliesTo is the set of products a coupon is limited to.
+type CouponAppliesTo struct {
+	Products []string `json:"products"`
+}
+
+// CouponCurrencyOptions is the amount a coupon takes off in an additional
+// currency.
+type CouponCurrencyOptions struct {
+	Amount uint64 `json:"amount_off"`
+}
+
 // Coupon is the resource representing a Stripe coupon.
 // For more details see https://stripe.com/docs/api#coupons.
 type Coupon struct {
-	Amount         uint64            `json:"amount_off"`
-	Created        int64             `json:"created"`
-	Currency       Currency          `json:"currency"`
-	Deleted        bool              `json:"deleted"`
-	Duration       CouponDuration    `json:"duration"`
-	DurationPeriod uint64            `json:"duration_in_months"`
-	ID             string            `json:"id"`
-	Live           bool              `json:"livemode"`
-	Meta           map[string]string `json:"metadata"`
-	Percent        uint64            `json:"percent_off"`
-	RedeemBy       int64             `json:"redeem_by"`
-	Redeemed       uint64            `json:"times_redeemed"`
-	Redemptions    uint64            `json:"max_redemptions"`
-	Valid          bool              `json:"valid"`
+	APIResource
+	Amount          uint64                              `json:"amount_off"`
+	AppliesTo       *CouponAppliesTo                    `json:"applies_to"`
+	Created         Timestamp                           `json:"created"`
+	CurrencyOptions map[Currency]*CouponCurrencyOptions `json:"currency_options"`
+	Currency        Currency                            `json:"currency"`
+	Deleted         bool                                `json:"deleted"`
+	Duration        CouponDuration                      `json:"duration"`
+	DurationPeriod  uint64                              `json:"duration_in_months"`
+	ID              string                              `json:"id"`
+	Live            bool                                `json:"livemode"`
+	Meta            map[string]string                   `json:"metadata"`
+	Percent         uint64                              `json:"percent_off"`
+	RedeemBy        Timestamp                           `json:"redeem_by"`
+	Redeemed        uint64                              `json:"times_redeemed"`
+	Redemptions     uint64                              `json:"max_redemptions"`
+	Valid           bool                                `json:"valid"`
 }
 
 // CouponList is a list of coupons as retrieved from a list endpoint.
 type CouponList struct {
+	APIResource
 	ListMeta
 	Values []*Coupon `json:"data"`
 }
 
+// AppliesInMonth reports whether the coupon still applies to an invoice
+// issued the given number of months after the coupon was redeemed, month 0
+// being the month of the redemption.
+func (c *Coupon) AppliesInMonth(month uint64) bool {
+	switch c.Duration {
+	case "forever":
+		return true
+	case "repeating":
+		return month < c.DurationPeriod
+	default:
+		return month == 0
+	}
+}
+
+// Discount returns the amount, in the smallest unit of currency, that the
+// coupon takes off amount. Percentage discounts are rounded to the nearest
+// unit and fixed discounts never exceed amount, so that the discounted total
+// is always amount minus the result. To discount several line items, pass the
+// sum of their amounts.
+//
+// Discount doesn't check whether the coupon can still be redeemed; see Valid
+// and AppliesInMonth. It returns an error if the coupon takes a fixed amount
+// off in a currency other than currency and has no currency option for it.
+func (c *Coupon) Discount(amount uint64, currency Currency) (uint64, error) {
+	if c.Percent > 0 {
+		percent := c.Percent
+		if percent > 100 {
+			percent = 100
+		}
+		return (amount*percent + 50) / 100, nil
+	}
+
+	off, ok := c.amountIn(currency)
+	if !ok {
+		return 0, fmt.Errorf("coupon %v takes %v off, which can't be applied to an amount in %v", c.ID, c.Currency, currency)
+	}
+
+	if off > amount {
+		return amount, nil
+	}
+	return off, nil
+}
+
+// amountIn returns the fixed amount the coupon takes off in currency, either
+// in the coupon's own currency or in one of its currency options.
+func (c *Coupon) amountIn(currency Currency) (uint64, bool) {
+	if strings.EqualFold(string(c.Currency), string(currency)) {
+		return c.Amount, true
+	}
+
+	for optionCurrency, option := range c.CurrencyOptions {
+		if option != nil && strings.EqualFold(string(optionCurrency), string(currency)) {
+			return option.Amount, true
+		}
+	}
+	return 0, false
+}
+
 // UnmarshalJSON handles deserialization of a Coupon.
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (c *Coupon) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		c.ID = id
+		return nil
+	}
+
 	type coupon Coupon
 	var cc coupon
 	err := json.Unmarshal(data, &cc)
-	if err == nil {
-		*c = Coupon(cc)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		c.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*c = Coupon(cc)
 	return nil
 }
@@ -142,5 +142,5 @@ func (i *Iter) Coupon() *stripe.Coupon {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -6,6 +6,7 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestCouponDel(t *testing.T) {
@@ -40,6 +41,40 @@ func TestCouponNew(t *testing.T) {
 	assert.NotNil(t, coupon)
 }
 
+func TestCouponNewMultiCurrency(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/coupons", `{
+		"id": "5OFF",
+		"amount_off": 500,
+		"applies_to": {"products": ["prod_123"]},
+		"currency": "usd",
+		"currency_options": {"eur": {"amount_off": 450}}
+	}`)
+
+	coupon, err := Client{B: b}.New(&stripe.CouponParams{
+		Amount: 500,
+		AppliesTo: &stripe.CouponAppliesToParams{
+			Products: []string{"prod_123"},
+		},
+		Currency: "usd",
+		CurrencyOptions: map[stripe.Currency]*stripe.CouponCurrencyOptionsParams{
+			"eur": {Amount: 450},
+		},
+		Duration:    "once",
+		RedeemBy:    1767225600,
+		Redemptions: 100,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"prod_123"}, coupon.AppliesTo.Products)
+	assert.Equal(t, uint64(450), coupon.CurrencyOptions["eur"].Amount)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "prod_123", values.Get("applies_to[products][]"))
+	assert.Equal(t, "450", values.Get("currency_options[eur][amount_off]"))
+	assert.Equal(t, "1767225600", values.Get("redeem_by"))
+	assert.Equal(t, "100", values.Get("max_redemptions"))
+}
+
 func TestCouponUpdate(t *testing.T) {
 	coupon, err := Update("25OFF", &stripe.CouponParams{
 		Redemptions: 5,
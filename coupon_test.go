@@ -0,0 +1,73 @@
+package stripe
+
+import (
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestCouponAppliesInMonth(t *testing.T) {
+	once := &Coupon{Duration: "once"}
+	assert.True(t, once.AppliesInMonth(0))
+	assert.False(t, once.AppliesInMonth(1))
+
+	repeating := &Coupon{Duration: "repeating", DurationPeriod: 3}
+	assert.True(t, repeating.AppliesInMonth(2))
+	assert.False(t, repeating.AppliesInMonth(3))
+
+	forever := &Coupon{Duration: "forever"}
+	assert.True(t, forever.AppliesInMonth(120))
+}
+
+func TestCouponDiscount(t *testing.T) {
+	// Percentage discounts are rounded to the nearest unit
+	percent := &Coupon{ID: "25OFF", Percent: 25}
+	discount, err := percent.Discount(1002, "usd")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(251), discount)
+
+	// Fixed discounts never exceed the amount
+	fixed := &Coupon{ID: "5OFF", Amount: 500, Currency: "usd"}
+	discount, err = fixed.Discount(1000, "USD")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(500), discount)
+
+	discount, err = fixed.Discount(300, "usd")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(300), discount)
+
+	_, err = fixed.Discount(1000, "eur")
+	assert.Error(t, err)
+
+	// Currency options give the amount off in other currencies
+	fixed.CurrencyOptions = map[Currency]*CouponCurrencyOptions{
+		"eur": {Amount: 450},
+	}
+	discount, err = fixed.Discount(1000, "EUR")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(450), discount)
+
+	_, err = fixed.Discount(1000, "gbp")
+	assert.Error(t, err)
+}
+
+func TestPromotionCodeDiscount(t *testing.T) {
+	code := &PromotionCode{
+		Active: true,
+		Code:   "SPRING",
+		Coupon: &Coupon{Percent: 10},
+	}
+	discount, err := code.Discount(1000, "usd")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), discount)
+
+	code.ExpiresAt = NewTimestamp(time.Now().Add(-time.Hour))
+	_, err = code.Discount(1000, "usd")
+	assert.Error(t, err)
+
+	code.ExpiresAt = 0
+	code.Active = false
+	_, err = code.Discount(1000, "usd")
+	assert.Error(t, err)
+}
@@ -0,0 +1,173 @@
+package stripe
+
+import "encoding/json"
+
+// CreditNoteReason is the reason why a given credit note was created.
+// Allowed values are "duplicate", "fraudulent", "order_change", and
+// "product_unsatisfactory".
+type CreditNoteReason string
+
+// CreditNoteStatus is the list of allowed values for the credit note's
+// status. Allowed values are "issued" and "void".
+type CreditNoteStatus string
+
+// CreditNoteType is the list of allowed values for the credit note's type.
+// Allowed values are "post_payment" and "pre_payment".
+type CreditNoteType string
+
+// CreditNoteLineItemType is the list of allowed values for the credit note
+// line item's type. Allowed values are "custom_line_item" and
+// "invoice_line_item".
+type CreditNoteLineItemType string
+
+// CreditNoteLineParams is the set of parameters that can be used for a line
+// item when creating or previewing a credit note.
+type CreditNoteLineParams struct {
+	Amount          int64                  `form:"amount"`
+	Desc            string                 `form:"description"`
+	InvoiceLineItem string                 `form:"invoice_line_item"`
+	Quantity        uint64                 `form:"quantity"`
+	Type            CreditNoteLineItemType `form:"type"`
+	UnitAmount      int64                  `form:"unit_amount"`
+}
+
+// CreditNoteParams is the set of parameters that can be used when creating
+// or updating a credit note.
+//
+// The total of a credit note must be split between CreditAmount (credited to
+// the customer's balance), RefundAmount (refunded through Refund or a new
+// refund), and OutOfBandAmount (settled outside of Stripe).
+// For more details see https://stripe.com/docs/api#create_credit_note and https://stripe.com/docs/api#update_credit_note.
+type CreditNoteParams struct {
+	Params          `form:"*"`
+	Amount          int64                   `form:"amount"`
+	CreditAmount    int64                   `form:"credit_amount"`
+	Invoice         string                  `form:"invoice"`
+	Lines           []*CreditNoteLineParams `form:"lines,indexed"`
+	Memo            string                  `form:"memo"`
+	OutOfBandAmount int64                   `form:"out_of_band_amount"`
+	Reason          CreditNoteReason        `form:"reason"`
+	Refund          string                  `form:"refund"`
+	RefundAmount    int64                   `form:"refund_amount"`
+}
+
+// CreditNotePreviewParams is the set of parameters that can be used when
+// previewing a credit note.
+// For more details see https://stripe.com/docs/api#preview_credit_note.
+type CreditNotePreviewParams struct {
+	Params          `form:"*"`
+	Amount          int64                   `form:"amount"`
+	CreditAmount    int64                   `form:"credit_amount"`
+	Invoice         string                  `form:"invoice"`
+	Lines           []*CreditNoteLineParams `form:"lines,indexed"`
+	Memo            string                  `form:"memo"`
+	OutOfBandAmount int64                   `form:"out_of_band_amount"`
+	Reason          CreditNoteReason        `form:"reason"`
+	Refund          string                  `form:"refund"`
+	RefundAmount    int64                   `form:"refund_amount"`
+}
+
+// CreditNoteVoidParams is the set of parameters that can be used when voiding
+// a credit note.
+// For more details see https://stripe.com/docs/api#void_credit_note.
+type CreditNoteVoidParams struct {
+	Params `form:"*"`
+}
+
+// CreditNoteListParams is the set of parameters that can be used when listing
+// credit notes.
+// For more details see https://stripe.com/docs/api#list_credit_notes.
+type CreditNoteListParams struct {
+	ListParams   `form:"*"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Customer     string            `form:"customer"`
+	Invoice      string            `form:"invoice"`
+}
+
+// CreditNoteLineItemListParams is the set of parameters that can be used when
+// listing the line items of a credit note.
+// For more details see https://stripe.com/docs/api#credit_note_lines.
+type CreditNoteLineItemListParams struct {
+	ListParams `form:"*"`
+
+	// ID is the credit note ID to list line items for.
+	ID string `form:"-"` // Goes in the URL
+}
+
+// CreditNoteLineItem is the resource representing a Stripe credit note line
+// item.
+// For more details see https://stripe.com/docs/api#credit_note_line_item_object.
+type CreditNoteLineItem struct {
+	APIResource
+	Amount          int64                  `json:"amount"`
+	Desc            string                 `json:"description"`
+	DiscountAmount  int64                  `json:"discount_amount"`
+	ID              string                 `json:"id"`
+	InvoiceLineItem string                 `json:"invoice_line_item"`
+	Live            bool                   `json:"livemode"`
+	Quantity        uint64                 `json:"quantity"`
+	Type            CreditNoteLineItemType `json:"type"`
+	UnitAmount      int64                  `json:"unit_amount"`
+}
+
+// CreditNoteLineItemList is a list of credit note line items as retrieved
+// from a list endpoint.
+type CreditNoteLineItemList struct {
+	APIResource
+	ListMeta
+	Values []*CreditNoteLineItem `json:"data"`
+}
+
+// CreditNote is the resource representing a Stripe credit note.
+// For more details see https://stripe.com/docs/api#credit_notes.
+type CreditNote struct {
+	APIResource
+	Amount          int64                   `json:"amount"`
+	Created         Timestamp               `json:"created"`
+	Currency        Currency                `json:"currency"`
+	Customer        *Customer               `json:"customer"`
+	ID              string                  `json:"id"`
+	Invoice         *Invoice                `json:"invoice"`
+	Lines           *CreditNoteLineItemList `json:"lines"`
+	Live            bool                    `json:"livemode"`
+	Memo            string                  `json:"memo"`
+	Meta            map[string]string       `json:"metadata"`
+	Number          string                  `json:"number"`
+	OutOfBandAmount int64                   `json:"out_of_band_amount"`
+	PDF             string                  `json:"pdf"`
+	Reason          CreditNoteReason        `json:"reason"`
+	Refund          *Refund                 `json:"refund"`
+	Status          CreditNoteStatus        `json:"status"`
+	Subtotal        int64                   `json:"subtotal"`
+	Total           int64                   `json:"total"`
+	Type            CreditNoteType          `json:"type"`
+	VoidedAt        Timestamp               `json:"voided_at"`
+}
+
+// CreditNoteList is a list of credit notes as retrieved from a list endpoint.
+type CreditNoteList struct {
+	APIResource
+	ListMeta
+	Values []*CreditNote `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a CreditNote.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (c *CreditNote) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		c.ID = id
+		return nil
+	}
+
+	type creditNote CreditNote
+	var cc creditNote
+	err := json.Unmarshal(data, &cc)
+	if err != nil {
+		return err
+	}
+
+	*c = CreditNote(cc)
+	return nil
+}
@@ -0,0 +1,223 @@
+// Package creditnote provides the /credit_notes APIs
+package creditnote
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	ReasonDuplicate             stripe.CreditNoteReason = "duplicate"
+	ReasonFraudulent            stripe.CreditNoteReason = "fraudulent"
+	ReasonOrderChange           stripe.CreditNoteReason = "order_change"
+	ReasonProductUnsatisfactory stripe.CreditNoteReason = "product_unsatisfactory"
+
+	StatusIssued stripe.CreditNoteStatus = "issued"
+	StatusVoid   stripe.CreditNoteStatus = "void"
+
+	TypePostPayment stripe.CreditNoteType = "post_payment"
+	TypePrePayment  stripe.CreditNoteType = "pre_payment"
+
+	LineItemTypeCustomLineItem  stripe.CreditNoteLineItemType = "custom_line_item"
+	LineItemTypeInvoiceLineItem stripe.CreditNoteLineItemType = "invoice_line_item"
+)
+
+// Client is used to invoke /credit_notes APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new credit note.
+// For more details see https://stripe.com/docs/api#create_credit_note.
+func New(params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	creditNote := &stripe.CreditNote{}
+	err := c.B.Call("POST", "/credit_notes", c.Key, body, commonParams, creditNote)
+
+	return creditNote, err
+}
+
+// Get returns the details of a credit note.
+// For more details see https://stripe.com/docs/api#retrieve_credit_note.
+func Get(id string, params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	creditNote := &stripe.CreditNote{}
+	err := c.B.Call("GET", fmt.Sprintf("/credit_notes/%v", id), c.Key, body, commonParams, creditNote)
+
+	return creditNote, err
+}
+
+// Update updates a credit note's properties.
+// For more details see https://stripe.com/docs/api#update_credit_note.
+func Update(id string, params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.CreditNoteParams) (*stripe.CreditNote, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	creditNote := &stripe.CreditNote{}
+	err := c.B.Call("POST", fmt.Sprintf("/credit_notes/%v", id), c.Key, body, commonParams, creditNote)
+
+	return creditNote, err
+}
+
+// Void voids a credit note, which reverses its adjustments to the customer
+// balance. Refunds and out of band amounts aren't reversed.
+// For more details see https://stripe.com/docs/api#void_credit_note.
+func Void(id string, params *stripe.CreditNoteVoidParams) (*stripe.CreditNote, error) {
+	return getC().Void(id, params)
+}
+
+func (c Client) Void(id string, params *stripe.CreditNoteVoidParams) (*stripe.CreditNote, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	creditNote := &stripe.CreditNote{}
+	err := c.B.Call("POST", fmt.Sprintf("/credit_notes/%v/void", id), c.Key, body, commonParams, creditNote)
+
+	return creditNote, err
+}
+
+// Preview returns a preview of the credit note that would be issued with the
+// given parameters, without creating it.
+// For more details see https://stripe.com/docs/api#preview_credit_note.
+func Preview(params *stripe.CreditNotePreviewParams) (*stripe.CreditNote, error) {
+	return getC().Preview(params)
+}
+
+func (c Client) Preview(params *stripe.CreditNotePreviewParams) (*stripe.CreditNote, error) {
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	creditNote := &stripe.CreditNote{}
+	err := c.B.Call("GET", "/credit_notes/preview", c.Key, body, &params.Params, creditNote)
+
+	return creditNote, err
+}
+
+// List returns a list of credit notes.
+// For more details see https://stripe.com/docs/api#list_credit_notes.
+func List(params *stripe.CreditNoteListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.CreditNoteListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.CreditNoteList{}
+		err := c.B.Call("GET", "/credit_notes", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// ListLines returns a list of a credit note's line items.
+// For more details see https://stripe.com/docs/api#credit_note_lines.
+func ListLines(params *stripe.CreditNoteLineItemListParams) *LineItemIter {
+	return getC().ListLines(params)
+}
+
+func (c Client) ListLines(params *stripe.CreditNoteLineItemListParams) *LineItemIter {
+	body := &form.Values{}
+	var lp *stripe.ListParams = &params.ListParams
+	var p *stripe.Params = params.ToParams()
+	form.AppendTo(body, params)
+
+	return &LineItemIter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.CreditNoteLineItemList{}
+		err := c.B.Call("GET", fmt.Sprintf("/credit_notes/%v/lines", params.ID), c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of CreditNotes.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// CreditNote returns the most recent CreditNote
+// visited by a call to Next.
+func (i *Iter) CreditNote() *stripe.CreditNote {
+	return i.Current().(*stripe.CreditNote)
+}
+
+// LineItemIter is an iterator for lists of CreditNoteLineItems.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type LineItemIter struct {
+	*stripe.Iter
+}
+
+// CreditNoteLineItem returns the most recent CreditNoteLineItem
+// visited by a call to Next.
+func (i *LineItemIter) CreditNoteLineItem() *stripe.CreditNoteLineItem {
+	return i.Current().(*stripe.CreditNoteLineItem)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,74 @@
+package creditnote
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestCreditNoteGet(t *testing.T) {
+	cn, err := Get("cn_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, cn)
+}
+
+func TestCreditNoteList(t *testing.T) {
+	i := List(&stripe.CreditNoteListParams{Invoice: "in_123"})
+
+	// Verify that we can get at least one credit note
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.CreditNote())
+}
+
+func TestCreditNoteListLines(t *testing.T) {
+	i := ListLines(&stripe.CreditNoteLineItemListParams{ID: "cn_123"})
+
+	// Verify that we can get at least one line item
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.CreditNoteLineItem())
+}
+
+func TestCreditNoteNew(t *testing.T) {
+	cn, err := New(&stripe.CreditNoteParams{
+		Amount:          1000,
+		Invoice:         "in_123",
+		OutOfBandAmount: 400,
+		Reason:          ReasonDuplicate,
+		RefundAmount:    600,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, cn)
+}
+
+func TestCreditNotePreview(t *testing.T) {
+	cn, err := Preview(&stripe.CreditNotePreviewParams{
+		Invoice: "in_123",
+		Lines: []*stripe.CreditNoteLineParams{
+			{
+				InvoiceLineItem: "il_123",
+				Quantity:        1,
+				Type:            LineItemTypeInvoiceLineItem,
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, cn)
+}
+
+func TestCreditNoteUpdate(t *testing.T) {
+	cn, err := Update("cn_123", &stripe.CreditNoteParams{
+		Memo: "Updated memo",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, cn)
+}
+
+func TestCreditNoteVoid(t *testing.T) {
+	cn, err := Void("cn_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, cn)
+}
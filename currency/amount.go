@@ -0,0 +1,113 @@
+package currency
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	stripe "github.com/stripe/stripe-go"
+)
+
+// zeroDecimal are the currencies whose amounts are expressed in the currency
+// itself rather than in a smaller unit, like 500 for ¥500.
+var zeroDecimal = map[stripe.Currency]bool{
+	BIF: true, CLP: true, DJF: true, GNF: true, JPY: true, KMF: true,
+	KRW: true, MGA: true, PYG: true, RWF: true, UGX: true, VND: true,
+	VUV: true, XAF: true, XOF: true, XPF: true,
+}
+
+// threeDecimal are the currencies whose amounts are expressed in thousandths
+// of the currency, like 1500 for 1.500 KWD.
+var threeDecimal = map[stripe.Currency]bool{
+	BHD: true, JOD: true, KWD: true, OMR: true, TND: true,
+}
+
+// Decimals returns the number of decimal digits of the amounts of currency c
+// as accepted by the API: 0 for zero-decimal currencies like JPY, 3 for
+// currencies like KWD, and 2 for every other currency.
+// For more details see https://stripe.com/docs/currencies#zero-decimal.
+func Decimals(c stripe.Currency) int {
+	c = stripe.Currency(strings.ToLower(string(c)))
+
+	switch {
+	case zeroDecimal[c]:
+		return 0
+	case threeDecimal[c]:
+		return 3
+	}
+	return 2
+}
+
+// ParseAmount parses a decimal amount of currency c, like "19.99" for USD,
+// into the amount in the smallest unit of the currency expected by the API,
+// like 1999. Amounts with more decimal digits than the currency has, like
+// "19.999" for USD or "500.5" for JPY, are rejected rather than rounded, as
+// are thousands separators and currency symbols.
+func ParseAmount(s string, c stripe.Currency) (int64, error) {
+	decimals := Decimals(c)
+	input := s
+
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+
+	whole, fraction := s, ""
+	if i := strings.Index(s, "."); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+
+	if whole == "" && fraction == "" || !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("Invalid amount %q", input)
+	}
+	if len(fraction) > decimals {
+		return 0, fmt.Errorf("Invalid amount %q: %v only has %v decimal digits", input, c, decimals)
+	}
+
+	digits := whole + fraction + strings.Repeat("0", decimals-len(fraction))
+
+	var amount int64
+	for _, d := range digits {
+		if amount > (math.MaxInt64-int64(d-'0'))/10 {
+			return 0, fmt.Errorf("Invalid amount %q: out of range", input)
+		}
+		amount = amount*10 + int64(d-'0')
+	}
+
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// FormatAmount formats an amount in the smallest unit of currency c, as
+// returned by the API, as a decimal amount, like "19.99" for 1999 USD or
+// "500" for 500 JPY.
+func FormatAmount(amount int64, c stripe.Currency) string {
+	decimals := Decimals(c)
+
+	var sign string
+	abs := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		abs = uint64(-(amount + 1)) + 1
+	}
+
+	digits := fmt.Sprintf("%0*d", decimals+1, abs)
+	if decimals == 0 {
+		return sign + digits
+	}
+
+	i := len(digits) - decimals
+	return sign + digits[:i] + "." + digits[i:]
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
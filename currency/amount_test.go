@@ -0,0 +1,67 @@
+package currency
+
+import (
+	"math"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+)
+
+func TestDecimals(t *testing.T) {
+	assert.Equal(t, 2, Decimals(USD))
+	assert.Equal(t, 0, Decimals(JPY))
+	assert.Equal(t, 3, Decimals(KWD))
+
+	// Currencies are compared ignoring case
+	assert.Equal(t, 0, Decimals("JPY"))
+}
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "19.99", FormatAmount(1999, USD))
+	assert.Equal(t, "0.05", FormatAmount(5, USD))
+	assert.Equal(t, "-0.05", FormatAmount(-5, USD))
+	assert.Equal(t, "500", FormatAmount(500, JPY))
+	assert.Equal(t, "1.500", FormatAmount(1500, KWD))
+	assert.Equal(t, "-92233720368547758.08", FormatAmount(math.MinInt64, USD))
+}
+
+func TestParseAmount(t *testing.T) {
+	valid := []struct {
+		s        string
+		currency stripe.Currency
+		amount   int64
+	}{
+		{"19.99", USD, 1999},
+		{"19.9", USD, 1990},
+		{"19", USD, 1900},
+		{".5", USD, 50},
+		{" -0.05 ", USD, -5},
+		{"500", JPY, 500},
+		{"1.5", KWD, 1500},
+		{"92233720368547758.07", USD, math.MaxInt64},
+	}
+	for _, tc := range valid {
+		amount, err := ParseAmount(tc.s, tc.currency)
+		assert.NoError(t, err, tc.s)
+		assert.Equal(t, tc.amount, amount, tc.s)
+	}
+
+	invalid := []struct {
+		s        string
+		currency stripe.Currency
+	}{
+		{"", USD},
+		{"-", USD},
+		{"19.999", USD},
+		{"500.5", JPY},
+		{"1,999.00", USD},
+		{"$19.99", USD},
+		{"1e3", USD},
+		{"92233720368547758.08", USD},
+	}
+	for _, tc := range invalid {
+		_, err := ParseAmount(tc.s, tc.currency)
+		assert.Error(t, err, tc.s)
+	}
+}
@@ -20,6 +20,7 @@ const (
 	BBD stripe.Currency = "bbd" // Barbadian Dollar
 	BDT stripe.Currency = "bdt" // Bangladeshi Taka
 	BGN stripe.Currency = "bgn" // Bulgarian Lev
+	BHD stripe.Currency = "bhd" // Bahraini Dinar
 	BIF stripe.Currency = "bif" // Burundian Franc
 	BMD stripe.Currency = "bmd" // Bermudian Dollar
 	BND stripe.Currency = "bnd" // Brunei Dollar
@@ -64,12 +65,14 @@ const (
 	INR stripe.Currency = "inr" // Indian Rupee
 	ISK stripe.Currency = "isk" // Icelandic Króna
 	JMD stripe.Currency = "jmd" // Jamaican Dollar
+	JOD stripe.Currency = "jod" // Jordanian Dinar
 	JPY stripe.Currency = "jpy" // Japanese Yen
 	KES stripe.Currency = "kes" // Kenyan Shilling
 	KGS stripe.Currency = "kgs" // Kyrgyzstani Som
 	KHR stripe.Currency = "khr" // Cambodian Riel
 	KMF stripe.Currency = "kmf" // Comorian Franc
 	KRW stripe.Currency = "krw" // South Korean Won
+	KWD stripe.Currency = "kwd" // Kuwaiti Dinar
 	KYD stripe.Currency = "kyd" // Cayman Islands Dollar
 	KZT stripe.Currency = "kzt" // Kazakhstani Tenge
 	LAK stripe.Currency = "lak" // Lao Kip
@@ -98,6 +101,7 @@ const (
 	NOK stripe.Currency = "nok" // Norwegian Krone
 	NPR stripe.Currency = "npr" // Nepalese Rupee
 	NZD stripe.Currency = "nzd" // New Zealand Dollar
+	OMR stripe.Currency = "omr" // Omani Rial
 	PAB stripe.Currency = "pab" // Panamanian Balboa
 	PEN stripe.Currency = "pen" // Peruvian Nuevo Sol
 	PGK stripe.Currency = "pgk" // Papua New Guinean Kina
@@ -124,6 +128,7 @@ const (
 	SZL stripe.Currency = "szl" // Swazi Lilangeni
 	THB stripe.Currency = "thb" // Thai Baht
 	TJS stripe.Currency = "tjs" // Tajikistani Somoni
+	TND stripe.Currency = "tnd" // Tunisian Dinar
 	TOP stripe.Currency = "top" // Tongan Paʻanga
 	TRY stripe.Currency = "try" // Turkish Lira
 	TTD stripe.Currency = "ttd" // Trinidad and Tobago Dollar
@@ -4,26 +4,67 @@ import (
 	"encoding/json"
 )
 
+// CustomerInvoiceAmountTaxDisplay is the list of allowed values for how tax
+// is displayed on the invoices of a customer. Allowed values are
+// "exclude_tax" and "include_inclusive_tax".
+type CustomerInvoiceAmountTaxDisplay string
+
 // CustomerParams is the set of parameters that can be used when creating or updating a customer.
 // For more details see https://stripe.com/docs/api#create_customer and https://stripe.com/docs/api#update_customer.
 type CustomerParams struct {
-	Params         `form:"*"`
-	Balance        int64                    `form:"account_balance"`
-	BalanceZero    bool                     `form:"account_balance,zero"`
-	BusinessVatID  string                   `form:"business_vat_id"`
-	Coupon         string                   `form:"coupon"`
-	CouponEmpty    bool                     `form:"coupon,empty"`
-	DefaultSource  string                   `form:"default_source"`
-	Desc           string                   `form:"description"`
-	Email          string                   `form:"email"`
-	Plan           string                   `form:"plan"`
-	Quantity       uint64                   `form:"quantity"`
-	Shipping       *CustomerShippingDetails `form:"shipping"`
-	Source         *SourceParams            `form:"*"` // SourceParams has custom encoding so brought to top level with "*"
-	TaxPercent     float64                  `form:"tax_percent"`
-	TaxPercentZero bool                     `form:"tax_percent,zero"`
-	Token          string                   `form:"-"` // This doesn't seem to be used?
-	TrialEnd       int64                    `form:"trial_end"`
+	Params          `form:"*"`
+	Balance         int64                          `form:"account_balance"`
+	BalanceZero     bool                           `form:"account_balance,zero"`
+	BusinessVatID   string                         `form:"business_vat_id"`
+	Coupon          string                         `form:"coupon"`
+	CouponEmpty     bool                           `form:"coupon,empty"`
+	DefaultSource   string                         `form:"default_source"`
+	Desc            string                         `form:"description"`
+	Email           string                         `form:"email"`
+	InvoiceSettings *CustomerInvoiceSettingsParams `form:"invoice_settings"`
+	Plan            string                         `form:"plan"`
+	Quantity        uint64                         `form:"quantity"`
+	Shipping        *CustomerShippingDetails       `form:"shipping"`
+	Source          *SourceParams                  `form:"*"` // SourceParams has custom encoding so brought to top level with "*"
+	TaxIDData       []*CustomerTaxIDDataParams     `form:"tax_id_data,indexed"`
+	TaxPercent      float64                        `form:"tax_percent"`
+	TaxPercentZero  bool                           `form:"tax_percent,zero"`
+	TestClock       string                         `form:"test_clock"`
+	Token           string                         `form:"-"` // This doesn't seem to be used?
+	TrialEnd        int64                          `form:"trial_end"`
+}
+
+// CustomerInvoiceCustomFieldParams is the set of parameters for a custom
+// field shown on the invoices of a customer.
+type CustomerInvoiceCustomFieldParams struct {
+	Name  string `form:"name"`
+	Value string `form:"value"`
+}
+
+// CustomerInvoiceRenderingOptionsParams is the set of parameters for how the
+// invoices of a customer are rendered.
+type CustomerInvoiceRenderingOptionsParams struct {
+	AmountTaxDisplay      CustomerInvoiceAmountTaxDisplay `form:"amount_tax_display"`
+	AmountTaxDisplayEmpty bool                            `form:"amount_tax_display,empty"`
+}
+
+// CustomerInvoiceSettingsParams is the set of parameters for the default
+// settings of the invoices of a customer. Set CustomFieldsEmpty or
+// FooterEmpty to clear a setting.
+type CustomerInvoiceSettingsParams struct {
+	CustomFields         []*CustomerInvoiceCustomFieldParams    `form:"custom_fields,indexed"`
+	CustomFieldsEmpty    bool                                   `form:"custom_fields,empty"`
+	DefaultPaymentMethod string                                 `form:"default_payment_method"`
+	Footer               string                                 `form:"footer"`
+	FooterEmpty          bool                                   `form:"footer,empty"`
+	RenderingOptions     *CustomerInvoiceRenderingOptionsParams `form:"rendering_options"`
+}
+
+// CustomerTaxIDDataParams is the set of parameters for a tax ID that's created
+// along with a new customer.
+type CustomerTaxIDDataParams struct {
+	Type  TaxIDType `form:"type"`
+	Value string    `form:"value"`
 }
 
 // SetSource adds valid sources to a CustomerParams object,
@@ -45,30 +86,57 @@ type CustomerListParams struct {
 // Customer is the resource representing a Stripe customer.
 // For more details see https://stripe.com/docs/api#customers.
 type Customer struct {
-	Balance       int64                    `json:"account_balance"`
-	BusinessVatID string                   `json:"business_vat_id"`
-	Currency      Currency                 `json:"currency"`
-	Created       int64                    `json:"created"`
-	DefaultSource *PaymentSource           `json:"default_source"`
-	Deleted       bool                     `json:"deleted"`
-	Delinquent    bool                     `json:"delinquent"`
-	Desc          string                   `json:"description"`
-	Discount      *Discount                `json:"discount"`
-	Email         string                   `json:"email"`
-	ID            string                   `json:"id"`
-	Live          bool                     `json:"livemode"`
-	Meta          map[string]string        `json:"metadata"`
-	Shipping      *CustomerShippingDetails `json:"shipping"`
-	Sources       *SourceList              `json:"sources"`
-	Subs          *SubList                 `json:"subscriptions"`
+	APIResource
+	Balance         int64                    `json:"account_balance"`
+	BusinessVatID   string                   `json:"business_vat_id"`
+	Currency        Currency                 `json:"currency"`
+	Created         Timestamp                `json:"created"`
+	DefaultSource   *PaymentSource           `json:"default_source"`
+	Deleted         bool                     `json:"deleted"`
+	Delinquent      bool                     `json:"delinquent"`
+	Desc            string                   `json:"description"`
+	Discount        *Discount                `json:"discount"`
+	Email           string                   `json:"email"`
+	ID              string                   `json:"id"`
+	InvoiceSettings *CustomerInvoiceSettings `json:"invoice_settings"`
+	Live            bool                     `json:"livemode"`
+	Meta            map[string]string        `json:"metadata"`
+	Shipping        *CustomerShippingDetails `json:"shipping"`
+	Sources         *SourceList              `json:"sources"`
+	Subs            *SubList                 `json:"subscriptions"`
+	TaxIDs          *TaxIDList               `json:"tax_ids"`
+	TestClock       *TestHelpersTestClock    `json:"test_clock"`
 }
 
 // CustomerList is a list of customers as retrieved from a list endpoint.
 type CustomerList struct {
+	APIResource
 	ListMeta
 	Values []*Customer `json:"data"`
 }
 
+// CustomerInvoiceCustomField is a custom field shown on the invoices of a
+// customer.
+type CustomerInvoiceCustomField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CustomerInvoiceRenderingOptions represents how the invoices of a customer
+// are rendered.
+type CustomerInvoiceRenderingOptions struct {
+	AmountTaxDisplay CustomerInvoiceAmountTaxDisplay `json:"amount_tax_display"`
+}
+
+// CustomerInvoiceSettings represents the default settings of the invoices of
+// a customer. DefaultPaymentMethod is the ID of a payment method.
+type CustomerInvoiceSettings struct {
+	CustomFields         []*CustomerInvoiceCustomField    `json:"custom_fields"`
+	DefaultPaymentMethod string                           `json:"default_payment_method"`
+	Footer               string                           `json:"footer"`
+	RenderingOptions     *CustomerInvoiceRenderingOptions `json:"rendering_options"`
+}
+
 // CustomerShippingDetails is the structure containing shipping information.
 type CustomerShippingDetails struct {
 	Address Address `json:"address" form:"address"`
@@ -80,15 +148,18 @@ type CustomerShippingDetails struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (c *Customer) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		c.ID = id
+		return nil
+	}
+
 	type customer Customer
 	var cc customer
 	err := json.Unmarshal(data, &cc)
-	if err == nil {
-		*c = Customer(cc)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		c.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*c = Customer(cc)
 	return nil
 }
@@ -6,6 +6,11 @@ import (
 	"github.com/stripe/stripe-go/form"
 )
 
+const (
+	InvoiceAmountTaxDisplayExcludeTax          stripe.CustomerInvoiceAmountTaxDisplay = "exclude_tax"
+	InvoiceAmountTaxDisplayIncludeInclusiveTax stripe.CustomerInvoiceAmountTaxDisplay = "include_inclusive_tax"
+)
+
 // Client is used to invoke /customers APIs.
 type Client struct {
 	B   stripe.Backend
@@ -145,5 +150,5 @@ func (i *Iter) Customer() *stripe.Customer {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
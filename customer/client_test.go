@@ -6,6 +6,7 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestCustomerDel(t *testing.T) {
@@ -43,6 +44,18 @@ func TestCustomerNew_NilParams(t *testing.T) {
 	assert.NotNil(t, customer)
 }
 
+func TestCustomerNew_TestClock(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/customers", `{"id": "cus_123", "test_clock": "clock_123"}`)
+
+	customer, err := Client{B: b}.New(&stripe.CustomerParams{
+		TestClock: "clock_123",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "clock_123", customer.TestClock.ID)
+	assert.Equal(t, "clock_123", b.LastCall().Values().Get("test_clock"))
+}
+
 func TestCustomerUpdate(t *testing.T) {
 	customer, err := Update("cus_123", &stripe.CustomerParams{
 		Email: "foo@example.com",
@@ -50,3 +63,19 @@ func TestCustomerUpdate(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, customer)
 }
+
+func TestCustomerUpdate_InvoiceSettings(t *testing.T) {
+	customer, err := Update("cus_123", &stripe.CustomerParams{
+		InvoiceSettings: &stripe.CustomerInvoiceSettingsParams{
+			CustomFields: []*stripe.CustomerInvoiceCustomFieldParams{
+				{Name: "PO number", Value: "PO-1234"},
+			},
+			Footer: "Thank you for your business!",
+			RenderingOptions: &stripe.CustomerInvoiceRenderingOptionsParams{
+				AmountTaxDisplay: InvoiceAmountTaxDisplayIncludeInclusiveTax,
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, customer)
+}
@@ -0,0 +1,49 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/form"
+)
+
+func TestCustomerParams_AppendTo_InvoiceSettings(t *testing.T) {
+	params := &CustomerParams{
+		InvoiceSettings: &CustomerInvoiceSettingsParams{
+			CustomFields: []*CustomerInvoiceCustomFieldParams{
+				{Name: "PO number", Value: "PO-1234"},
+			},
+			DefaultPaymentMethod: "pm_123",
+			FooterEmpty:          true,
+			RenderingOptions: &CustomerInvoiceRenderingOptionsParams{
+				AmountTaxDisplay: "exclude_tax",
+			},
+		},
+	}
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	assert.Equal(t, []string{"PO number"}, body.Get("invoice_settings[custom_fields][0][name]"))
+	assert.Equal(t, []string{"PO-1234"}, body.Get("invoice_settings[custom_fields][0][value]"))
+	assert.Equal(t, []string{"pm_123"}, body.Get("invoice_settings[default_payment_method]"))
+	assert.Equal(t, []string{""}, body.Get("invoice_settings[footer]"))
+	assert.Equal(t, []string{"exclude_tax"}, body.Get("invoice_settings[rendering_options][amount_tax_display]"))
+}
+
+func TestCustomerUnmarshal_InvoiceSettings(t *testing.T) {
+	var c Customer
+	err := json.Unmarshal([]byte(`{
+		"id": "cus_123",
+		"invoice_settings": {
+			"custom_fields": [{"name": "PO number", "value": "PO-1234"}],
+			"default_payment_method": "pm_123",
+			"footer": "Thanks!",
+			"rendering_options": {"amount_tax_display": "exclude_tax"}
+		}
+	}`), &c)
+	assert.NoError(t, err)
+	assert.Equal(t, "PO-1234", c.InvoiceSettings.CustomFields[0].Value)
+	assert.Equal(t, "pm_123", c.InvoiceSettings.DefaultPaymentMethod)
+	assert.Equal(t, "Thanks!", c.InvoiceSettings.Footer)
+	assert.Equal(t, CustomerInvoiceAmountTaxDisplay("exclude_tax"), c.InvoiceSettings.RenderingOptions.AmountTaxDisplay)
+}
@@ -0,0 +1,78 @@
+package stripe
+
+import "encoding/json"
+
+// CustomerBalanceTransactionType is the list of allowed values for the
+// customer balance transaction's type. Allowed values are "adjustment",
+// "applied_to_invoice", "credit_note", "initial", "invoice_too_large",
+// "invoice_too_small", "migration", "unapplied_from_invoice", and
+// "unspent_receiver_credit".
+type CustomerBalanceTransactionType string
+
+// CustomerBalanceTransactionParams is the set of parameters that can be used
+// when creating or updating a customer balance transaction. A negative Amount
+// credits the customer, while a positive one debits them.
+// For more details see https://stripe.com/docs/api#create_customer_balance_transaction.
+type CustomerBalanceTransactionParams struct {
+	Params   `form:"*"`
+	Amount   int64    `form:"amount"`
+	Currency Currency `form:"currency"`
+	Customer string   `form:"-"` // Included in URL
+	Desc     string   `form:"description"`
+}
+
+// CustomerBalanceTransactionListParams is the set of parameters that can be
+// used when listing customer balance transactions.
+// For more details see https://stripe.com/docs/api#customer_balance_transactions.
+type CustomerBalanceTransactionListParams struct {
+	ListParams `form:"*"`
+	Customer   string `form:"-"` // Included in URL
+}
+
+// CustomerBalanceTransaction is the resource representing a change to the
+// balance of a customer, which is applied to their next invoices.
+// EndingBalance is the balance of the customer after the transaction.
+// For more details see https://stripe.com/docs/api#customer_balance_transactions.
+type CustomerBalanceTransaction struct {
+	APIResource
+	Amount        int64                          `json:"amount"`
+	Created       Timestamp                      `json:"created"`
+	CreditNote    *CreditNote                    `json:"credit_note"`
+	Currency      Currency                       `json:"currency"`
+	Customer      *Customer                      `json:"customer"`
+	Desc          string                         `json:"description"`
+	EndingBalance int64                          `json:"ending_balance"`
+	ID            string                         `json:"id"`
+	Invoice       *Invoice                       `json:"invoice"`
+	Live          bool                           `json:"livemode"`
+	Meta          map[string]string              `json:"metadata"`
+	Type          CustomerBalanceTransactionType `json:"type"`
+}
+
+// CustomerBalanceTransactionList is a list of customer balance transactions
+// as retrieved from a list endpoint.
+type CustomerBalanceTransactionList struct {
+	APIResource
+	ListMeta
+	Values []*CustomerBalanceTransaction `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a CustomerBalanceTransaction.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (c *CustomerBalanceTransaction) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		c.ID = id
+		return nil
+	}
+
+	type customerBalanceTransaction CustomerBalanceTransaction
+	var cc customerBalanceTransaction
+	err := json.Unmarshal(data, &cc)
+	if err != nil {
+		return err
+	}
+
+	*c = CustomerBalanceTransaction(cc)
+	return nil
+}
@@ -0,0 +1,141 @@
+// Package customerbalancetransaction provides the /customers/balance_transactions APIs
+package customerbalancetransaction
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	TypeAdjustment            stripe.CustomerBalanceTransactionType = "adjustment"
+	TypeAppliedToInvoice      stripe.CustomerBalanceTransactionType = "applied_to_invoice"
+	TypeCreditNote            stripe.CustomerBalanceTransactionType = "credit_note"
+	TypeInitial               stripe.CustomerBalanceTransactionType = "initial"
+	TypeInvoiceTooLarge       stripe.CustomerBalanceTransactionType = "invoice_too_large"
+	TypeInvoiceTooSmall       stripe.CustomerBalanceTransactionType = "invoice_too_small"
+	TypeMigration             stripe.CustomerBalanceTransactionType = "migration"
+	TypeUnappliedFromInvoice  stripe.CustomerBalanceTransactionType = "unapplied_from_invoice"
+	TypeUnspentReceiverCredit stripe.CustomerBalanceTransactionType = "unspent_receiver_credit"
+)
+
+// Client is used to invoke /customers/balance_transactions APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new customer balance transaction.
+// For more details see https://stripe.com/docs/api#create_customer_balance_transaction.
+func New(params *stripe.CustomerBalanceTransactionParams) (*stripe.CustomerBalanceTransaction, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.CustomerBalanceTransactionParams) (*stripe.CustomerBalanceTransaction, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Customer == "" {
+		return nil, fmt.Errorf("params.Customer must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	customerBalanceTransaction := &stripe.CustomerBalanceTransaction{}
+	err := c.B.Call("POST", fmt.Sprintf("/customers/%v/balance_transactions", params.Customer), c.Key, body, &params.Params, customerBalanceTransaction)
+
+	return customerBalanceTransaction, err
+}
+
+// Get returns the details of a customer balance transaction.
+// For more details see https://stripe.com/docs/api#retrieve_customer_balance_transaction.
+func Get(id string, params *stripe.CustomerBalanceTransactionParams) (*stripe.CustomerBalanceTransaction, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.CustomerBalanceTransactionParams) (*stripe.CustomerBalanceTransaction, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Customer == "" {
+		return nil, fmt.Errorf("params.Customer must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	customerBalanceTransaction := &stripe.CustomerBalanceTransaction{}
+	err := c.B.Call("GET", fmt.Sprintf("/customers/%v/balance_transactions/%v", params.Customer, id), c.Key, body, &params.Params, customerBalanceTransaction)
+
+	return customerBalanceTransaction, err
+}
+
+// Update updates a customer balance transaction's properties.
+// For more details see https://stripe.com/docs/api#update_customer_balance_transaction.
+func Update(id string, params *stripe.CustomerBalanceTransactionParams) (*stripe.CustomerBalanceTransaction, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.CustomerBalanceTransactionParams) (*stripe.CustomerBalanceTransaction, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Customer == "" {
+		return nil, fmt.Errorf("params.Customer must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	customerBalanceTransaction := &stripe.CustomerBalanceTransaction{}
+	err := c.B.Call("POST", fmt.Sprintf("/customers/%v/balance_transactions/%v", params.Customer, id), c.Key, body, &params.Params, customerBalanceTransaction)
+
+	return customerBalanceTransaction, err
+}
+
+// List returns a list of customer balance transactions.
+// For more details see https://stripe.com/docs/api#list_customer_balance_transactions.
+func List(params *stripe.CustomerBalanceTransactionListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.CustomerBalanceTransactionListParams) *Iter {
+	body := &form.Values{}
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+	p = params.ToParams()
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.CustomerBalanceTransactionList{}
+		err := c.B.Call("GET", fmt.Sprintf("/customers/%v/balance_transactions", params.Customer), c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of CustomerBalanceTransactions.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// CustomerBalanceTransaction returns the most recent CustomerBalanceTransaction
+// visited by a call to Next.
+func (i *Iter) CustomerBalanceTransaction() *stripe.CustomerBalanceTransaction {
+	return i.Current().(*stripe.CustomerBalanceTransaction)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,53 @@
+package customerbalancetransaction
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestCustomerBalanceTransactionGet(t *testing.T) {
+	transaction, err := Get("cbtxn_123", &stripe.CustomerBalanceTransactionParams{
+		Customer: "cus_123",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, transaction)
+}
+
+func TestCustomerBalanceTransactionGetNoCustomer(t *testing.T) {
+	_, err := Get("cbtxn_123", &stripe.CustomerBalanceTransactionParams{})
+	assert.NotNil(t, err)
+}
+
+func TestCustomerBalanceTransactionList(t *testing.T) {
+	i := List(&stripe.CustomerBalanceTransactionListParams{
+		Customer: "cus_123",
+	})
+
+	// Verify that we can get at least one transaction
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.CustomerBalanceTransaction())
+}
+
+func TestCustomerBalanceTransactionNew(t *testing.T) {
+	transaction, err := New(&stripe.CustomerBalanceTransactionParams{
+		Amount:   -500,
+		Currency: "usd",
+		Customer: "cus_123",
+		Desc:     "Credit for the outage",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, transaction)
+}
+
+func TestCustomerBalanceTransactionUpdate(t *testing.T) {
+	transaction, err := Update("cbtxn_123", &stripe.CustomerBalanceTransactionParams{
+		Customer: "cus_123",
+		Desc:     "Credit for the outage of May 1st",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, transaction)
+}
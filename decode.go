@@ -0,0 +1,157 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DecodeError is the error reported for a single object of a list page that
+// couldn't be decoded. The other objects of the page are still returned.
+type DecodeError struct {
+	// Err is the error returned by the JSON decoder.
+	Err error
+
+	// ID is the ID of the object that couldn't be decoded, if it could be
+	// read at all.
+	ID string
+
+	// Index is the position of the object in its page.
+	Index int
+
+	// Path is the path to the value that couldn't be decoded, like
+	// "data[2].charge.amount".
+	Path string
+}
+
+// Error returns a description of the failing object and the underlying
+// error.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("cannot decode %v: %v", e.Path, e.Err)
+}
+
+// DecodeErrors is the error returned when one or more objects of a list page
+// couldn't be decoded. The objects that could be decoded are still returned,
+// and iterators keep going past the objects that failed; their errors are
+// reported by Iter.Err once the iteration is over.
+type DecodeErrors []*DecodeError
+
+// Error returns a description of every failing object.
+func (e DecodeErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// listMetaType is the type of the metadata embedded in every list type.
+var listMetaType = reflect.TypeOf(ListMeta{})
+
+// unmarshalResponse decodes the body of a response into v. Unlike a plain
+// json.Unmarshal, it never panics, and when v is a list it decodes every
+// object of the page separately so that one malformed object doesn't cause
+// the whole page to be lost. In that case, the objects that could be decoded
+// are set on v and a DecodeErrors is returned.
+func unmarshalResponse(data []byte, v interface{}) error {
+	err := safeUnmarshal(data, v)
+	if err == nil {
+		return nil
+	}
+
+	values, ok := listValues(v)
+	if !ok {
+		return err
+	}
+
+	var page struct {
+		ListMeta
+		Data []json.RawMessage `json:"data"`
+	}
+	if json.Unmarshal(data, &page) != nil {
+		// The page itself is malformed, not one of its objects
+		return err
+	}
+
+	reflect.ValueOf(v).Elem().FieldByName("ListMeta").Set(reflect.ValueOf(page.ListMeta))
+
+	var errs DecodeErrors
+	decoded := reflect.MakeSlice(values.Type(), 0, len(page.Data))
+	for i, raw := range page.Data {
+		elem := reflect.New(values.Type().Elem().Elem())
+		if err := safeUnmarshal(raw, elem.Interface()); err != nil {
+			errs = append(errs, newDecodeError(i, raw, err))
+			continue
+		}
+		decoded = reflect.Append(decoded, elem)
+	}
+	values.Set(decoded)
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// parseID returns the ID held by data if it's a JSON string, which is how
+// the API represents an object that wasn't expanded.
+func parseID(data []byte) (string, bool) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return "", false
+	}
+
+	var id string
+	if err := json.Unmarshal(data, &id); err != nil {
+		return "", false
+	}
+	return id, true
+}
+
+// safeUnmarshal is json.Unmarshal, except that a panic in one of the custom
+// UnmarshalJSON implementations is returned as an error.
+func safeUnmarshal(data []byte, v interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while decoding: %v", r)
+		}
+	}()
+
+	return json.Unmarshal(data, v)
+}
+
+// listValues returns the Values field of v if v is a pointer to a list type,
+// that is a struct embedding ListMeta with a slice of pointers as Values.
+func listValues(v interface{}) (reflect.Value, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	meta, ok := rv.Elem().Type().FieldByName("ListMeta")
+	if !ok || !meta.Anonymous || meta.Type != listMetaType {
+		return reflect.Value{}, false
+	}
+
+	values := rv.Elem().FieldByName("Values")
+	if !values.IsValid() || values.Kind() != reflect.Slice ||
+		values.Type().Elem().Kind() != reflect.Ptr {
+		return reflect.Value{}, false
+	}
+
+	return values, true
+}
+
+func newDecodeError(index int, raw json.RawMessage, err error) *DecodeError {
+	path := fmt.Sprintf("data[%v]", index)
+	if typeErr, ok := err.(*json.UnmarshalTypeError); ok && typeErr.Field != "" {
+		path += "." + typeErr.Field
+	}
+
+	var obj struct {
+		ID string `json:"id"`
+	}
+	json.Unmarshal(raw, &obj)
+
+	return &DecodeError{Err: err, ID: obj.ID, Index: index, Path: path}
+}
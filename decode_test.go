@@ -0,0 +1,81 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalResponse(t *testing.T) {
+	var charge Charge
+	err := unmarshalResponse([]byte(`{"id":"ch_123","amount":100}`), &charge)
+	assert.NoError(t, err)
+	assert.Equal(t, "ch_123", charge.ID)
+
+	// Errors on objects other than lists are returned as is
+	err = unmarshalResponse([]byte(`{"id":"ch_123","amount":"abc"}`), &charge)
+	_, ok := err.(*json.UnmarshalTypeError)
+	assert.True(t, ok)
+}
+
+func TestUnmarshalResponseList(t *testing.T) {
+	data := []byte(`{
+		"has_more": true,
+		"data": [
+			{"id": "ch_1", "amount": 100},
+			{"id": "ch_2", "amount": "abc"},
+			{"id": "ch_3", "amount": 300}
+		]
+	}`)
+
+	var list ChargeList
+	err := unmarshalResponse(data, &list)
+	assert.True(t, list.More)
+	assert.Equal(t, 2, len(list.Values))
+	assert.Equal(t, "ch_1", list.Values[0].ID)
+	assert.Equal(t, "ch_3", list.Values[1].ID)
+
+	errs, ok := err.(DecodeErrors)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "ch_2", errs[0].ID)
+	assert.Equal(t, 1, errs[0].Index)
+	assert.Equal(t, "data[1].amount", errs[0].Path)
+}
+
+func TestUnmarshalResponseMalformedExpansion(t *testing.T) {
+	// An expanded customer that's neither an ID nor an object
+	data := []byte(`{"data": [{"id": "ch_1", "customer": 1}, {"id": "ch_2", "customer": "cus_123"}]}`)
+
+	var list ChargeList
+	err := unmarshalResponse(data, &list)
+	assert.Equal(t, 1, len(list.Values))
+	assert.Equal(t, "cus_123", list.Values[0].Customer.ID)
+
+	errs, ok := err.(DecodeErrors)
+	assert.True(t, ok)
+	assert.Equal(t, "ch_1", errs[0].ID)
+	assert.Equal(t, 0, errs[0].Index)
+}
+
+type panickyObject struct {
+	ID string `json:"id"`
+}
+
+func (o *panickyObject) UnmarshalJSON(data []byte) error {
+	panic("cannot decode")
+}
+
+func TestUnmarshalResponsePanic(t *testing.T) {
+	var list struct {
+		ListMeta
+		Values []*panickyObject `json:"data"`
+	}
+	err := unmarshalResponse([]byte(`{"data": [{"id": "obj_1"}]}`), &list)
+	assert.Equal(t, 0, len(list.Values))
+
+	errs, ok := err.(DecodeErrors)
+	assert.True(t, ok)
+	assert.Equal(t, "obj_1", errs[0].ID)
+}
@@ -1,5 +1,7 @@
 package stripe
 
+import "encoding/json"
+
 // DiscountParams is the set of parameters that can be used when deleting a discount.
 type DiscountParams struct {
 	Params `form:"*"`
@@ -8,10 +10,35 @@ type DiscountParams struct {
 // Discount is the resource representing a Stripe discount.
 // For more details see https://stripe.com/docs/api#discounts.
 type Discount struct {
-	Coupon   *Coupon `json:"coupon"`
-	Customer string  `json:"customer"`
-	Deleted  bool    `json:"deleted"`
-	End      int64   `json:"end"`
-	Start    int64   `json:"start"`
-	Sub      string  `json:"subscription"`
+	APIResource
+	Coupon        *Coupon        `json:"coupon"`
+	Customer      string         `json:"customer"`
+	Deleted       bool           `json:"deleted"`
+	End           Timestamp      `json:"end"`
+	ID            string         `json:"id"`
+	Invoice       string         `json:"invoice"`
+	InvoiceItem   string         `json:"invoice_item"`
+	PromotionCode *PromotionCode `json:"promotion_code"`
+	Start         Timestamp      `json:"start"`
+	Sub           string         `json:"subscription"`
+}
+
+// UnmarshalJSON handles deserialization of a Discount.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (d *Discount) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		d.ID = id
+		return nil
+	}
+
+	type discount Discount
+	var dd discount
+	err := json.Unmarshal(data, &dd)
+	if err != nil {
+		return err
+	}
+
+	*d = Discount(dd)
+	return nil
 }
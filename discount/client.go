@@ -59,5 +59,5 @@ func (c Client) DelSub(subscriptionID string, params *stripe.DiscountParams) (*s
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -0,0 +1,36 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestDiscountUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"id": "in_123",
+		"discounts": [
+			"di_123",
+			{
+				"id": "di_456",
+				"coupon": {"id": "co_123"},
+				"promotion_code": "promo_123"
+			}
+		]
+	}`)
+
+	var invoice Invoice
+	err := json.Unmarshal(data, &invoice)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(invoice.Discounts))
+
+	// Unexpanded discount
+	assert.Equal(t, "di_123", invoice.Discounts[0].ID)
+	assert.Nil(t, invoice.Discounts[0].Coupon)
+
+	// Expanded discount with an unexpanded promotion code
+	assert.Equal(t, "di_456", invoice.Discounts[1].ID)
+	assert.Equal(t, "co_123", invoice.Discounts[1].Coupon.ID)
+	assert.Equal(t, "promo_123", invoice.Discounts[1].PromotionCode.ID)
+}
@@ -59,23 +59,27 @@ type DisputeEvidenceParams struct {
 // DisputeListParams is the set of parameters that can be used when listing disputes.
 // For more details see https://stripe.com/docs/api#list_disputes.
 type DisputeListParams struct {
-	ListParams   `form:"*"`
-	Created      int64             `form:"created"`
-	CreatedRange *RangeQueryParams `form:"created"`
+	ListParams    `form:"*"`
+	Charge        string            `form:"charge"`
+	Created       int64             `form:"created"`
+	CreatedRange  *RangeQueryParams `form:"created"`
+	PaymentIntent string            `form:"payment_intent"`
 }
 
 // Dispute is the resource representing a Stripe dispute.
 // For more details see https://stripe.com/docs/api#disputes.
 type Dispute struct {
+	APIResource
 	Amount          uint64            `json:"amount"`
-	Charge          string            `json:"charge"`
-	Created         int64             `json:"created"`
+	Charge          *Charge           `json:"charge"`
+	Created         Timestamp         `json:"created"`
 	Currency        Currency          `json:"currency"`
 	Evidence        *DisputeEvidence  `json:"evidence"`
 	EvidenceDetails *EvidenceDetails  `json:"evidence_details"`
 	ID              string            `json:"id"`
 	Live            bool              `json:"livemode"`
 	Meta            map[string]string `json:"metadata"`
+	PaymentIntent   *PaymentIntent    `json:"payment_intent"`
 	Reason          DisputeReason     `json:"reason"`
 	Refundable      bool              `json:"is_charge_refundable"`
 	Status          DisputeStatus     `json:"status"`
@@ -84,6 +88,7 @@ type Dispute struct {
 
 // DisputeList is a list of disputes as retrieved from a list endpoint.
 type DisputeList struct {
+	APIResource
 	ListMeta
 	Values []*Dispute `json:"data"`
 }
@@ -91,10 +96,10 @@ type DisputeList struct {
 // EvidenceDetails is the structure representing more details about
 // the dispute.
 type EvidenceDetails struct {
-	Count       int   `json:"submission_count"`
-	DueDate     int64 `json:"due_by"`
-	HasEvidence bool  `json:"has_evidence"`
-	PastDue     bool  `json:"past_due"`
+	Count       int       `json:"submission_count"`
+	DueDate     Timestamp `json:"due_by"`
+	HasEvidence bool      `json:"has_evidence"`
+	PastDue     bool      `json:"past_due"`
 }
 
 // DisputeEvidence is the structure that contains various details about
@@ -131,46 +136,22 @@ type DisputeEvidence struct {
 	UncategorizedText            string `json:"uncategorized_text"`
 }
 
-// File represents a link to downloadable content.
-type File struct {
-	Created int64  `json:"created"`
-	ID      string `json:"id"`
-	Mime    string `json:"mime_type"`
-	Purpose string `json:"purpose"`
-	Size    int    `json:"size"`
-	URL     string `json:"url"`
-}
-
 // UnmarshalJSON handles deserialization of a Dispute.
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (t *Dispute) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
 	type dispute Dispute
 	var dd dispute
 	err := json.Unmarshal(data, &dd)
-	if err == nil {
-		*t = Dispute(dd)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		t.ID = string(data[1 : len(data)-1])
-	}
-
-	return nil
-}
-
-// UnmarshalJSON handles deserialization of a File.
-// This custom unmarshaling is needed because the resulting
-// property may be an id or the full struct if it was expanded.
-func (f *File) UnmarshalJSON(data []byte) error {
-	type file File
-	var ff file
-	err := json.Unmarshal(data, &ff)
-	if err == nil {
-		*f = File(ff)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		f.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*t = Dispute(dd)
 	return nil
 }
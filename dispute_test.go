@@ -0,0 +1,28 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestDisputeListUnmarshal_ExpandedCharge(t *testing.T) {
+	data := []byte(`{
+		"object": "list",
+		"data": [
+			{"id": "dp_123", "charge": "ch_123"},
+			{"id": "dp_456", "charge": {"id": "ch_456", "amount": 1000}}
+		]
+	}`)
+
+	var list DisputeList
+	err := json.Unmarshal(data, &list)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(list.Values))
+
+	assert.Equal(t, "ch_123", list.Values[0].Charge.ID)
+
+	assert.Equal(t, "ch_456", list.Values[1].Charge.ID)
+	assert.Equal(t, uint64(1000), list.Values[1].Charge.Amount)
+}
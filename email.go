@@ -0,0 +1,90 @@
+package stripe
+
+import "strings"
+
+// Emailer is the interface implemented by objects that hold the email
+// addresses of the customer they relate to, like charges, customers and
+// disputes. It lets support tooling find every object related to an email
+// address, whatever its type.
+type Emailer interface {
+	// Emails returns the email addresses held by the object and the objects
+	// that it expands, without duplicates.
+	Emails() []string
+}
+
+// MatchEmail reports whether email is one of the email addresses held by e.
+// Addresses are compared ignoring case and surrounding whitespace.
+func MatchEmail(e Emailer, email string) bool {
+	email = normalizeEmail(email)
+	if email == "" {
+		return false
+	}
+
+	for _, candidate := range e.Emails() {
+		if normalizeEmail(candidate) == email {
+			return true
+		}
+	}
+	return false
+}
+
+// Emails is the Emailer implementation for Charge. It returns the receipt
+// email, the email of the billing details and of the owner of the source, and
+// the email of the customer if it was expanded.
+func (c *Charge) Emails() []string {
+	var emails []string
+
+	emails = appendEmail(emails, c.Email)
+	if c.BillingDetails != nil {
+		emails = appendEmail(emails, c.BillingDetails.Email)
+	}
+	if c.Source != nil && c.Source.SourceObject != nil {
+		emails = appendEmail(emails, c.Source.SourceObject.Owner.Email)
+	}
+	if c.Customer != nil {
+		emails = appendEmail(emails, c.Customer.Email)
+	}
+
+	return emails
+}
+
+// Emails is the Emailer implementation for Customer.
+func (c *Customer) Emails() []string {
+	return appendEmail(nil, c.Email)
+}
+
+// Emails is the Emailer implementation for Dispute. It returns the customer
+// email given as evidence and the emails of the charge if it was expanded.
+func (d *Dispute) Emails() []string {
+	var emails []string
+
+	if d.Evidence != nil {
+		emails = appendEmail(emails, d.Evidence.CustomerEmail)
+	}
+	if d.Charge != nil {
+		for _, email := range d.Charge.Emails() {
+			emails = appendEmail(emails, email)
+		}
+	}
+
+	return emails
+}
+
+// appendEmail appends email to emails unless it's empty or already there.
+func appendEmail(emails []string, email string) []string {
+	normalized := normalizeEmail(email)
+	if normalized == "" {
+		return emails
+	}
+
+	for _, existing := range emails {
+		if normalizeEmail(existing) == normalized {
+			return emails
+		}
+	}
+	return append(emails, strings.TrimSpace(email))
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
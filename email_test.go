@@ -0,0 +1,46 @@
+package stripe
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestChargeEmails(t *testing.T) {
+	charge := &Charge{
+		BillingDetails: &BillingDetails{Email: "Jenny@example.com"},
+		Customer:       &Customer{Email: "billing@example.com"},
+		Email:          "jenny@example.com",
+	}
+	assert.Equal(t, []string{"jenny@example.com", "billing@example.com"}, charge.Emails())
+}
+
+func TestDisputeEmails(t *testing.T) {
+	dispute := &Dispute{
+		Charge:   &Charge{Email: "jenny@example.com"},
+		Evidence: &DisputeEvidence{CustomerEmail: "jenny.rosen@example.com"},
+	}
+	assert.Equal(t, []string{"jenny.rosen@example.com", "jenny@example.com"}, dispute.Emails())
+
+	// Disputes with an unexpanded charge and no evidence hold no email
+	dispute = &Dispute{Charge: &Charge{ID: "ch_123"}}
+	assert.Equal(t, 0, len(dispute.Emails()))
+}
+
+func TestMatchEmail(t *testing.T) {
+	objects := []Emailer{
+		&Charge{Email: "jenny@example.com"},
+		&Customer{Email: " JENNY@example.com "},
+		&Dispute{Evidence: &DisputeEvidence{CustomerEmail: "someone@example.com"}},
+	}
+
+	var matched int
+	for _, obj := range objects {
+		if MatchEmail(obj, "Jenny@Example.com") {
+			matched++
+		}
+	}
+	assert.Equal(t, 2, matched)
+
+	assert.False(t, MatchEmail(&Customer{}, ""))
+}
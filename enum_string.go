@@ -0,0 +1,501 @@
+// Code generated by scripts/schema; DO NOT EDIT.
+
+package stripe
+
+// String returns the value of the AccountBusinessType.
+func (x AccountBusinessType) String() string { return string(x) }
+
+// String returns the value of the AccountCapabilityStatus.
+func (x AccountCapabilityStatus) String() string { return string(x) }
+
+// String returns the value of the AccountLinkCollect.
+func (x AccountLinkCollect) String() string { return string(x) }
+
+// String returns the value of the AccountLinkType.
+func (x AccountLinkType) String() string { return string(x) }
+
+// String returns the value of the AccountRejectReason.
+func (x AccountRejectReason) String() string { return string(x) }
+
+// String returns the value of the AccountRequirementsDisabledReason.
+func (x AccountRequirementsDisabledReason) String() string { return string(x) }
+
+// String returns the value of the AccountType.
+func (x AccountType) String() string { return string(x) }
+
+// String returns the value of the BankAccountStatus.
+func (x BankAccountStatus) String() string { return string(x) }
+
+// String returns the value of the BillingPortalSessionFlowAfterCompletionType.
+func (x BillingPortalSessionFlowAfterCompletionType) String() string { return string(x) }
+
+// String returns the value of the BillingPortalSessionFlowType.
+func (x BillingPortalSessionFlowType) String() string { return string(x) }
+
+// String returns the value of the CardBrand.
+func (x CardBrand) String() string { return string(x) }
+
+// String returns the value of the CardFunding.
+func (x CardFunding) String() string { return string(x) }
+
+// String returns the value of the ClimateOrderCancellationReason.
+func (x ClimateOrderCancellationReason) String() string { return string(x) }
+
+// String returns the value of the ClimateOrderStatus.
+func (x ClimateOrderStatus) String() string { return string(x) }
+
+// String returns the value of the ClimateSupplierRemovalPathway.
+func (x ClimateSupplierRemovalPathway) String() string { return string(x) }
+
+// String returns the value of the Country.
+func (x Country) String() string { return string(x) }
+
+// String returns the value of the CouponDuration.
+func (x CouponDuration) String() string { return string(x) }
+
+// String returns the value of the CreditNoteLineItemType.
+func (x CreditNoteLineItemType) String() string { return string(x) }
+
+// String returns the value of the CreditNoteReason.
+func (x CreditNoteReason) String() string { return string(x) }
+
+// String returns the value of the CreditNoteStatus.
+func (x CreditNoteStatus) String() string { return string(x) }
+
+// String returns the value of the CreditNoteType.
+func (x CreditNoteType) String() string { return string(x) }
+
+// String returns the value of the Currency.
+func (x Currency) String() string { return string(x) }
+
+// String returns the value of the CustomerBalanceTransactionType.
+func (x CustomerBalanceTransactionType) String() string { return string(x) }
+
+// String returns the value of the CustomerInvoiceAmountTaxDisplay.
+func (x CustomerInvoiceAmountTaxDisplay) String() string { return string(x) }
+
+// String returns the value of the DisputeReason.
+func (x DisputeReason) String() string { return string(x) }
+
+// String returns the value of the DisputeStatus.
+func (x DisputeStatus) String() string { return string(x) }
+
+// String returns the value of the ErrorCode.
+func (x ErrorCode) String() string { return string(x) }
+
+// String returns the value of the ErrorType.
+func (x ErrorType) String() string { return string(x) }
+
+// String returns the value of the EstimateType.
+func (x EstimateType) String() string { return string(x) }
+
+// String returns the value of the ExternalAccountType.
+func (x ExternalAccountType) String() string { return string(x) }
+
+// String returns the value of the FilePurpose.
+func (x FilePurpose) String() string { return string(x) }
+
+// String returns the value of the FileUploadPurpose.
+func (x FileUploadPurpose) String() string { return string(x) }
+
+// String returns the value of the FraudReport.
+func (x FraudReport) String() string { return string(x) }
+
+// String returns the value of the Gender.
+func (x Gender) String() string { return string(x) }
+
+// String returns the value of the IdentityVerificationDetailsCode.
+func (x IdentityVerificationDetailsCode) String() string { return string(x) }
+
+// String returns the value of the IdentityVerificationReportCheckStatus.
+func (x IdentityVerificationReportCheckStatus) String() string { return string(x) }
+
+// String returns the value of the IdentityVerificationReportDocumentType.
+func (x IdentityVerificationReportDocumentType) String() string { return string(x) }
+
+// String returns the value of the IdentityVerificationSessionLastErrorCode.
+func (x IdentityVerificationSessionLastErrorCode) String() string { return string(x) }
+
+// String returns the value of the IdentityVerificationSessionRedactionStatus.
+func (x IdentityVerificationSessionRedactionStatus) String() string { return string(x) }
+
+// String returns the value of the IdentityVerificationSessionStatus.
+func (x IdentityVerificationSessionStatus) String() string { return string(x) }
+
+// String returns the value of the IdentityVerificationSessionType.
+func (x IdentityVerificationSessionType) String() string { return string(x) }
+
+// String returns the value of the IdentityVerificationStatus.
+func (x IdentityVerificationStatus) String() string { return string(x) }
+
+// String returns the value of the Interval.
+func (x Interval) String() string { return string(x) }
+
+// String returns the value of the InvoiceBilling.
+func (x InvoiceBilling) String() string { return string(x) }
+
+// String returns the value of the InvoiceLineType.
+func (x InvoiceLineType) String() string { return string(x) }
+
+// String returns the value of the InvoiceStatus.
+func (x InvoiceStatus) String() string { return string(x) }
+
+// String returns the value of the IssuingAuthorizationMethod.
+func (x IssuingAuthorizationMethod) String() string { return string(x) }
+
+// String returns the value of the IssuingAuthorizationReason.
+func (x IssuingAuthorizationReason) String() string { return string(x) }
+
+// String returns the value of the IssuingAuthorizationStatus.
+func (x IssuingAuthorizationStatus) String() string { return string(x) }
+
+// String returns the value of the IssuingCardShippingCarrier.
+func (x IssuingCardShippingCarrier) String() string { return string(x) }
+
+// String returns the value of the IssuingCardShippingService.
+func (x IssuingCardShippingService) String() string { return string(x) }
+
+// String returns the value of the IssuingCardShippingStatus.
+func (x IssuingCardShippingStatus) String() string { return string(x) }
+
+// String returns the value of the IssuingCardStatus.
+func (x IssuingCardStatus) String() string { return string(x) }
+
+// String returns the value of the IssuingCardType.
+func (x IssuingCardType) String() string { return string(x) }
+
+// String returns the value of the IssuingCardholderStatus.
+func (x IssuingCardholderStatus) String() string { return string(x) }
+
+// String returns the value of the IssuingCardholderType.
+func (x IssuingCardholderType) String() string { return string(x) }
+
+// String returns the value of the IssuingDisputeReason.
+func (x IssuingDisputeReason) String() string { return string(x) }
+
+// String returns the value of the IssuingDisputeStatus.
+func (x IssuingDisputeStatus) String() string { return string(x) }
+
+// String returns the value of the IssuingSpendingLimitInterval.
+func (x IssuingSpendingLimitInterval) String() string { return string(x) }
+
+// String returns the value of the IssuingTransactionType.
+func (x IssuingTransactionType) String() string { return string(x) }
+
+// String returns the value of the LegalEntityType.
+func (x LegalEntityType) String() string { return string(x) }
+
+// String returns the value of the MandateACSSDebitPaymentSchedule.
+func (x MandateACSSDebitPaymentSchedule) String() string { return string(x) }
+
+// String returns the value of the MandateACSSDebitTransactionType.
+func (x MandateACSSDebitTransactionType) String() string { return string(x) }
+
+// String returns the value of the MandateBACSDebitNetworkStatus.
+func (x MandateBACSDebitNetworkStatus) String() string { return string(x) }
+
+// String returns the value of the MandateCustomerAcceptanceType.
+func (x MandateCustomerAcceptanceType) String() string { return string(x) }
+
+// String returns the value of the MandateStatus.
+func (x MandateStatus) String() string { return string(x) }
+
+// String returns the value of the MandateType.
+func (x MandateType) String() string { return string(x) }
+
+// String returns the value of the OAuthErrorCode.
+func (x OAuthErrorCode) String() string { return string(x) }
+
+// String returns the value of the OAuthGrantType.
+func (x OAuthGrantType) String() string { return string(x) }
+
+// String returns the value of the OAuthScope.
+func (x OAuthScope) String() string { return string(x) }
+
+// String returns the value of the OAuthTokenType.
+func (x OAuthTokenType) String() string { return string(x) }
+
+// String returns the value of the OrderStatus.
+func (x OrderStatus) String() string { return string(x) }
+
+// String returns the value of the PaymentIntentAction.
+func (x PaymentIntentAction) String() string { return string(x) }
+
+// String returns the value of the PaymentIntentCancellationReason.
+func (x PaymentIntentCancellationReason) String() string { return string(x) }
+
+// String returns the value of the PaymentIntentCaptureMethod.
+func (x PaymentIntentCaptureMethod) String() string { return string(x) }
+
+// String returns the value of the PaymentIntentConfirmationMethod.
+func (x PaymentIntentConfirmationMethod) String() string { return string(x) }
+
+// String returns the value of the PaymentIntentNextActionType.
+func (x PaymentIntentNextActionType) String() string { return string(x) }
+
+// String returns the value of the PaymentIntentPaymentMethodOptionsACSSDebitPaymentSchedule.
+func (x PaymentIntentPaymentMethodOptionsACSSDebitPaymentSchedule) String() string { return string(x) }
+
+// String returns the value of the PaymentIntentPaymentMethodOptionsACSSDebitTransactionType.
+func (x PaymentIntentPaymentMethodOptionsACSSDebitTransactionType) String() string { return string(x) }
+
+// String returns the value of the PaymentIntentPaymentMethodOptionsCardRequest.
+func (x PaymentIntentPaymentMethodOptionsCardRequest) String() string { return string(x) }
+
+// String returns the value of the PaymentIntentPaymentMethodOptionsCardRequestThreeDSecure.
+func (x PaymentIntentPaymentMethodOptionsCardRequestThreeDSecure) String() string { return string(x) }
+
+// String returns the value of the PaymentIntentPaymentMethodOptionsVerificationMethod.
+func (x PaymentIntentPaymentMethodOptionsVerificationMethod) String() string { return string(x) }
+
+// String returns the value of the PaymentIntentStatus.
+func (x PaymentIntentStatus) String() string { return string(x) }
+
+// String returns the value of the PaymentLinkAfterCompletionType.
+func (x PaymentLinkAfterCompletionType) String() string { return string(x) }
+
+// String returns the value of the PaymentLinkBillingAddressCollection.
+func (x PaymentLinkBillingAddressCollection) String() string { return string(x) }
+
+// String returns the value of the PaymentLinkCustomerCreation.
+func (x PaymentLinkCustomerCreation) String() string { return string(x) }
+
+// String returns the value of the PaymentSourceType.
+func (x PaymentSourceType) String() string { return string(x) }
+
+// String returns the value of the PayoutDestinationType.
+func (x PayoutDestinationType) String() string { return string(x) }
+
+// String returns the value of the PayoutFailureCode.
+func (x PayoutFailureCode) String() string { return string(x) }
+
+// String returns the value of the PayoutMethodType.
+func (x PayoutMethodType) String() string { return string(x) }
+
+// String returns the value of the PayoutReconciliationStatus.
+func (x PayoutReconciliationStatus) String() string { return string(x) }
+
+// String returns the value of the PayoutSourceType.
+func (x PayoutSourceType) String() string { return string(x) }
+
+// String returns the value of the PayoutStatus.
+func (x PayoutStatus) String() string { return string(x) }
+
+// String returns the value of the PayoutType.
+func (x PayoutType) String() string { return string(x) }
+
+// String returns the value of the PlanBillingScheme.
+func (x PlanBillingScheme) String() string { return string(x) }
+
+// String returns the value of the PlanInterval.
+func (x PlanInterval) String() string { return string(x) }
+
+// String returns the value of the PlanTiersMode.
+func (x PlanTiersMode) String() string { return string(x) }
+
+// String returns the value of the PlanTransformUsageRound.
+func (x PlanTransformUsageRound) String() string { return string(x) }
+
+// String returns the value of the PriceBillingScheme.
+func (x PriceBillingScheme) String() string { return string(x) }
+
+// String returns the value of the PriceRecurringInterval.
+func (x PriceRecurringInterval) String() string { return string(x) }
+
+// String returns the value of the PriceTiersMode.
+func (x PriceTiersMode) String() string { return string(x) }
+
+// String returns the value of the PriceTransformQuantityRound.
+func (x PriceTransformQuantityRound) String() string { return string(x) }
+
+// String returns the value of the PriceType.
+func (x PriceType) String() string { return string(x) }
+
+// String returns the value of the QuoteCollectionMethod.
+func (x QuoteCollectionMethod) String() string { return string(x) }
+
+// String returns the value of the QuoteStatus.
+func (x QuoteStatus) String() string { return string(x) }
+
+// String returns the value of the RadarEarlyFraudWarningFraudType.
+func (x RadarEarlyFraudWarningFraudType) String() string { return string(x) }
+
+// String returns the value of the RadarValueListItemType.
+func (x RadarValueListItemType) String() string { return string(x) }
+
+// String returns the value of the ReasonType.
+func (x ReasonType) String() string { return string(x) }
+
+// String returns the value of the RecipientTransferDestinationType.
+func (x RecipientTransferDestinationType) String() string { return string(x) }
+
+// String returns the value of the RecipientTransferFailCode.
+func (x RecipientTransferFailCode) String() string { return string(x) }
+
+// String returns the value of the RecipientTransferMethodType.
+func (x RecipientTransferMethodType) String() string { return string(x) }
+
+// String returns the value of the RecipientTransferSourceType.
+func (x RecipientTransferSourceType) String() string { return string(x) }
+
+// String returns the value of the RecipientTransferStatus.
+func (x RecipientTransferStatus) String() string { return string(x) }
+
+// String returns the value of the RecipientTransferType.
+func (x RecipientTransferType) String() string { return string(x) }
+
+// String returns the value of the RecipientType.
+func (x RecipientType) String() string { return string(x) }
+
+// String returns the value of the RedirectFlowStatus.
+func (x RedirectFlowStatus) String() string { return string(x) }
+
+// String returns the value of the RefundAttributesMethod.
+func (x RefundAttributesMethod) String() string { return string(x) }
+
+// String returns the value of the RefundAttributesStatus.
+func (x RefundAttributesStatus) String() string { return string(x) }
+
+// String returns the value of the RefundFailureReason.
+func (x RefundFailureReason) String() string { return string(x) }
+
+// String returns the value of the RefundReason.
+func (x RefundReason) String() string { return string(x) }
+
+// String returns the value of the RefundStatus.
+func (x RefundStatus) String() string { return string(x) }
+
+// String returns the value of the ReportRunStatus.
+func (x ReportRunStatus) String() string { return string(x) }
+
+// String returns the value of the ShippingRateDeliveryEstimateUnit.
+func (x ShippingRateDeliveryEstimateUnit) String() string { return string(x) }
+
+// String returns the value of the ShippingRateType.
+func (x ShippingRateType) String() string { return string(x) }
+
+// String returns the value of the SourceFlow.
+func (x SourceFlow) String() string { return string(x) }
+
+// String returns the value of the SourceStatus.
+func (x SourceStatus) String() string { return string(x) }
+
+// String returns the value of the SourceUsage.
+func (x SourceUsage) String() string { return string(x) }
+
+// String returns the value of the SubBilling.
+func (x SubBilling) String() string { return string(x) }
+
+// String returns the value of the SubCancellationDetailsFeedback.
+func (x SubCancellationDetailsFeedback) String() string { return string(x) }
+
+// String returns the value of the SubCancellationDetailsReason.
+func (x SubCancellationDetailsReason) String() string { return string(x) }
+
+// String returns the value of the SubPauseCollectionBehavior.
+func (x SubPauseCollectionBehavior) String() string { return string(x) }
+
+// String returns the value of the SubProrationBehavior.
+func (x SubProrationBehavior) String() string { return string(x) }
+
+// String returns the value of the SubStatus.
+func (x SubStatus) String() string { return string(x) }
+
+// String returns the value of the SubscriptionScheduleEndBehavior.
+func (x SubscriptionScheduleEndBehavior) String() string { return string(x) }
+
+// String returns the value of the SubscriptionScheduleStatus.
+func (x SubscriptionScheduleStatus) String() string { return string(x) }
+
+// String returns the value of the SupportedBackend.
+func (x SupportedBackend) String() string { return string(x) }
+
+// String returns the value of the TaxBehavior.
+func (x TaxBehavior) String() string { return string(x) }
+
+// String returns the value of the TaxCalculationAddressSource.
+func (x TaxCalculationAddressSource) String() string { return string(x) }
+
+// String returns the value of the TaxCalculationTaxabilityOverride.
+func (x TaxCalculationTaxabilityOverride) String() string { return string(x) }
+
+// String returns the value of the TaxCalculationTaxabilityReason.
+func (x TaxCalculationTaxabilityReason) String() string { return string(x) }
+
+// String returns the value of the TaxIDType.
+func (x TaxIDType) String() string { return string(x) }
+
+// String returns the value of the TaxIDVerificationStatus.
+func (x TaxIDVerificationStatus) String() string { return string(x) }
+
+// String returns the value of the TaxRegistrationStatus.
+func (x TaxRegistrationStatus) String() string { return string(x) }
+
+// String returns the value of the TaxRegistrationType.
+func (x TaxRegistrationType) String() string { return string(x) }
+
+// String returns the value of the TaxSettingsStatus.
+func (x TaxSettingsStatus) String() string { return string(x) }
+
+// String returns the value of the TaxTransactionReversalMode.
+func (x TaxTransactionReversalMode) String() string { return string(x) }
+
+// String returns the value of the TaxTransactionType.
+func (x TaxTransactionType) String() string { return string(x) }
+
+// String returns the value of the TerminalReaderActionStatus.
+func (x TerminalReaderActionStatus) String() string { return string(x) }
+
+// String returns the value of the TerminalReaderActionType.
+func (x TerminalReaderActionType) String() string { return string(x) }
+
+// String returns the value of the TerminalReaderDeviceType.
+func (x TerminalReaderDeviceType) String() string { return string(x) }
+
+// String returns the value of the TerminalReaderStatus.
+func (x TerminalReaderStatus) String() string { return string(x) }
+
+// String returns the value of the TestHelpersTestClockStatus.
+func (x TestHelpersTestClockStatus) String() string { return string(x) }
+
+// String returns the value of the ThreeDSecureStatus.
+func (x ThreeDSecureStatus) String() string { return string(x) }
+
+// String returns the value of the TokenType.
+func (x TokenType) String() string { return string(x) }
+
+// String returns the value of the TokenizationMethod.
+func (x TokenizationMethod) String() string { return string(x) }
+
+// String returns the value of the TopupDestinationBalance.
+func (x TopupDestinationBalance) String() string { return string(x) }
+
+// String returns the value of the TopupStatus.
+func (x TopupStatus) String() string { return string(x) }
+
+// String returns the value of the TransactionReportingCategory.
+func (x TransactionReportingCategory) String() string { return string(x) }
+
+// String returns the value of the TransactionSourceType.
+func (x TransactionSourceType) String() string { return string(x) }
+
+// String returns the value of the TransactionStatus.
+func (x TransactionStatus) String() string { return string(x) }
+
+// String returns the value of the TransactionType.
+func (x TransactionType) String() string { return string(x) }
+
+// String returns the value of the TransferSourceType.
+func (x TransferSourceType) String() string { return string(x) }
+
+// String returns the value of the TxFeeType.
+func (x TxFeeType) String() string { return string(x) }
+
+// String returns the value of the UsageRecordAction.
+func (x UsageRecordAction) String() string { return string(x) }
+
+// String returns the value of the Verification.
+func (x Verification) String() string { return string(x) }
+
+// String returns the value of the VerificationFlowStatus.
+func (x VerificationFlowStatus) String() string { return string(x) }
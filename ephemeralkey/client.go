@@ -3,7 +3,6 @@ package ephemeralkey
 
 import (
 	"fmt"
-	"net/http"
 
 	stripe "github.com/stripe/stripe-go"
 	"github.com/stripe/stripe-go/form"
@@ -24,6 +23,9 @@ func New(params *stripe.EphemeralKeyParams) (*stripe.EphemeralKey, error) {
 // New POSTs new ephemeral keys.
 // For more details see https://stripe.com/docs/api#create_ephemeral_key.
 func (c Client) New(params *stripe.EphemeralKeyParams) (*stripe.EphemeralKey, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
 	if params.StripeVersion == "" {
 		return nil, fmt.Errorf("params.StripeVersion must be specified")
 	}
@@ -31,15 +33,16 @@ func (c Client) New(params *stripe.EphemeralKeyParams) (*stripe.EphemeralKey, er
 	body := &form.Values{}
 	form.AppendTo(body, params)
 
-	if len(params.StripeVersion) > 0 {
-		if params.Headers == nil {
-			params.Headers = make(http.Header)
-		}
-		params.Headers.Add("Stripe-Version", params.StripeVersion)
-	}
+	// Copy the params so that the caller's aren't changed
+	p := *params
+
+	// The key must be created with the API version of the mobile SDK that
+	// will use it rather than the version of these bindings, so it replaces
+	// the version that would otherwise be sent.
+	p.Params.StripeVersion = p.StripeVersion
 
 	ephemeralKey := &stripe.EphemeralKey{}
-	err := c.B.Call("POST", "ephemeral_keys", c.Key, body, &params.Params, ephemeralKey)
+	err := c.B.Call("POST", "/ephemeral_keys", c.Key, body, &p.Params, ephemeralKey)
 
 	return ephemeralKey, err
 }
@@ -69,5 +72,5 @@ func (c Client) Del(id string, params *stripe.EphemeralKeyParams) (*stripe.Ephem
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
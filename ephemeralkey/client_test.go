@@ -6,6 +6,7 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestEphemeralKeyDel(t *testing.T) {
@@ -22,3 +23,45 @@ func TestEphemeralKeyNew(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, key)
 }
+
+func TestEphemeralKeyNewRawJSON(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/ephemeral_keys", `{"id": "ephkey_123", "secret": "ek_test_123"}`)
+
+	key, err := Client{B: b}.New(&stripe.EphemeralKeyParams{
+		Customer:      "cus_123",
+		StripeVersion: "2017-05-25",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "ephkey_123", key.ID)
+	assert.Equal(t, `{"id": "ephkey_123", "secret": "ek_test_123"}`, string(key.RawJSON))
+
+	call := b.LastCall()
+	assert.Equal(t, "cus_123", call.Values().Get("customer"))
+	assert.Equal(t, "2017-05-25", call.Params.StripeVersion)
+	assert.Empty(t, call.Params.Headers.Get("Stripe-Version"))
+}
+
+func TestEphemeralKeyNewKeepsParams(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/ephemeral_keys", `{"id": "ephkey_123"}`)
+
+	params := &stripe.EphemeralKeyParams{
+		Customer:      "cus_123",
+		StripeVersion: "2017-05-25",
+	}
+	_, err := Client{B: b}.New(params)
+	assert.Nil(t, err)
+	assert.Equal(t, "2017-05-25", b.LastCall().Params.StripeVersion)
+
+	// The caller's params are left as they were
+	assert.Equal(t, "", params.Params.StripeVersion)
+}
+
+func TestEphemeralKeyNewWithoutVersion(t *testing.T) {
+	_, err := New(&stripe.EphemeralKeyParams{Customer: "cus_123"})
+	assert.NotNil(t, err)
+
+	_, err = New(nil)
+	assert.NotNil(t, err)
+}
@@ -1,6 +1,9 @@
 package stripe
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"time"
+)
 
 // ErrorType is the list of allowed values for the error's type.
 type ErrorType string
@@ -13,6 +16,7 @@ const (
 	ErrorTypeAPIConnection  ErrorType = "api_connection_error"
 	ErrorTypeAuthentication ErrorType = "authentication_error"
 	ErrorTypeCard           ErrorType = "card_error"
+	ErrorTypeIdempotency    ErrorType = "idempotency_error"
 	ErrorTypeInvalidRequest ErrorType = "invalid_request_error"
 	ErrorTypePermission     ErrorType = "more_permissions_required"
 	ErrorTypeRateLimit      ErrorType = "rate_limit_error"
@@ -41,10 +45,23 @@ const (
 
 // Error is the response returned when a call is unsuccessful.
 // For more details see  https://stripe.com/docs/api#errors.
+//
+// Errors are better told apart by their Type and Code than by their message,
+// which is meant for humans and can change. The error specific to the Type,
+// like a *CardError, is held by Err and can also be reached with errors.As:
+//
+//	var cardErr *stripe.CardError
+//	if errors.As(err, &cardErr) {
+//	    // Tell the customer why the card was declined using cardErr.DeclineCode
+//	}
 type Error struct {
 	ChargeID string    `json:"charge,omitempty"`
 	Code     ErrorCode `json:"code,omitempty"`
 
+	// DeclineCode is the reason given by the issuer of the card for declining
+	// it, like "insufficient_funds", for card errors.
+	DeclineCode string `json:"decline_code,omitempty"`
+
 	// Err contains an internal error with an additional level of granularity
 	// that can be used in some cases to get more detailed information about
 	// what went wrong. For example, Err may hold a ChargeError that indicates
@@ -64,6 +81,12 @@ func (e *Error) Error() string {
 	return string(ret)
 }
 
+// Unwrap returns the error specific to the type of the error, held by Err,
+// so that it can be reached with errors.As.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
 // APIConnectionError is a failure to connect to the Stripe API.
 type APIConnectionError struct {
 	stripeErr *Error
@@ -119,10 +142,26 @@ func (e *CardError) Error() string {
 	return e.stripeErr.Error()
 }
 
+// IdempotencyError occurs when an idempotency key is reused for a request
+// that doesn't match the one it was first used for.
+type IdempotencyError struct {
+	stripeErr *Error
+}
+
+// Error serializes the error object to JSON and returns it as a string.
+func (e *IdempotencyError) Error() string {
+	return e.stripeErr.Error()
+}
+
 // InvalidRequestError is an error that occurs when a request contains invalid
 // parameters.
 type InvalidRequestError struct {
 	stripeErr *Error
+
+	// Form holds the parameters that were sent, with the values of sensitive
+	// parameters redacted. It's only set when the TraceForm option of the
+	// backend is set.
+	Form []FormPair
 }
 
 // Error serializes the error object to JSON and returns it as a string.
@@ -134,6 +173,10 @@ func (e *InvalidRequestError) Error() string {
 // too quickly and indicates that the current request has been rate limited.
 type RateLimitError struct {
 	stripeErr *Error
+
+	// RetryAfter is how long the response asked to wait before retrying the
+	// request, if it did.
+	RetryAfter time.Duration
 }
 
 // Error serializes the error object to JSON and returns it as a string.
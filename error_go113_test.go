@@ -0,0 +1,44 @@
+//go:build go1.13
+// +build go1.13
+
+package stripe
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestErrorAs(t *testing.T) {
+	backend := &BackendConfiguration{}
+	res := &http.Response{Header: http.Header{"Request-Id": []string{"req_123"}}, StatusCode: 402}
+
+	err := backend.ResponseToError(res, []byte(`{"error": {
+		"code": "card_declined",
+		"decline_code": "insufficient_funds",
+		"message": "Your card has insufficient funds.",
+		"type": "card_error"
+	}}`))
+
+	var stripeErr *Error
+	assert.True(t, errors.As(err, &stripeErr))
+	assert.Equal(t, CardDeclined, stripeErr.Code)
+	assert.Equal(t, "req_123", stripeErr.RequestID)
+
+	var cardErr *CardError
+	assert.True(t, errors.As(err, &cardErr))
+	assert.Equal(t, "insufficient_funds", cardErr.DeclineCode)
+
+	var invalidErr *InvalidRequestError
+	assert.False(t, errors.As(err, &invalidErr))
+
+	err = backend.ResponseToError(&http.Response{StatusCode: 400}, []byte(`{"error": {
+		"message": "Keys for idempotent requests can only be used with the same parameters they were first used with.",
+		"type": "idempotency_error"
+	}}`))
+
+	var idempotencyErr *IdempotencyError
+	assert.True(t, errors.As(err, &idempotencyErr))
+}
@@ -8,8 +8,9 @@ import (
 // Event is the resource representing a Stripe event.
 // For more details see https://stripe.com/docs/api#events.
 type Event struct {
+	APIResource
 	Account  string        `json:"account"`
-	Created  int64         `json:"created"`
+	Created  Timestamp     `json:"created"`
 	Data     *EventData    `json:"data"`
 	ID       string        `json:"id"`
 	Live     bool          `json:"livemode"`
@@ -39,10 +40,18 @@ type EventData struct {
 
 // EventList is a list of events as retrieved from a list endpoint.
 type EventList struct {
+	APIResource
 	ListMeta
 	Values []*Event `json:"data"`
 }
 
+// EventParams is the set of parameters that can be used when retrieving
+// events.
+// For more details see https://stripe.com/docs/api#retrieve_event.
+type EventParams struct {
+	Params `form:"*"`
+}
+
 // EventListParams is the set of parameters that can be used when listing events.
 // For more details see https://stripe.com/docs/api#list_events.
 type EventListParams struct {
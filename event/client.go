@@ -14,13 +14,23 @@ type Client struct {
 
 // Get returns the details of an event
 // For more details see https://stripe.com/docs/api#retrieve_event.
-func Get(id string, params *stripe.Params) (*stripe.Event, error) {
+func Get(id string, params *stripe.EventParams) (*stripe.Event, error) {
 	return getC().Get(id, params)
 }
 
-func (c Client) Get(id string, params *stripe.Params) (*stripe.Event, error) {
+func (c Client) Get(id string, params *stripe.EventParams) (*stripe.Event, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
 	event := &stripe.Event{}
-	err := c.B.Call("GET", "/events/"+id, c.Key, nil, params, event)
+	err := c.B.Call("GET", "/events/"+id, c.Key, body, commonParams, event)
+
 	return event, err
 }
 
@@ -69,5 +79,5 @@ func (i *Iter) Event() *stripe.Event {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
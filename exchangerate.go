@@ -0,0 +1,33 @@
+package stripe
+
+// ExchangeRateParams is the set of parameters that can be used when retrieving
+// exchange rates.
+// For more details see https://stripe.com/docs/api/exchange_rates/retrieve.
+type ExchangeRateParams struct {
+	Params `form:"*"`
+}
+
+// ExchangeRateListParams is the set of parameters that can be used when listing
+// exchange rates.
+// For more details see https://stripe.com/docs/api/exchange_rates/list.
+type ExchangeRateListParams struct {
+	ListParams `form:"*"`
+}
+
+// ExchangeRate is the resource representing the currency exchange rates for a
+// given currency. Its ID is the currency whose rates it holds, and Rates maps
+// each other currency to the amount of it that one unit of the ID's currency
+// is worth.
+// For more details see https://stripe.com/docs/api/exchange_rates.
+type ExchangeRate struct {
+	APIResource
+	ID    string               `json:"id"`
+	Rates map[Currency]float64 `json:"rates"`
+}
+
+// ExchangeRateList is a list of exchange rates as retrieved from a list endpoint.
+type ExchangeRateList struct {
+	APIResource
+	ListMeta
+	Values []*ExchangeRate `json:"data"`
+}
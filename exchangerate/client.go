@@ -0,0 +1,83 @@
+// Package exchangerate provides the /exchange_rates APIs
+package exchangerate
+
+import (
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /exchange_rates APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the exchange rates for a given currency.
+// For more details see https://stripe.com/docs/api/exchange_rates/retrieve.
+func Get(currency stripe.Currency, params *stripe.ExchangeRateParams) (*stripe.ExchangeRate, error) {
+	return getC().Get(currency, params)
+}
+
+func (c Client) Get(currency stripe.Currency, params *stripe.ExchangeRateParams) (*stripe.ExchangeRate, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	exchangeRate := &stripe.ExchangeRate{}
+	err := c.B.Call("GET", "/exchange_rates/"+string(currency), c.Key, body, commonParams, exchangeRate)
+
+	return exchangeRate, err
+}
+
+// List returns the exchange rates for all supported currencies.
+// For more details see https://stripe.com/docs/api/exchange_rates/list.
+func List(params *stripe.ExchangeRateListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.ExchangeRateListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.ExchangeRateList{}
+		err := c.B.Call("GET", "/exchange_rates", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of ExchangeRates.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// ExchangeRate returns the most recent ExchangeRate
+// visited by a call to Next.
+func (i *Iter) ExchangeRate() *stripe.ExchangeRate {
+	return i.Current().(*stripe.ExchangeRate)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
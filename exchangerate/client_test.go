@@ -0,0 +1,42 @@
+package exchangerate
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestExchangeRateGet(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/exchange_rates/usd", `{
+		"id": "usd",
+		"object": "exchange_rate",
+		"rates": {"eur": 0.85, "gbp": 0.75}
+	}`)
+
+	rate, err := Client{B: b}.Get("usd", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "usd", rate.ID)
+	assert.Equal(t, 0.85, rate.Rates["eur"])
+	assert.Equal(t, 0.75, rate.Rates[stripe.Currency("gbp")])
+}
+
+func TestExchangeRateList(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/exchange_rates", `{
+		"data": [
+			{"id": "eur", "rates": {"usd": 1.18}},
+			{"id": "usd", "rates": {"eur": 0.85}}
+		]
+	}`)
+
+	i := Client{B: b}.List(&stripe.ExchangeRateListParams{})
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, "eur", i.ExchangeRate().ID)
+	assert.True(t, i.Next())
+	assert.Equal(t, 0.85, i.ExchangeRate().Rates["eur"])
+}
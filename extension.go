@@ -0,0 +1,98 @@
+package stripe
+
+import (
+	"reflect"
+
+	"github.com/stripe/stripe-go/form"
+)
+
+// This file holds the API used to add resource packages for endpoints that
+// this library doesn't cover, like private or beta ones. A package for a
+// custom resource defines its params struct by embedding Params (or
+// ListParams for list endpoints), its response struct by embedding
+// APIResource, and calls DoRequest:
+//
+//	type WidgetParams struct {
+//	    stripe.Params `form:"*"`
+//	    Color         string `form:"color"`
+//	}
+//
+//	type Widget struct {
+//	    stripe.APIResource
+//	    ID    string `json:"id"`
+//	    Color string `json:"color"`
+//	}
+//
+//	widget := &Widget{}
+//	err := stripe.DoRequest(nil, "POST", "/widgets", "", params, widget)
+//
+// List endpoints are iterated by passing a Query to GetIter that calls
+// Backend.Call with the form values it's given, which carry the pagination
+// cursor.
+
+// ParamsContainer is implemented by every params struct through the Params or
+// ListParams it embeds. It gives access to the parameters that are sent as
+// headers rather than in the body of the request.
+type ParamsContainer interface {
+	GetParams() *Params
+}
+
+// GetParams returns p, so that every params struct embedding Params is a
+// ParamsContainer.
+func (p *Params) GetParams() *Params {
+	return p
+}
+
+// GetParams returns the common params of a list request, so that every params
+// struct embedding ListParams is a ParamsContainer.
+func (p *ListParams) GetParams() *Params {
+	return p.ToParams()
+}
+
+// EncodeParams encodes params as the form values sent to the API, following
+// the form tags of its fields. It returns nil when params is nil.
+func EncodeParams(params interface{}) *form.Values {
+	if isNil(params) {
+		return nil
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	return body
+}
+
+// UnmarshalResponse decodes the body of a response into v the way the
+// backends do: it never panics, and the objects of a list page that can't be
+// decoded are reported in a DecodeErrors while the others are still set on v.
+func UnmarshalResponse(data []byte, v interface{}) error {
+	return unmarshalResponse(data, v)
+}
+
+// DoRequest encodes params, calls path on b with them and decodes the
+// response into v. When b is nil, the API backend returned by GetBackend is
+// used, and when key is empty, the global key returned by GetKey is, like the
+// resource packages do. params may be nil.
+func DoRequest(b Backend, method, path, key string, params ParamsContainer, v interface{}) error {
+	if b == nil {
+		b = GetBackend(APIBackend)
+	}
+	if key == "" {
+		key = GetKey()
+	}
+
+	var commonParams *Params
+	if !isNil(params) {
+		commonParams = params.GetParams()
+	}
+
+	return b.Call(method, path, key, EncodeParams(params), commonParams, v)
+}
+
+// isNil reports whether v is nil or holds a nil pointer.
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
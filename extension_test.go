@@ -0,0 +1,72 @@
+package stripe_test
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+type widgetParams struct {
+	stripe.Params `form:"*"`
+	Color         string `form:"color"`
+}
+
+type widget struct {
+	stripe.APIResource
+	ID    string `json:"id"`
+	Color string `json:"color"`
+}
+
+func TestDoRequest(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/widgets", `{"id":"wd_123","color":"blue"}`)
+
+	params := &widgetParams{Color: "blue"}
+	params.IdempotencyKey = "key"
+
+	w := &widget{}
+	err := stripe.DoRequest(b, "POST", "/widgets", "sk_test", params, w)
+	assert.NoError(t, err)
+	assert.Equal(t, "wd_123", w.ID)
+	assert.Equal(t, "blue", w.Color)
+	assert.NotNil(t, w.LastResponse)
+
+	call := b.LastCall()
+	assert.Equal(t, "sk_test", call.Key)
+	assert.Equal(t, "blue", call.Values().Get("color"))
+	assert.Equal(t, "key", call.Params.IdempotencyKey)
+}
+
+func TestDoRequestNilParams(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/widgets/wd_123", `{"id":"wd_123"}`)
+
+	var params *widgetParams
+	w := &widget{}
+	err := stripe.DoRequest(b, "GET", "/widgets/wd_123", "", params, w)
+	assert.NoError(t, err)
+	assert.Equal(t, "wd_123", w.ID)
+	assert.Nil(t, b.LastCall().Params)
+
+	// Without a key, the global one is used
+	assert.Equal(t, stripe.GetKey(), b.LastCall().Key)
+}
+
+func TestEncodeParams(t *testing.T) {
+	assert.Nil(t, stripe.EncodeParams(nil))
+
+	body := stripe.EncodeParams(&widgetParams{Color: "blue"})
+	assert.Equal(t, "color=blue", body.Encode())
+}
+
+func TestUnmarshalResponse(t *testing.T) {
+	list := &stripe.ChargeList{}
+	err := stripe.UnmarshalResponse([]byte(`{"data":[{"id":"ch_1"},{"id":"ch_2","amount":"x"}]}`), list)
+	errs, ok := err.(stripe.DecodeErrors)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, 1, len(list.Values))
+	assert.Equal(t, "ch_1", list.Values[0].ID)
+}
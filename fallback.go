@@ -0,0 +1,193 @@
+package stripe
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	// DefaultFallbackMaxAge is the default age past which the responses kept
+	// by a FallbackBackend aren't served anymore.
+	DefaultFallbackMaxAge = 24 * time.Hour
+
+	// DefaultFallbackThreshold is the default number of consecutive server
+	// errors after which a FallbackBackend starts serving kept responses.
+	DefaultFallbackThreshold = 3
+)
+
+// StaleResponseError is the error returned by a FallbackBackend when it
+// answers a call with a response kept from an earlier call because Stripe is
+// failing. The response has been decoded despite the error, so callers that
+// can live with stale data, like dashboards, can use it and report its age.
+type StaleResponseError struct {
+	// CachedAt is when the response was received from Stripe.
+	CachedAt time.Time
+
+	// Err is the error returned for the call by the wrapped backend.
+	Err error
+}
+
+// Error returns the age of the response and the error of the call.
+func (e *StaleResponseError) Error() string {
+	return fmt.Sprintf("Serving a stale response received at %v: %v", e.CachedAt.Format(time.RFC3339), e.Err)
+}
+
+// FallbackBackend is a Backend that keeps the last response to every
+// retrieve call in a CacheStore and serves it when Stripe is failing, so that
+// read-only tools degrade instead of erroring during incidents. Pages of
+// lists aren't kept, since they'd quickly fill the store. The store should be
+// bounded, like MemoryCacheStore.
+//
+// Once Threshold consecutive calls have failed with a server error (a 5xx
+// status) or a network error, like a timeout, retrieve calls that fail are
+// answered with their kept response if it's younger than MaxAge. The response
+// is decoded into v and a *StaleResponseError is returned, so that stale data
+// is never mistaken for fresh data. LastResponse isn't set on stale data,
+// since no response was received for the call:
+//
+//	ch, err := charge.Get("ch_123", nil)
+//	if staleErr, ok := err.(*stripe.StaleResponseError); ok {
+//	    // Show ch as last known at staleErr.CachedAt
+//	}
+//
+// Writes are never answered from the store.
+type FallbackBackend struct {
+	Backend   Backend
+	MaxAge    time.Duration
+	Store     CacheStore
+	Threshold int
+
+	failures int
+	mu       sync.Mutex
+}
+
+// NewFallbackBackend returns a FallbackBackend wrapping b which keeps
+// responses in store, using DefaultFallbackMaxAge and
+// DefaultFallbackThreshold. A typical use is:
+//
+//	stripe.SetBackend(stripe.APIBackend, stripe.NewFallbackBackend(
+//	    stripe.GetBackend(stripe.APIBackend), stripe.NewMemoryCacheStore()))
+func NewFallbackBackend(b Backend, store CacheStore) *FallbackBackend {
+	return &FallbackBackend{
+		Backend:   b,
+		MaxAge:    DefaultFallbackMaxAge,
+		Store:     store,
+		Threshold: DefaultFallbackThreshold,
+	}
+}
+
+// fallbackEntry is a response kept by a FallbackBackend.
+type fallbackEntry struct {
+	CachedAt int64           `json:"cached_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// Call is the Backend.Call implementation for the fallback backend.
+func (f *FallbackBackend) Call(method, path, key string, body *form.Values, params *Params, v interface{}) error {
+	captured := &capturedResponse{}
+	err := f.Backend.Call(method, path, key, body, params, captured)
+	outage := f.record(err, captured.errStatusCode())
+
+	if strings.ToUpper(method) != "GET" || v == nil {
+		if err != nil || v == nil {
+			return err
+		}
+		return captured.decodeInto(v)
+	}
+
+	cacheKey := cacheKey(path, key, body, params)
+
+	if err == nil {
+		if err := captured.decodeInto(v); err != nil {
+			return err
+		}
+
+		if captured.isList() {
+			return nil
+		}
+
+		entry, err := json.Marshal(fallbackEntry{CachedAt: time.Now().Unix(), Data: captured.data})
+		if err == nil {
+			f.Store.Set(cacheKey, entry, f.MaxAge)
+		}
+		return nil
+	}
+
+	if !outage {
+		return err
+	}
+
+	stored, ok := f.Store.Get(cacheKey)
+	if !ok {
+		return err
+	}
+
+	var entry fallbackEntry
+	if json.Unmarshal(stored, &entry) != nil || unmarshalResponse(entry.Data, v) != nil {
+		return err
+	}
+
+	return &StaleResponseError{CachedAt: time.Unix(entry.CachedAt, 0), Err: err}
+}
+
+// CallMultipart is the Backend.CallMultipart implementation for the fallback
+// backend. Multipart calls are never answered from the store.
+func (f *FallbackBackend) CallMultipart(method, path, key, boundary string, body io.Reader, params *Params, v interface{}) error {
+	err := f.Backend.CallMultipart(method, path, key, boundary, body, params, v)
+	f.record(err, 0)
+	return err
+}
+
+// CallStreaming is the StreamingBackend.CallStreaming implementation for the
+// fallback backend. Streamed responses are never answered from the store.
+func (f *FallbackBackend) CallStreaming(method, path, key string, body *form.Values, params *Params) (io.ReadCloser, error) {
+	b, ok := f.Backend.(StreamingBackend)
+	if !ok {
+		return nil, errors.New("Wrapped backend doesn't support streaming responses.")
+	}
+
+	return b.CallStreaming(method, path, key, body, params)
+}
+
+// record counts the consecutive server and network errors returned by the
+// wrapped backend and reports whether err is one of enough of them to
+// consider that Stripe is failing. status is the status code of the response
+// to the failed call, if known, which catches server errors that the wrapped
+// backend couldn't parse, like an HTML page from a proxy. Successes and other
+// API errors mean that Stripe is up.
+func (f *FallbackBackend) record(err error, status int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err == nil {
+		f.failures = 0
+		return false
+	}
+
+	failing, answered := status >= 500, status > 0
+	switch e := err.(type) {
+	case *Error:
+		failing, answered = e.HTTPStatusCode >= 500, true
+	case net.Error:
+		// Connection failures and timeouts, the usual shape of an outage
+		failing = true
+	}
+
+	if !failing {
+		if answered {
+			f.failures = 0
+		}
+		return false
+	}
+
+	f.failures++
+	return f.failures >= f.Threshold
+}
@@ -0,0 +1,178 @@
+package stripe_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// failingBackend is a backend that answers every call with a charge, or with
+// data if it's set, or with err if it's set.
+type failingBackend struct {
+	calls int
+	data  string
+	err   error
+}
+
+func (b *failingBackend) Call(method, path, key string, body *form.Values, params *stripe.Params, v interface{}) error {
+	b.calls++
+	if b.err != nil {
+		return b.err
+	}
+
+	data := b.data
+	if data == "" {
+		data = `{"id":"ch_123","amount":100}`
+	}
+	if err := json.Unmarshal([]byte(data), v); err != nil {
+		return err
+	}
+
+	if setter, ok := v.(stripe.LastResponseSetter); ok {
+		setter.SetLastResponse(&stripe.APIResponse{RequestID: "req_123", StatusCode: 200})
+	}
+	return nil
+}
+
+func (b *failingBackend) CallMultipart(method, path, key, boundary string, body io.Reader, params *stripe.Params, v interface{}) error {
+	return b.err
+}
+
+func TestFallbackBackend(t *testing.T) {
+	b := &failingBackend{}
+	f := stripe.NewFallbackBackend(b, stripe.NewMemoryCacheStore())
+	f.Threshold = 2
+
+	charge := &stripe.Charge{}
+	err := f.Call("GET", "/charges/ch_123", "sk_test_123", nil, nil, charge)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), charge.Amount)
+	assert.NotNil(t, charge.LastResponse)
+	assert.Equal(t, "req_123", charge.LastResponse.RequestID)
+
+	serverErr := &stripe.Error{HTTPStatusCode: 503, Type: stripe.ErrorTypeAPI}
+	b.err = serverErr
+
+	// A single server error isn't an outage yet
+	err = f.Call("GET", "/charges/ch_123", "sk_test_123", nil, nil, &stripe.Charge{})
+	assert.Equal(t, serverErr, err)
+
+	charge = &stripe.Charge{}
+	err = f.Call("GET", "/charges/ch_123", "sk_test_123", nil, nil, charge)
+	staleErr, ok := err.(*stripe.StaleResponseError)
+	assert.True(t, ok)
+	assert.Equal(t, serverErr, staleErr.Err)
+	assert.False(t, staleErr.CachedAt.IsZero())
+	assert.Equal(t, "ch_123", charge.ID)
+	assert.Equal(t, uint64(100), charge.Amount)
+
+	// No response was received for stale data
+	assert.Nil(t, charge.LastResponse)
+
+	// Objects that were never retrieved can't be served
+	err = f.Call("GET", "/charges/ch_456", "sk_test_123", nil, nil, &stripe.Charge{})
+	assert.Equal(t, serverErr, err)
+
+	// Writes are never served from the store
+	err = f.Call("POST", "/charges/ch_123", "sk_test_123", nil, nil, &stripe.Charge{})
+	assert.Equal(t, serverErr, err)
+}
+
+func TestFallbackBackendRecovery(t *testing.T) {
+	b := &failingBackend{}
+	f := stripe.NewFallbackBackend(b, stripe.NewMemoryCacheStore())
+	f.Threshold = 2
+
+	err := f.Call("GET", "/charges/ch_123", "sk_test_123", nil, nil, &stripe.Charge{})
+	assert.NoError(t, err)
+
+	serverErr := &stripe.Error{HTTPStatusCode: 500, Type: stripe.ErrorTypeAPI}
+	b.err = serverErr
+	err = f.Call("GET", "/charges/ch_123", "sk_test_123", nil, nil, &stripe.Charge{})
+	assert.Equal(t, serverErr, err)
+
+	// Any other API error means that Stripe is up and resets the count
+	b.err = &stripe.Error{HTTPStatusCode: 404, Type: stripe.ErrorTypeInvalidRequest}
+	err = f.Call("GET", "/charges/ch_456", "sk_test_123", nil, nil, &stripe.Charge{})
+	assert.Equal(t, b.err, err)
+
+	b.err = serverErr
+	err = f.Call("GET", "/charges/ch_123", "sk_test_123", nil, nil, &stripe.Charge{})
+	assert.Equal(t, serverErr, err)
+}
+
+func TestFallbackBackendNetworkError(t *testing.T) {
+	b := &failingBackend{}
+	f := stripe.NewFallbackBackend(b, stripe.NewMemoryCacheStore())
+	f.Threshold = 2
+
+	err := f.Call("GET", "/charges/ch_123", "sk_test_123", nil, nil, &stripe.Charge{})
+	assert.NoError(t, err)
+
+	netErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	b.err = netErr
+	err = f.Call("GET", "/charges/ch_123", "sk_test_123", nil, nil, &stripe.Charge{})
+	assert.Equal(t, netErr, err)
+
+	charge := &stripe.Charge{}
+	err = f.Call("GET", "/charges/ch_123", "sk_test_123", nil, nil, charge)
+	staleErr, ok := err.(*stripe.StaleResponseError)
+	assert.True(t, ok)
+	assert.Equal(t, netErr, staleErr.Err)
+	assert.Equal(t, "ch_123", charge.ID)
+}
+
+func TestFallbackBackendSkipsLists(t *testing.T) {
+	b := &failingBackend{data: `{"object":"list","data":[{"id":"ch_123","amount":100}]}`}
+	f := stripe.NewFallbackBackend(b, stripe.NewMemoryCacheStore())
+	f.Threshold = 1
+
+	list := &stripe.ChargeList{}
+	err := f.Call("GET", "/charges", "sk_test_123", nil, nil, list)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(list.Values))
+	assert.NotNil(t, list.LastResponse)
+
+	serverErr := &stripe.Error{HTTPStatusCode: 503, Type: stripe.ErrorTypeAPI}
+	b.err = serverErr
+
+	// Pages of lists are never kept, so they can't be served
+	err = f.Call("GET", "/charges", "sk_test_123", nil, nil, &stripe.ChargeList{})
+	assert.Equal(t, serverErr, err)
+}
+
+func TestFallbackBackendUnparsableServerError(t *testing.T) {
+	failing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing {
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("<html><body>502 Bad Gateway</body></html>"))
+			return
+		}
+		w.Write([]byte(`{"id":"ch_123","amount":100}`))
+	}))
+	defer server.Close()
+
+	b := &stripe.BackendConfiguration{URL: server.URL + "/v1", HTTPClient: http.DefaultClient}
+	f := stripe.NewFallbackBackend(b, stripe.NewMemoryCacheStore())
+	f.Threshold = 1
+
+	err := f.Call("GET", "/charges/ch_123", "sk_test_123", nil, nil, &stripe.Charge{})
+	assert.NoError(t, err)
+
+	// The error page of a proxy isn't an API error, but it's still an outage
+	failing = true
+	charge := &stripe.Charge{}
+	err = f.Call("GET", "/charges/ch_123", "sk_test_123", nil, nil, charge)
+	_, ok := err.(*stripe.StaleResponseError)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(100), charge.Amount)
+}
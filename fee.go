@@ -21,12 +21,13 @@ type FeeListParams struct {
 // Fee is the resource representing a Stripe application fee.
 // For more details see https://stripe.com/docs/api#application_fees.
 type Fee struct {
+	APIResource
 	Account                *Account       `json:"account"`
 	Amount                 uint64         `json:"amount"`
 	AmountRefunded         uint64         `json:"amount_refunded"`
 	App                    string         `json:"application"`
 	Charge                 *Charge        `json:"charge"`
-	Created                int64          `json:"created"`
+	Created                Timestamp      `json:"created"`
 	Currency               Currency       `json:"currency"`
 	ID                     string         `json:"id"`
 	Live                   bool           `json:"livemode"`
@@ -38,6 +39,7 @@ type Fee struct {
 
 // FeeList is a list of fees as retrieved from a list endpoint.
 type FeeList struct {
+	APIResource
 	ListMeta
 	Values []*Fee `json:"data"`
 }
@@ -46,15 +48,18 @@ type FeeList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (f *Fee) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		f.ID = id
+		return nil
+	}
+
 	type appfee Fee
 	var ff appfee
 	err := json.Unmarshal(data, &ff)
-	if err == nil {
-		*f = Fee(ff)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		f.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*f = Fee(ff)
 	return nil
 }
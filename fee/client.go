@@ -31,7 +31,7 @@ func (c Client) Get(id string, params *stripe.FeeParams) (*stripe.Fee, error) {
 	}
 
 	fee := &stripe.Fee{}
-	err := c.B.Call("GET", fmt.Sprintf("application_fees/%v", id), c.Key, body, commonParams, fee)
+	err := c.B.Call("GET", fmt.Sprintf("/application_fees/%v", id), c.Key, body, commonParams, fee)
 
 	return fee, err
 }
@@ -81,5 +81,5 @@ func (i *Iter) Fee() *stripe.Fee {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
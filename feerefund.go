@@ -22,10 +22,11 @@ type FeeRefundListParams struct {
 // FeeRefund is the resource representing a Stripe fee refund.
 // For more details see https://stripe.com/docs/api#fee_refunds.
 type FeeRefund struct {
+	APIResource
 	Amount   uint64            `json:"amount"`
-	Created  int64             `json:"created"`
+	Created  Timestamp         `json:"created"`
 	Currency Currency          `json:"currency"`
-	Fee      string            `json:"fee"`
+	Fee      *Fee              `json:"fee"`
 	ID       string            `json:"id"`
 	Meta     map[string]string `json:"metadata"`
 	Tx       *Transaction      `json:"balance_transaction"`
@@ -33,6 +34,7 @@ type FeeRefund struct {
 
 // FeeRefundList is a list object for fee refunds.
 type FeeRefundList struct {
+	APIResource
 	ListMeta
 	Values []*FeeRefund `json:"data"`
 }
@@ -41,15 +43,18 @@ type FeeRefundList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (f *FeeRefund) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		f.ID = id
+		return nil
+	}
+
 	type feerefund FeeRefund
 	var ff feerefund
 	err := json.Unmarshal(data, &ff)
-	if err == nil {
-		*f = FeeRefund(ff)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		f.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*f = FeeRefund(ff)
 	return nil
 }
@@ -32,7 +32,7 @@ func (c Client) New(params *stripe.FeeRefundParams) (*stripe.FeeRefund, error) {
 	form.AppendTo(body, params)
 
 	refund := &stripe.FeeRefund{}
-	err := c.B.Call("POST", fmt.Sprintf("application_fees/%v/refunds", params.Fee), c.Key, body, &params.Params, refund)
+	err := c.B.Call("POST", fmt.Sprintf("/application_fees/%v/refunds", params.Fee), c.Key, body, &params.Params, refund)
 
 	return refund, err
 }
@@ -60,8 +60,8 @@ func (c Client) Get(id string, params *stripe.FeeRefundParams) (*stripe.FeeRefun
 	return refund, err
 }
 
-// Update updates a refund's properties.
-// For more details see https://stripe.com/docs/api#update_refund.
+// Update updates a fee refund's properties.
+// For more details see https://stripe.com/docs/api#update_fee_refund.
 func Update(id string, params *stripe.FeeRefundParams) (*stripe.FeeRefund, error) {
 	return getC().Update(id, params)
 }
@@ -125,5 +125,5 @@ func (i *Iter) FeeRefund() *stripe.FeeRefund {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
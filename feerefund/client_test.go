@@ -6,6 +6,7 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestFeeRefundGet(t *testing.T) {
@@ -47,3 +48,21 @@ func TestFeeRefundUpdate(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, refund)
 }
+
+func TestFeeRefundNewPartial(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/application_fees/fee_123/refunds", `{
+		"id": "fr_123",
+		"amount": 50,
+		"fee": {"id": "fee_123", "amount_refunded": 50}
+	}`)
+
+	refund, err := Client{B: b}.New(&stripe.FeeRefundParams{
+		Amount: 50,
+		Fee:    "fee_123",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "fee_123", refund.Fee.ID)
+	assert.Equal(t, uint64(50), refund.Fee.AmountRefunded)
+	assert.Equal(t, "50", b.LastCall().Values().Get("amount"))
+}
@@ -0,0 +1,165 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// FilePurpose is the purpose of a particular file. Allowed values include
+// "dispute_evidence" and "identity_document".
+type FilePurpose string
+
+// FileFileLinkDataParams is the set of parameters allowed for the
+// file_link_data hash, which creates a file link for the file as it's
+// uploaded.
+type FileFileLinkDataParams struct {
+	Create    bool
+	ExpiresAt int64
+	Meta      map[string]string
+}
+
+// FileParams is the set of parameters that can be used when creating a file.
+// For more details see https://stripe.com/docs/api#create_file.
+type FileParams struct {
+	Params `form:"*"`
+
+	// FileLinkData, if set, creates a file link for the file along with it.
+	FileLinkData *FileFileLinkDataParams
+
+	// FileReader is a reader with the contents of the file that should be uploaded.
+	FileReader io.Reader
+
+	// Filename is just the name of the file without path information.
+	Filename string
+
+	Purpose FilePurpose
+}
+
+// FileListParams is the set of parameters that can be used when listing
+// files. For more details see https://stripe.com/docs/api#list_files.
+type FileListParams struct {
+	ListParams   `form:"*"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Purpose      FilePurpose       `form:"purpose"`
+}
+
+// File is the resource representing a Stripe file, like a piece of dispute
+// evidence or an identity document.
+// For more details see https://stripe.com/docs/api#files.
+type File struct {
+	APIResource
+	Created  Timestamp     `json:"created"`
+	Filename string        `json:"filename"`
+	ID       string        `json:"id"`
+	Links    *FileLinkList `json:"links"`
+	Mime     string        `json:"mime_type"`
+	Purpose  FilePurpose   `json:"purpose"`
+	Size     int           `json:"size"`
+	Title    string        `json:"title"`
+	Type     string        `json:"type"`
+	URL      string        `json:"url"`
+}
+
+// FileList is a list of files as retrieved from a list endpoint.
+type FileList struct {
+	APIResource
+	ListMeta
+	Values []*File `json:"data"`
+}
+
+// AppendDetails adds the file details to an io.ReadWriter. It returns the
+// boundary string for a multipart/form-data request and an error (if one
+// exists).
+func (f *FileParams) AppendDetails(body io.ReadWriter) (string, error) {
+	writer := multipart.NewWriter(body)
+	var err error
+
+	if len(f.Purpose) > 0 {
+		err = writer.WriteField("purpose", string(f.Purpose))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if f.FileLinkData != nil {
+		err = f.FileLinkData.appendDetails(writer)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if f.FileReader != nil && f.Filename != "" {
+		part, err := writer.CreateFormFile("file", filepath.Base(f.Filename))
+		if err != nil {
+			return "", err
+		}
+
+		_, err = io.Copy(part, f.FileReader)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return "", err
+	}
+
+	return writer.Boundary(), nil
+}
+
+// appendDetails writes the file_link_data fields to a multipart request.
+// Metadata keys are written in order so that requests are reproducible.
+func (p *FileFileLinkDataParams) appendDetails(writer *multipart.Writer) error {
+	fields := [][2]string{
+		{"file_link_data[create]", strconv.FormatBool(p.Create)},
+	}
+
+	if p.ExpiresAt != 0 {
+		fields = append(fields, [2]string{"file_link_data[expires_at]", strconv.FormatInt(p.ExpiresAt, 10)})
+	}
+
+	keys := make([]string, 0, len(p.Meta))
+	for k := range p.Meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fields = append(fields, [2]string{fmt.Sprintf("file_link_data[metadata][%v]", k), p.Meta[k]})
+	}
+
+	for _, field := range fields {
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON handles deserialization of a File.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (f *File) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		f.ID = id
+		return nil
+	}
+
+	type file File
+	var ff file
+	err := json.Unmarshal(data, &ff)
+	if err != nil {
+		return err
+	}
+
+	*f = File(ff)
+	return nil
+}
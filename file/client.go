@@ -0,0 +1,129 @@
+// Package file provides the file related APIs
+package file
+
+import (
+	"bytes"
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	PurposeAdditionalVerification stripe.FilePurpose = "additional_verification"
+	PurposeBusinessLogo           stripe.FilePurpose = "business_logo"
+	PurposeCustomerSignature      stripe.FilePurpose = "customer_signature"
+	PurposeDisputeEvidence        stripe.FilePurpose = "dispute_evidence"
+	PurposeIdentityDocument       stripe.FilePurpose = "identity_document"
+	PurposePCIDocument            stripe.FilePurpose = "pci_document"
+	PurposeTaxDocumentUserUpload  stripe.FilePurpose = "tax_document_user_upload"
+)
+
+// Client is used to invoke file APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+
+	// FilesB is the backend used to upload files, which are sent to
+	// files.stripe.com rather than to the API. The global files backend is
+	// used if it's nil.
+	FilesB stripe.Backend
+}
+
+// New uploads a new file. The content of the file is read from
+// params.FileReader.
+// For more details see https://stripe.com/docs/api#create_file.
+func New(params *stripe.FileParams) (*stripe.File, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.FileParams) (*stripe.File, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil, and params.Purpose and params.FileReader must be set")
+	}
+
+	body := &bytes.Buffer{}
+	boundary, err := params.AppendDetails(body)
+	if err != nil {
+		return nil, err
+	}
+
+	b := c.FilesB
+	if b == nil {
+		b = stripe.GetBackend(stripe.FilesBackend)
+	}
+
+	file := &stripe.File{}
+	err = b.CallMultipart("POST", "/files", c.Key, boundary, body, &params.Params, file)
+
+	return file, err
+}
+
+// Get returns the details of a file.
+// For more details see https://stripe.com/docs/api#retrieve_file.
+func Get(id string, params *stripe.FileParams) (*stripe.File, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.FileParams) (*stripe.File, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	file := &stripe.File{}
+	err := c.B.Call("GET", "/files/"+id, c.Key, body, commonParams, file)
+
+	return file, err
+}
+
+// List returns a list of files.
+// For more details see https://stripe.com/docs/api#list_files.
+func List(params *stripe.FileListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.FileListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.FileList{}
+		err := c.B.Call("GET", "/files", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of Files.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// File returns the most recent File visited by a call to Next.
+func (i *Iter) File() *stripe.File {
+	return i.Current().(*stripe.File)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey(), stripe.GetBackend(stripe.FilesBackend)}
+}
@@ -0,0 +1,90 @@
+package file
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+// uploadBackend is a files backend that records the multipart form of the
+// upload it receives.
+type uploadBackend struct {
+	form *multipart.Form
+	path string
+}
+
+func (b *uploadBackend) Call(method, path, key string, body *form.Values, params *stripe.Params, v interface{}) error {
+	return nil
+}
+
+func (b *uploadBackend) CallMultipart(method, path, key, boundary string, body io.Reader, params *stripe.Params, v interface{}) error {
+	b.path = path
+
+	f, err := multipart.NewReader(body, boundary).ReadForm(1 << 20)
+	if err != nil {
+		return err
+	}
+	b.form = f
+
+	return json.Unmarshal([]byte(`{"id":"file_123","purpose":"dispute_evidence"}`), v)
+}
+
+func TestFileGet(t *testing.T) {
+	file, err := Get("file_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, file)
+}
+
+func TestFileList(t *testing.T) {
+	i := List(&stripe.FileListParams{Purpose: PurposeDisputeEvidence})
+
+	// Verify that we can get at least one file
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.File())
+}
+
+func TestFileNew(t *testing.T) {
+	b := &uploadBackend{}
+	c := Client{FilesB: b}
+
+	file, err := c.New(&stripe.FileParams{
+		FileLinkData: &stripe.FileFileLinkDataParams{
+			Create: true,
+			Meta:   map[string]string{"order": "123"},
+		},
+		FileReader: bytes.NewBufferString("%PDF-1.4"),
+		Filename:   "/tmp/evidence.pdf",
+		Purpose:    PurposeDisputeEvidence,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "file_123", file.ID)
+	assert.Equal(t, PurposeDisputeEvidence, file.Purpose)
+
+	assert.Equal(t, "/files", b.path)
+	assert.Equal(t, []string{"dispute_evidence"}, b.form.Value["purpose"])
+	assert.Equal(t, []string{"true"}, b.form.Value["file_link_data[create]"])
+	assert.Equal(t, []string{"123"}, b.form.Value["file_link_data[metadata][order]"])
+
+	assert.Equal(t, 1, len(b.form.File["file"]))
+	assert.Equal(t, "evidence.pdf", b.form.File["file"][0].Filename)
+
+	part, err := b.form.File["file"][0].Open()
+	assert.Nil(t, err)
+	data, err := ioutil.ReadAll(part)
+	assert.Nil(t, err)
+	assert.Equal(t, "%PDF-1.4", string(data))
+}
+
+func TestFileNewNilParams(t *testing.T) {
+	_, err := New(nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,78 @@
+package stripe
+
+import (
+	"encoding/json"
+
+	"github.com/stripe/stripe-go/form"
+)
+
+// FileLinkParams is the set of parameters that can be used when creating or
+// updating a file link.
+// For more details see https://stripe.com/docs/api#create_file_link and https://stripe.com/docs/api#update_file_link.
+type FileLinkParams struct {
+	Params       `form:"*"`
+	ExpiresAt    int64  `form:"expires_at"`
+	ExpiresAtNow bool   `form:"-"` // See custom AppendTo
+	File         string `form:"file"`
+}
+
+// AppendTo implements custom encoding logic for FileLinkParams so that a
+// link can be expired immediately.
+func (p *FileLinkParams) AppendTo(body *form.Values, keyParts []string) {
+	if p.ExpiresAtNow {
+		body.Add(form.FormatKey(append(keyParts, "expires_at")), "now")
+	}
+}
+
+// FileLinkListParams is the set of parameters that can be used when listing
+// file links. For more details see https://stripe.com/docs/api#list_file_links.
+type FileLinkListParams struct {
+	ListParams   `form:"*"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Expired      *bool             `form:"expired"`
+	File         string            `form:"file"`
+}
+
+// FileLink is the resource representing a Stripe file link, a URL that can be
+// used to share the content of a file with someone who doesn't have access to
+// the account.
+// For more details see https://stripe.com/docs/api#file_links.
+type FileLink struct {
+	APIResource
+	Created   Timestamp         `json:"created"`
+	Expired   bool              `json:"expired"`
+	ExpiresAt Timestamp         `json:"expires_at"`
+	File      *File             `json:"file"`
+	ID        string            `json:"id"`
+	Live      bool              `json:"livemode"`
+	Meta      map[string]string `json:"metadata"`
+	URL       string            `json:"url"`
+}
+
+// FileLinkList is a list of file links as retrieved from a list endpoint.
+type FileLinkList struct {
+	APIResource
+	ListMeta
+	Values []*FileLink `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a FileLink.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (l *FileLink) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		l.ID = id
+		return nil
+	}
+
+	type fileLink FileLink
+	var ll fileLink
+	err := json.Unmarshal(data, &ll)
+	if err != nil {
+		return err
+	}
+
+	*l = FileLink(ll)
+	return nil
+}
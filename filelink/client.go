@@ -0,0 +1,129 @@
+// Package filelink provides the file link APIs
+package filelink
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke file link APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new file link.
+// For more details see https://stripe.com/docs/api#create_file_link.
+func New(params *stripe.FileLinkParams) (*stripe.FileLink, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.FileLinkParams) (*stripe.FileLink, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	fileLink := &stripe.FileLink{}
+	err := c.B.Call("POST", "/file_links", c.Key, body, commonParams, fileLink)
+
+	return fileLink, err
+}
+
+// Get returns the details of a file link.
+// For more details see https://stripe.com/docs/api#retrieve_file_link.
+func Get(id string, params *stripe.FileLinkParams) (*stripe.FileLink, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.FileLinkParams) (*stripe.FileLink, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	fileLink := &stripe.FileLink{}
+	err := c.B.Call("GET", fmt.Sprintf("/file_links/%v", id), c.Key, body, commonParams, fileLink)
+
+	return fileLink, err
+}
+
+// Update updates a file link's properties.
+// For more details see https://stripe.com/docs/api#update_file_link.
+func Update(id string, params *stripe.FileLinkParams) (*stripe.FileLink, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.FileLinkParams) (*stripe.FileLink, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	fileLink := &stripe.FileLink{}
+	err := c.B.Call("POST", fmt.Sprintf("/file_links/%v", id), c.Key, body, commonParams, fileLink)
+
+	return fileLink, err
+}
+
+// List returns a list of file links.
+// For more details see https://stripe.com/docs/api#list_file_links.
+func List(params *stripe.FileLinkListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.FileLinkListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.FileLinkList{}
+		err := c.B.Call("GET", "/file_links", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of FileLinks.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// FileLink returns the most recent FileLink
+// visited by a call to Next.
+func (i *Iter) FileLink() *stripe.FileLink {
+	return i.Current().(*stripe.FileLink)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
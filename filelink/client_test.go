@@ -0,0 +1,40 @@
+package filelink
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestFileLinkGet(t *testing.T) {
+	link, err := Get("link_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, link)
+}
+
+func TestFileLinkList(t *testing.T) {
+	i := List(&stripe.FileLinkListParams{File: "file_123"})
+
+	// Verify that we can get at least one file link
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.FileLink())
+}
+
+func TestFileLinkNew(t *testing.T) {
+	link, err := New(&stripe.FileLinkParams{
+		File: "file_123",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, link)
+}
+
+func TestFileLinkUpdate(t *testing.T) {
+	link, err := Update("link_123", &stripe.FileLinkParams{
+		ExpiresAtNow: true,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, link)
+}
@@ -43,7 +43,8 @@ type FileUploadPurpose string
 // FileUpload is the resource representing a Stripe file upload.
 // For more details see https://stripe.com/docs/api#file_uploads.
 type FileUpload struct {
-	Created int64             `json:"created"`
+	APIResource
+	Created Timestamp         `json:"created"`
 	ID      string            `json:"id"`
 	Purpose FileUploadPurpose `json:"purpose"`
 	Size    int64             `json:"size"`
@@ -53,6 +54,7 @@ type FileUpload struct {
 
 // FileUploadList is a list of file uploads as retrieved from a list endpoint.
 type FileUploadList struct {
+	APIResource
 	ListMeta
 	Values []*FileUpload `json:"data"`
 }
@@ -107,15 +109,18 @@ func (f *FileUploadParams) AppendDetails(body io.ReadWriter) (string, error) {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (f *FileUpload) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		f.ID = id
+		return nil
+	}
+
 	type file FileUpload
 	var ff file
 	err := json.Unmarshal(data, &ff)
-	if err == nil {
-		*f = FileUpload(ff)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		f.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*f = FileUpload(ff)
 	return nil
 }
@@ -110,5 +110,5 @@ func (i *Iter) FileUpload() *stripe.FileUpload {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.UploadsBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.UploadsBackend), stripe.GetKey()}
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -16,9 +17,16 @@ const tagName = "form"
 // Appender is the interface implemented by types that can append themselves to
 // a collection of form values.
 //
-// This is usually something that shouldn't be used, but is needed in a few
-// places where authors deviated from norms while implementing various
-// parameters.
+// Most parameters are encoded from their form tags alone, including nested
+// structs like the RangeQueryParams of list filters. Appender is for the
+// types that must serialize specially, like Filters or the "now" value of a
+// timestamp. Fields that AppendTo encodes itself are usually tagged with
+// `form:"-"` so that they aren't encoded twice. keyParts are the parts of the
+// key of the value being encoded, which are turned into a key with FormatKey:
+//
+//	func (p *AmountParams) AppendTo(body *form.Values, keyParts []string) {
+//	    body.Add(form.FormatKey(append(keyParts, "amount")), formatAmount(p.Amount))
+//	}
 type Appender interface {
 	// AppendTo is invoked by the form package on any types found to implement
 	// Appender so that they have a chance to encode themselves. Note that
@@ -166,23 +174,27 @@ func buildArrayOrSliceEncoder(t reflect.Type) encoderFunc {
 	elemF := getCachedOrBuildTypeEncoder(t.Elem())
 
 	return func(values *Values, v reflect.Value, keyParts []string, options *formOptions) {
-		// FormatKey automatically adds square brackets, so just pass an empty
-		// string into the breadcrumb trail
-		arrNames := append(keyParts, "")
-
 		for i := 0; i < v.Len(); i++ {
-			// The one exception to the above is when options have requested
-			// that this array/slice be indexed. In that case we produce a hash
-			// keyed with integers which the Stripe API knows how to interpret.
-			if options != nil && options.IndexedArray {
+			indexV := v.Index(i)
+
+			// FormatKey automatically adds square brackets, so just pass an
+			// empty string into the breadcrumb trail
+			arrNames := append(keyParts, "")
+
+			// The exception to the above is when options have requested that
+			// this array/slice be indexed, or when it holds composite values
+			// like structs or maps, whose fields can't be told apart from
+			// one item to the next with `arr[][field]=...`. In that case we
+			// produce a hash keyed with integers which the Stripe API knows
+			// how to interpret, like `arr[0][field]=...`.
+			if (options != nil && options.IndexedArray) || isComposite(indexV) {
 				arrNames = append(keyParts, strconv.Itoa(i))
 			}
 
-			indexV := v.Index(i)
 			elemF(values, indexV, arrNames, nil)
 
-			if isAppender(indexV.Type()) && !indexV.IsNil() {
-				v.Interface().(Appender).AppendTo(values, arrNames)
+			if isAppender(indexV.Type()) && !isNil(indexV) {
+				indexV.Interface().(Appender).AppendTo(values, arrNames)
 			}
 		}
 	}
@@ -292,20 +304,69 @@ func interfaceEncoder(values *Values, v reflect.Value, keyParts []string, _ *for
 	reflectValue(values, v.Elem(), keyParts)
 }
 
+// isNil reports whether v is a nil pointer or interface.
+func isNil(v reflect.Value) bool {
+	return (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil()
+}
+
+// isComposite reports whether v holds a value encoded as several fields,
+// like a struct, a map, or another array or slice.
+func isComposite(v reflect.Value) bool {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.Struct:
+		return true
+	}
+	return false
+}
+
 func isAppender(t reflect.Type) bool {
 	return t.Implements(reflect.TypeOf((*Appender)(nil)).Elem())
 }
 
 func mapEncoder(values *Values, v reflect.Value, keyParts []string, _ *formOptions) {
-	for _, keyVal := range v.MapKeys() {
+	keys := v.MapKeys()
+
+	// Encode keys in a stable order so that the same parameters always
+	// produce the same request body
+	sort.Sort(mapKeys(keys))
+
+	for _, keyVal := range keys {
 		if Strict && keyVal.Kind() != reflect.String {
 			panic("Don't support serializing maps with non-string keys")
 		}
 
-		reflectValue(values, v.MapIndex(keyVal), append(keyParts, keyVal.String()))
+		mapKeyParts := append(keyParts, keyVal.String())
+		mapV := v.MapIndex(keyVal)
+
+		// Unlike other zero values, empty strings in maps are sent: they're
+		// how a key of metadata is unset
+		elemV := mapV
+		if elemV.Kind() == reflect.Interface && !elemV.IsNil() {
+			elemV = elemV.Elem()
+		}
+		if elemV.Kind() == reflect.String && elemV.String() == "" {
+			values.Add(FormatKey(mapKeyParts), "")
+			continue
+		}
+
+		reflectValue(values, mapV, mapKeyParts)
 	}
 }
 
+// mapKeys sorts the keys of a map by their string value.
+type mapKeys []reflect.Value
+
+func (k mapKeys) Len() int           { return len(k) }
+func (k mapKeys) Less(i, j int) bool { return k[i].String() < k[j].String() }
+func (k mapKeys) Swap(i, j int)      { k[i], k[j] = k[j], k[i] }
+
 func stringEncoder(values *Values, v reflect.Value, keyParts []string, _ *formOptions) {
 	if v.String() == "" {
 		return
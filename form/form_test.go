@@ -57,6 +57,14 @@ type testStruct struct {
 	Slice    []string  `form:"slice"`
 	SlicePtr *[]string `form:"slice_ptr"`
 
+	SliceOfMaps       []map[string]string  `form:"slice_of_maps"`
+	SliceOfSlices     [][]string           `form:"slice_of_slices"`
+	SliceOfStructs    []testSubSubStruct   `form:"slice_of_structs"`
+	SliceOfStructPtrs []*testSubSubStruct  `form:"slice_of_struct_ptrs"`
+	SliceOfAppenders  []*testAppender      `form:"slice_of_appenders"`
+	SliceOfInterfaces []interface{}        `form:"slice_of_interfaces"`
+	SliceFlat         *testSliceFlatStruct `form:"slice_flat"`
+
 	String    string  `form:"string"`
 	StringPtr *string `form:"string_ptr"`
 
@@ -91,6 +99,12 @@ func (a *testAppender) AppendTo(values *Values, keyParts []string) {
 	values.Add(FormatKey(keyParts), a.String)
 }
 
+// testSliceFlatStruct holds a slice that takes the name of the field the
+// struct is in.
+type testSliceFlatStruct struct {
+	Items []*testSubSubStruct `form:"*"`
+}
+
 type testSubStruct struct {
 	SubSubStruct testSubSubStruct `form:"subsubstruct"`
 }
@@ -198,6 +212,15 @@ func TestAppendTo(t *testing.T) {
 			"bar",
 		},
 
+		// Tests empty string in map, which is sent
+		{
+			"map[foo]",
+			&testStruct{Map: map[string]interface{}{
+				"foo": "",
+			}},
+			"",
+		},
+
 		// Tests map nested inside of another map
 		{
 			"map[foo][bar]",
@@ -209,6 +232,48 @@ func TestAppendTo(t *testing.T) {
 
 		{"slice_indexed[2]", &testStruct{SliceIndexed: sliceVal}, "3"},
 
+		// Tests slices of composite values, which are always indexed
+		{
+			"slice_of_maps[1][foo]",
+			&testStruct{SliceOfMaps: []map[string]string{{"foo": "bar"}, {"foo": "baz"}}},
+			"baz",
+		},
+		{
+			"slice_of_slices[1][]",
+			&testStruct{SliceOfSlices: [][]string{{"1"}, {"2"}}},
+			"2",
+		},
+		{
+			"slice_of_structs[1][string]",
+			&testStruct{SliceOfStructs: []testSubSubStruct{{String: "1"}, {String: "2"}}},
+			"2",
+		},
+		{
+			"slice_of_struct_ptrs[1][string]",
+			&testStruct{SliceOfStructPtrs: []*testSubSubStruct{{String: "1"}, {String: "2"}}},
+			"2",
+		},
+		{
+			"slice_of_appenders[0]",
+			&testStruct{SliceOfAppenders: []*testAppender{{String: "123"}}},
+			"123",
+		},
+		{
+			"slice_of_interfaces[1][foo]",
+			&testStruct{SliceOfInterfaces: []interface{}{"1", map[string]interface{}{"foo": "bar"}}},
+			"bar",
+		},
+		{
+			"slice_of_interfaces[]",
+			&testStruct{SliceOfInterfaces: []interface{}{"1", map[string]interface{}{"foo": "bar"}}},
+			"1",
+		},
+		{
+			"slice_flat[1][string]",
+			&testStruct{SliceFlat: &testSliceFlatStruct{Items: []*testSubSubStruct{{String: "1"}, {String: "2"}}}},
+			"2",
+		},
+
 		{"string", &testStruct{String: stringVal}, stringVal},
 		{"string_ptr", &testStruct{StringPtr: &stringVal}, stringVal},
 
@@ -280,6 +345,30 @@ func TestAppendTo_DuplicatedNames(t *testing.T) {
 	}
 }
 
+func TestAppendTo_NestedSlices(t *testing.T) {
+	type item struct {
+		Meta     map[string]string `form:"metadata"`
+		Price    string            `form:"price"`
+		Quantity int64             `form:"quantity"`
+	}
+	type params struct {
+		Items []*item `form:"items"`
+	}
+
+	form := &Values{}
+	AppendTo(form, &params{Items: []*item{
+		{Price: "price_1", Quantity: 2, Meta: map[string]string{"b": "2", "a": "1"}},
+		{Price: "price_2"},
+	}})
+
+	// Map keys are encoded in order
+	encoded, err := url.QueryUnescape(form.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"items[0][metadata][a]=1&items[0][metadata][b]=2&items[0][price]=price_1&items[0][quantity]=2&items[1][price]=price_2",
+		encoded)
+}
+
 func TestAppendTo_IgnoredFields(t *testing.T) {
 	form := &Values{}
 	data := &testStruct{Ignored: "value"}
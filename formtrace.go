@@ -0,0 +1,88 @@
+package stripe
+
+import (
+	"net/url"
+	"strings"
+)
+
+// FormPair is a key/value pair of the encoded parameters of a request, as
+// recorded when the TraceForm option of a backend is set.
+type FormPair struct {
+	Key   string
+	Value string
+}
+
+// redactedValue replaces the values of sensitive parameters in traced forms.
+const redactedValue = "[REDACTED]"
+
+// sensitiveParams are the names of the parameters whose values are redacted
+// from traced forms, wherever they're nested, like "card[number]".
+var sensitiveParams = map[string]bool{
+	"account_number":     true,
+	"client_secret":      true,
+	"cvc":                true,
+	"id_number":          true,
+	"number":             true,
+	"personal_id_number": true,
+	"routing_number":     true,
+	"ssn_last_4":         true,
+}
+
+// traceForm splits encoded form data into its key/value pairs, in the order
+// they were sent, redacting the values of sensitive parameters.
+func traceForm(data string) []FormPair {
+	if data == "" {
+		return nil
+	}
+
+	var pairs []FormPair
+	for _, part := range strings.Split(data, "&") {
+		kv := strings.SplitN(part, "=", 2)
+
+		key, err := url.QueryUnescape(kv[0])
+		if err != nil {
+			key = kv[0]
+		}
+
+		var value string
+		if len(kv) == 2 {
+			value, err = url.QueryUnescape(kv[1])
+			if err != nil {
+				value = kv[1]
+			}
+		}
+
+		if sensitiveParams[paramName(key)] {
+			value = redactedValue
+		}
+
+		pairs = append(pairs, FormPair{Key: key, Value: value})
+	}
+	return pairs
+}
+
+// paramName returns the innermost name of a form key, like "number" for
+// "card[number]".
+func paramName(key string) string {
+	if strings.HasSuffix(key, "]") {
+		if i := strings.LastIndex(key, "["); i >= 0 {
+			return key[i+1 : len(key)-1]
+		}
+	}
+	return key
+}
+
+// attachForm attaches the traced pairs of the encoded form data of a request
+// to err if it's an invalid request error.
+func attachForm(err error, data string) {
+	stripeErr, ok := err.(*Error)
+	if !ok {
+		return
+	}
+
+	invalidErr, ok := stripeErr.Err.(*InvalidRequestError)
+	if !ok {
+		return
+	}
+	invalidErr.Form = traceForm(data)
+}
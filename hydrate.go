@@ -0,0 +1,131 @@
+package stripe
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// HydrateFunc retrieves the full object with the given ID, like customer.Get
+// does for customers.
+type HydrateFunc func(id string) (interface{}, error)
+
+// HydrateErrors is the error returned by Hydrator.Hydrate when some objects
+// couldn't be retrieved. It maps their IDs to the error returned for each.
+type HydrateErrors map[string]error
+
+// Error returns a description of every object that couldn't be retrieved.
+func (e HydrateErrors) Error() string {
+	ids := make([]string, 0, len(e))
+	for id := range e {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	msgs := make([]string, len(ids))
+	for i, id := range ids {
+		msgs[i] = fmt.Sprintf("%v: %v", id, e[id])
+	}
+	return "cannot hydrate " + strings.Join(msgs, "; ")
+}
+
+// Hydrator turns the IDs of objects that weren't expanded, like the IDs of
+// the customers of a list of charges, into full objects. Each object is
+// retrieved once however many times its ID is given, and the retrieved
+// objects are kept for the lifetime of the Hydrator. It's safe for
+// concurrent use.
+//
+// A typical use is:
+//
+//	h := stripe.NewHydrator(func(id string) (interface{}, error) {
+//		return customer.Get(id, nil)
+//	}, 4)
+//	customers, err := h.Hydrate(customerIDs)
+type Hydrator struct {
+	cache       map[string]interface{}
+	concurrency int
+	get         HydrateFunc
+	mu          sync.Mutex
+}
+
+// NewHydrator returns a Hydrator retrieving objects with get, making at most
+// concurrency calls to it at a time. A concurrency lower than 1 is treated as
+// 1.
+func NewHydrator(get HydrateFunc, concurrency int) *Hydrator {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &Hydrator{
+		cache:       make(map[string]interface{}),
+		concurrency: concurrency,
+		get:         get,
+	}
+}
+
+// Hydrate returns the objects with the given IDs, keyed by ID. Empty and
+// duplicate IDs are ignored. If some objects can't be retrieved, the others
+// are still returned along with a HydrateErrors; failures aren't cached, so
+// they're retried by the next call.
+func (h *Hydrator) Hydrate(ids []string) (map[string]interface{}, error) {
+	objects := make(map[string]interface{}, len(ids))
+	var missing []string
+
+	h.mu.Lock()
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		if _, ok := objects[id]; ok {
+			continue
+		}
+
+		obj, ok := h.cache[id]
+		if !ok {
+			missing = append(missing, id)
+		}
+		// Marks the ID as seen; objects that aren't cached are set below.
+		objects[id] = obj
+	}
+	h.mu.Unlock()
+
+	errs := make(HydrateErrors)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, h.concurrency)
+
+	for _, id := range missing {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(id string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			obj, err := h.get(id)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs[id] = err
+				delete(objects, id)
+				return
+			}
+			objects[id] = obj
+
+			h.mu.Lock()
+			h.cache[id] = obj
+			h.mu.Unlock()
+		}(id)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return objects, errs
+	}
+	return objects, nil
+}
@@ -0,0 +1,66 @@
+package stripe_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+)
+
+func TestHydrator(t *testing.T) {
+	var calls, inFlight, maxInFlight int32
+	var mu sync.Mutex
+	seen := make(map[string]int)
+
+	h := stripe.NewHydrator(func(id string) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		mu.Lock()
+		seen[id]++
+		if n > maxInFlight {
+			maxInFlight = n
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+		return &stripe.Customer{ID: id}, nil
+	}, 2)
+
+	objects, err := h.Hydrate([]string{"cus_1", "cus_2", "cus_1", "", "cus_3", "cus_4"})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(objects))
+	assert.Equal(t, "cus_3", objects["cus_3"].(*stripe.Customer).ID)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+	assert.True(t, maxInFlight <= 2)
+
+	// Objects that were already retrieved are cached
+	objects, err = h.Hydrate([]string{"cus_1", "cus_5"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(objects))
+	assert.Equal(t, int32(5), atomic.LoadInt32(&calls))
+	assert.Equal(t, 1, seen["cus_1"])
+}
+
+func TestHydratorErrors(t *testing.T) {
+	h := stripe.NewHydrator(func(id string) (interface{}, error) {
+		if id == "cus_missing" {
+			return nil, errors.New("No such customer")
+		}
+		return &stripe.Customer{ID: id}, nil
+	}, 4)
+
+	objects, err := h.Hydrate([]string{"cus_1", "cus_missing"})
+	assert.Equal(t, 1, len(objects))
+	assert.NotNil(t, objects["cus_1"])
+
+	errs, ok := err.(stripe.HydrateErrors)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "cannot hydrate cus_missing: No such customer", err.Error())
+}
@@ -0,0 +1,94 @@
+// Package verificationreport provides the /identity/verification_reports APIs
+package verificationreport
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	CheckStatusUnverified stripe.IdentityVerificationReportCheckStatus = "unverified"
+	CheckStatusVerified   stripe.IdentityVerificationReportCheckStatus = "verified"
+
+	DocumentTypeDrivingLicense stripe.IdentityVerificationReportDocumentType = "driving_license"
+	DocumentTypeIDCard         stripe.IdentityVerificationReportDocumentType = "id_card"
+	DocumentTypePassport       stripe.IdentityVerificationReportDocumentType = "passport"
+)
+
+// Client is used to invoke /identity/verification_reports APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of a verification report.
+// For more details see https://stripe.com/docs/api#retrieve_identity_verification_report.
+func Get(id string, params *stripe.IdentityVerificationReportParams) (*stripe.IdentityVerificationReport, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.IdentityVerificationReportParams) (*stripe.IdentityVerificationReport, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	identityVerificationReport := &stripe.IdentityVerificationReport{}
+	err := c.B.Call("GET", fmt.Sprintf("/identity/verification_reports/%v", id), c.Key, body, commonParams, identityVerificationReport)
+
+	return identityVerificationReport, err
+}
+
+// List returns a list of verification reports.
+// For more details see https://stripe.com/docs/api#list_identity_verification_reports.
+func List(params *stripe.IdentityVerificationReportListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.IdentityVerificationReportListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.IdentityVerificationReportList{}
+		err := c.B.Call("GET", "/identity/verification_reports", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of IdentityVerificationReports.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// IdentityVerificationReport returns the most recent IdentityVerificationReport
+// visited by a call to Next.
+func (i *Iter) IdentityVerificationReport() *stripe.IdentityVerificationReport {
+	return i.Current().(*stripe.IdentityVerificationReport)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
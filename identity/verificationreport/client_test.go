@@ -0,0 +1,26 @@
+package verificationreport
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestIdentityVerificationReportGet(t *testing.T) {
+	report, err := Get("vr_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, report)
+}
+
+func TestIdentityVerificationReportList(t *testing.T) {
+	i := List(&stripe.IdentityVerificationReportListParams{
+		VerificationSession: "vs_123",
+	})
+
+	// Verify that we can get at least one verification report
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.IdentityVerificationReport())
+}
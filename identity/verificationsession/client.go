@@ -0,0 +1,203 @@
+// Package verificationsession provides the /identity/verification_sessions APIs
+package verificationsession
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	LastErrorCodeAbandoned                        stripe.IdentityVerificationSessionLastErrorCode = "abandoned"
+	LastErrorCodeConsentDeclined                  stripe.IdentityVerificationSessionLastErrorCode = "consent_declined"
+	LastErrorCodeCountryNotSupported              stripe.IdentityVerificationSessionLastErrorCode = "country_not_supported"
+	LastErrorCodeDeviceNotSupported               stripe.IdentityVerificationSessionLastErrorCode = "device_not_supported"
+	LastErrorCodeDocumentExpired                  stripe.IdentityVerificationSessionLastErrorCode = "document_expired"
+	LastErrorCodeDocumentTypeNotSupported         stripe.IdentityVerificationSessionLastErrorCode = "document_type_not_supported"
+	LastErrorCodeDocumentUnverifiedOther          stripe.IdentityVerificationSessionLastErrorCode = "document_unverified_other"
+	LastErrorCodeIDNumberInsufficientDocumentData stripe.IdentityVerificationSessionLastErrorCode = "id_number_insufficient_document_data"
+	LastErrorCodeIDNumberMismatch                 stripe.IdentityVerificationSessionLastErrorCode = "id_number_mismatch"
+	LastErrorCodeIDNumberUnverifiedOther          stripe.IdentityVerificationSessionLastErrorCode = "id_number_unverified_other"
+	LastErrorCodeSelfieDocumentMissingPhoto       stripe.IdentityVerificationSessionLastErrorCode = "selfie_document_missing_photo"
+	LastErrorCodeSelfieFaceMismatch               stripe.IdentityVerificationSessionLastErrorCode = "selfie_face_mismatch"
+	LastErrorCodeSelfieManipulated                stripe.IdentityVerificationSessionLastErrorCode = "selfie_manipulated"
+	LastErrorCodeSelfieUnverifiedOther            stripe.IdentityVerificationSessionLastErrorCode = "selfie_unverified_other"
+	LastErrorCodeUnderSupportedAge                stripe.IdentityVerificationSessionLastErrorCode = "under_supported_age"
+
+	RedactionStatusProcessing stripe.IdentityVerificationSessionRedactionStatus = "processing"
+	RedactionStatusRedacted   stripe.IdentityVerificationSessionRedactionStatus = "redacted"
+
+	StatusCanceled      stripe.IdentityVerificationSessionStatus = "canceled"
+	StatusProcessing    stripe.IdentityVerificationSessionStatus = "processing"
+	StatusRequiresInput stripe.IdentityVerificationSessionStatus = "requires_input"
+	StatusVerified      stripe.IdentityVerificationSessionStatus = "verified"
+
+	TypeDocument stripe.IdentityVerificationSessionType = "document"
+	TypeIDNumber stripe.IdentityVerificationSessionType = "id_number"
+)
+
+// Client is used to invoke /identity/verification_sessions APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new verification session.
+// For more details see https://stripe.com/docs/api#create_identity_verification_session.
+func New(params *stripe.IdentityVerificationSessionParams) (*stripe.IdentityVerificationSession, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.IdentityVerificationSessionParams) (*stripe.IdentityVerificationSession, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	identityVerificationSession := &stripe.IdentityVerificationSession{}
+	err := c.B.Call("POST", "/identity/verification_sessions", c.Key, body, commonParams, identityVerificationSession)
+
+	return identityVerificationSession, err
+}
+
+// Get returns the details of a verification session.
+// For more details see https://stripe.com/docs/api#retrieve_identity_verification_session.
+func Get(id string, params *stripe.IdentityVerificationSessionParams) (*stripe.IdentityVerificationSession, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.IdentityVerificationSessionParams) (*stripe.IdentityVerificationSession, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	identityVerificationSession := &stripe.IdentityVerificationSession{}
+	err := c.B.Call("GET", fmt.Sprintf("/identity/verification_sessions/%v", id), c.Key, body, commonParams, identityVerificationSession)
+
+	return identityVerificationSession, err
+}
+
+// Update updates a verification session's properties.
+// For more details see https://stripe.com/docs/api#update_identity_verification_session.
+func Update(id string, params *stripe.IdentityVerificationSessionParams) (*stripe.IdentityVerificationSession, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.IdentityVerificationSessionParams) (*stripe.IdentityVerificationSession, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	identityVerificationSession := &stripe.IdentityVerificationSession{}
+	err := c.B.Call("POST", fmt.Sprintf("/identity/verification_sessions/%v", id), c.Key, body, commonParams, identityVerificationSession)
+
+	return identityVerificationSession, err
+}
+
+// Cancel cancels a verification session, which can't be used anymore.
+// For more details see https://stripe.com/docs/api#cancel_identity_verification_session.
+func Cancel(id string, params *stripe.IdentityVerificationSessionCancelParams) (*stripe.IdentityVerificationSession, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.IdentityVerificationSessionCancelParams) (*stripe.IdentityVerificationSession, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	identityVerificationSession := &stripe.IdentityVerificationSession{}
+	err := c.B.Call("POST", fmt.Sprintf("/identity/verification_sessions/%v/cancel", id), c.Key, body, commonParams, identityVerificationSession)
+
+	return identityVerificationSession, err
+}
+
+// Redact redacts a verification session, removing the personal data it
+// collected from the session and its reports.
+// For more details see https://stripe.com/docs/api#redact_identity_verification_session.
+func Redact(id string, params *stripe.IdentityVerificationSessionRedactParams) (*stripe.IdentityVerificationSession, error) {
+	return getC().Redact(id, params)
+}
+
+func (c Client) Redact(id string, params *stripe.IdentityVerificationSessionRedactParams) (*stripe.IdentityVerificationSession, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	identityVerificationSession := &stripe.IdentityVerificationSession{}
+	err := c.B.Call("POST", fmt.Sprintf("/identity/verification_sessions/%v/redact", id), c.Key, body, commonParams, identityVerificationSession)
+
+	return identityVerificationSession, err
+}
+
+// List returns a list of verification sessions.
+// For more details see https://stripe.com/docs/api#list_identity_verification_sessions.
+func List(params *stripe.IdentityVerificationSessionListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.IdentityVerificationSessionListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.IdentityVerificationSessionList{}
+		err := c.B.Call("GET", "/identity/verification_sessions", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of IdentityVerificationSessions.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// IdentityVerificationSession returns the most recent IdentityVerificationSession
+// visited by a call to Next.
+func (i *Iter) IdentityVerificationSession() *stripe.IdentityVerificationSession {
+	return i.Current().(*stripe.IdentityVerificationSession)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
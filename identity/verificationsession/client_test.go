@@ -0,0 +1,61 @@
+package verificationsession
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestIdentityVerificationSessionCancel(t *testing.T) {
+	session, err := Cancel("vs_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, session)
+}
+
+func TestIdentityVerificationSessionGet(t *testing.T) {
+	session, err := Get("vs_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, session)
+}
+
+func TestIdentityVerificationSessionList(t *testing.T) {
+	i := List(&stripe.IdentityVerificationSessionListParams{
+		Status: StatusVerified,
+	})
+
+	// Verify that we can get at least one verification session
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.IdentityVerificationSession())
+}
+
+func TestIdentityVerificationSessionNew(t *testing.T) {
+	requireSelfie := true
+	session, err := New(&stripe.IdentityVerificationSessionParams{
+		Options: &stripe.IdentityVerificationSessionOptionsParams{
+			Document: &stripe.IdentityVerificationSessionOptionsDocumentParams{
+				AllowedTypes:          []string{"driving_license", "passport"},
+				RequireMatchingSelfie: &requireSelfie,
+			},
+		},
+		Type: TypeDocument,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, session)
+}
+
+func TestIdentityVerificationSessionRedact(t *testing.T) {
+	session, err := Redact("vs_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, session)
+}
+
+func TestIdentityVerificationSessionUpdate(t *testing.T) {
+	session, err := Update("vs_123", &stripe.IdentityVerificationSessionParams{
+		Type: TypeIDNumber,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, session)
+}
@@ -0,0 +1,122 @@
+package stripe
+
+import "encoding/json"
+
+// IdentityVerificationReportCheckStatus is the list of allowed values for the
+// status of a check of a verification report. Allowed values are
+// "unverified", "verified".
+type IdentityVerificationReportCheckStatus string
+
+// IdentityVerificationReportDocumentType is the list of allowed values for
+// the type of a verified document. Allowed values are "driving_license",
+// "id_card", "passport".
+type IdentityVerificationReportDocumentType string
+
+// IdentityVerificationReportParams is the set of parameters that can be used
+// when retrieving a verification report.
+// For more details see https://stripe.com/docs/api#retrieve_identity_verification_report.
+type IdentityVerificationReportParams struct {
+	Params `form:"*"`
+}
+
+// IdentityVerificationReportListParams is the set of parameters that can be
+// used when listing verification reports.
+// For more details see https://stripe.com/docs/api#list_identity_verification_reports.
+type IdentityVerificationReportListParams struct {
+	ListParams          `form:"*"`
+	Created             int64                           `form:"created"`
+	CreatedRange        *RangeQueryParams               `form:"created"`
+	Type                IdentityVerificationSessionType `form:"type"`
+	VerificationSession string                          `form:"verification_session"`
+}
+
+// IdentityVerificationReportCheckError describes why a check of a
+// verification report failed.
+type IdentityVerificationReportCheckError struct {
+	Code   IdentityVerificationSessionLastErrorCode `json:"code"`
+	Reason string                                   `json:"reason"`
+}
+
+// IdentityVerificationReportDocument is the result of the document check of
+// a verification report. Files holds the IDs of the uploaded images of the
+// document.
+type IdentityVerificationReportDocument struct {
+	Address        *Address                               `json:"address"`
+	DOB            *DOB                                   `json:"dob"`
+	Error          *IdentityVerificationReportCheckError  `json:"error"`
+	ExpirationDate *DOB                                   `json:"expiration_date"`
+	Files          []string                               `json:"files"`
+	FirstName      string                                 `json:"first_name"`
+	IssuedDate     *DOB                                   `json:"issued_date"`
+	IssuingCountry string                                 `json:"issuing_country"`
+	LastName       string                                 `json:"last_name"`
+	Number         string                                 `json:"number"`
+	Status         IdentityVerificationReportCheckStatus  `json:"status"`
+	Type           IdentityVerificationReportDocumentType `json:"type"`
+}
+
+// IdentityVerificationReportIDNumber is the result of the ID number check of
+// a verification report.
+type IdentityVerificationReportIDNumber struct {
+	DOB          *DOB                                  `json:"dob"`
+	Error        *IdentityVerificationReportCheckError `json:"error"`
+	FirstName    string                                `json:"first_name"`
+	IDNumber     string                                `json:"id_number"`
+	IDNumberType string                                `json:"id_number_type"`
+	LastName     string                                `json:"last_name"`
+	Status       IdentityVerificationReportCheckStatus `json:"status"`
+}
+
+// IdentityVerificationReportSelfie is the result of the selfie check of a
+// verification report, which compares the face of the user with the photo of
+// their document. Document and Selfie are the IDs of the compared images.
+type IdentityVerificationReportSelfie struct {
+	Document string                                `json:"document"`
+	Error    *IdentityVerificationReportCheckError `json:"error"`
+	Selfie   string                                `json:"selfie"`
+	Status   IdentityVerificationReportCheckStatus `json:"status"`
+}
+
+// IdentityVerificationReport is the resource representing the result of a
+// verification attempt of a Stripe Identity verification session.
+// For more details see https://stripe.com/docs/api#identity_verification_reports.
+type IdentityVerificationReport struct {
+	APIResource
+	Created             Timestamp                           `json:"created"`
+	Document            *IdentityVerificationReportDocument `json:"document"`
+	ID                  string                              `json:"id"`
+	IDNumber            *IdentityVerificationReportIDNumber `json:"id_number"`
+	Live                bool                                `json:"livemode"`
+	Options             *IdentityVerificationSessionOptions `json:"options"`
+	Selfie              *IdentityVerificationReportSelfie   `json:"selfie"`
+	Type                IdentityVerificationSessionType     `json:"type"`
+	VerificationSession string                              `json:"verification_session"`
+}
+
+// IdentityVerificationReportList is a list of verification reports as
+// retrieved from a list endpoint.
+type IdentityVerificationReportList struct {
+	APIResource
+	ListMeta
+	Values []*IdentityVerificationReport `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of an IdentityVerificationReport.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (i *IdentityVerificationReport) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		i.ID = id
+		return nil
+	}
+
+	type identityVerificationReport IdentityVerificationReport
+	var ii identityVerificationReport
+	err := json.Unmarshal(data, &ii)
+	if err != nil {
+		return err
+	}
+
+	*i = IdentityVerificationReport(ii)
+	return nil
+}
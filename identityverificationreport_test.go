@@ -0,0 +1,44 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestIdentityVerificationReportUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"id": "vr_123",
+		"object": "identity.verification_report",
+		"document": {
+			"dob": {"day": 1, "month": 12, "year": 1990},
+			"error": null,
+			"expiration_date": {"day": 31, "month": 1, "year": 2030},
+			"files": ["file_123"],
+			"status": "verified",
+			"type": "passport"
+		},
+		"selfie": {
+			"document": "file_123",
+			"error": {"code": "selfie_face_mismatch", "reason": "The faces don't match."},
+			"selfie": "file_456",
+			"status": "unverified"
+		},
+		"type": "document",
+		"verification_session": "vs_123"
+	}`)
+
+	var report IdentityVerificationReport
+	err := json.Unmarshal(data, &report)
+	assert.NoError(t, err)
+
+	assert.Equal(t, IdentityVerificationReportCheckStatus("verified"), report.Document.Status)
+	assert.Equal(t, IdentityVerificationReportDocumentType("passport"), report.Document.Type)
+	assert.Equal(t, 1990, report.Document.DOB.Year)
+	assert.Equal(t, []string{"file_123"}, report.Document.Files)
+
+	assert.Equal(t, IdentityVerificationReportCheckStatus("unverified"), report.Selfie.Status)
+	assert.Equal(t, IdentityVerificationSessionLastErrorCode("selfie_face_mismatch"), report.Selfie.Error.Code)
+	assert.Equal(t, "vs_123", report.VerificationSession)
+}
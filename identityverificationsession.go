@@ -0,0 +1,165 @@
+package stripe
+
+import "encoding/json"
+
+// IdentityVerificationSessionLastErrorCode is the list of allowed values for
+// the code of the last error of a verification session. Allowed values are
+// "abandoned", "consent_declined", "country_not_supported",
+// "device_not_supported", "document_expired", "document_type_not_supported",
+// "document_unverified_other", "id_number_insufficient_document_data",
+// "id_number_mismatch", "id_number_unverified_other", "selfie_document_missing_photo",
+// "selfie_face_mismatch", "selfie_manipulated", "selfie_unverified_other",
+// "under_supported_age".
+type IdentityVerificationSessionLastErrorCode string
+
+// IdentityVerificationSessionRedactionStatus is the list of allowed values
+// for the status of the redaction of a verification session. Allowed values
+// are "processing", "redacted".
+type IdentityVerificationSessionRedactionStatus string
+
+// IdentityVerificationSessionStatus is the list of allowed values for the
+// status of a verification session. Allowed values are "canceled",
+// "processing", "requires_input", "verified".
+type IdentityVerificationSessionStatus string
+
+// IdentityVerificationSessionType is the list of allowed values for the type
+// of a verification session. Allowed values are "document", "id_number".
+type IdentityVerificationSessionType string
+
+// IdentityVerificationSessionOptionsDocumentParams is the set of parameters
+// describing the document check of a verification session. AllowedTypes
+// holds the allowed document types, like "driving_license" or "passport".
+type IdentityVerificationSessionOptionsDocumentParams struct {
+	AllowedTypes          []string `form:"allowed_types"`
+	RequireIDNumber       *bool    `form:"require_id_number"`
+	RequireLiveCapture    *bool    `form:"require_live_capture"`
+	RequireMatchingSelfie *bool    `form:"require_matching_selfie"`
+}
+
+// IdentityVerificationSessionOptionsParams is the set of parameters
+// describing the checks of a verification session.
+type IdentityVerificationSessionOptionsParams struct {
+	Document *IdentityVerificationSessionOptionsDocumentParams `form:"document"`
+}
+
+// IdentityVerificationSessionParams is the set of parameters that can be
+// used when creating or updating a verification session.
+// For more details see https://stripe.com/docs/api#create_identity_verification_session.
+type IdentityVerificationSessionParams struct {
+	Params    `form:"*"`
+	Options   *IdentityVerificationSessionOptionsParams `form:"options"`
+	ReturnURL string                                    `form:"return_url"`
+	Type      IdentityVerificationSessionType           `form:"type"`
+}
+
+// IdentityVerificationSessionCancelParams is the set of parameters that can
+// be used when canceling a verification session.
+// For more details see https://stripe.com/docs/api#cancel_identity_verification_session.
+type IdentityVerificationSessionCancelParams struct {
+	Params `form:"*"`
+}
+
+// IdentityVerificationSessionRedactParams is the set of parameters that can
+// be used when redacting a verification session.
+// For more details see https://stripe.com/docs/api#redact_identity_verification_session.
+type IdentityVerificationSessionRedactParams struct {
+	Params `form:"*"`
+}
+
+// IdentityVerificationSessionListParams is the set of parameters that can be
+// used when listing verification sessions.
+// For more details see https://stripe.com/docs/api#list_identity_verification_sessions.
+type IdentityVerificationSessionListParams struct {
+	ListParams   `form:"*"`
+	Created      int64                             `form:"created"`
+	CreatedRange *RangeQueryParams                 `form:"created"`
+	Status       IdentityVerificationSessionStatus `form:"status"`
+}
+
+// IdentityVerificationSessionLastError describes why the last verification
+// attempt of a session failed.
+type IdentityVerificationSessionLastError struct {
+	Code   IdentityVerificationSessionLastErrorCode `json:"code"`
+	Reason string                                   `json:"reason"`
+}
+
+// IdentityVerificationSessionOptionsDocument describes the document check of
+// a verification session.
+type IdentityVerificationSessionOptionsDocument struct {
+	AllowedTypes          []string `json:"allowed_types"`
+	RequireIDNumber       bool     `json:"require_id_number"`
+	RequireLiveCapture    bool     `json:"require_live_capture"`
+	RequireMatchingSelfie bool     `json:"require_matching_selfie"`
+}
+
+// IdentityVerificationSessionOptions describes the checks of a verification
+// session.
+type IdentityVerificationSessionOptions struct {
+	Document *IdentityVerificationSessionOptionsDocument `json:"document"`
+}
+
+// IdentityVerificationSessionRedaction describes the redaction of a
+// verification session.
+type IdentityVerificationSessionRedaction struct {
+	Status IdentityVerificationSessionRedactionStatus `json:"status"`
+}
+
+// IdentityVerificationSessionVerifiedOutputs holds the data that was
+// verified by a session.
+type IdentityVerificationSessionVerifiedOutputs struct {
+	Address      *Address `json:"address"`
+	DOB          *DOB     `json:"dob"`
+	FirstName    string   `json:"first_name"`
+	IDNumber     string   `json:"id_number"`
+	IDNumberType string   `json:"id_number_type"`
+	LastName     string   `json:"last_name"`
+}
+
+// IdentityVerificationSession is the resource representing a Stripe Identity
+// verification session, which guides a user through verifying their
+// identity.
+// For more details see https://stripe.com/docs/api#identity_verification_sessions.
+type IdentityVerificationSession struct {
+	APIResource
+	ClientSecret           string                                      `json:"client_secret"`
+	Created                Timestamp                                   `json:"created"`
+	ID                     string                                      `json:"id"`
+	LastError              *IdentityVerificationSessionLastError       `json:"last_error"`
+	LastVerificationReport *IdentityVerificationReport                 `json:"last_verification_report"`
+	Live                   bool                                        `json:"livemode"`
+	Meta                   map[string]string                           `json:"metadata"`
+	Options                *IdentityVerificationSessionOptions         `json:"options"`
+	Redaction              *IdentityVerificationSessionRedaction       `json:"redaction"`
+	Status                 IdentityVerificationSessionStatus           `json:"status"`
+	Type                   IdentityVerificationSessionType             `json:"type"`
+	URL                    string                                      `json:"url"`
+	VerifiedOutputs        *IdentityVerificationSessionVerifiedOutputs `json:"verified_outputs"`
+}
+
+// IdentityVerificationSessionList is a list of verification sessions as
+// retrieved from a list endpoint.
+type IdentityVerificationSessionList struct {
+	APIResource
+	ListMeta
+	Values []*IdentityVerificationSession `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of an IdentityVerificationSession.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (i *IdentityVerificationSession) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		i.ID = id
+		return nil
+	}
+
+	type identityVerificationSession IdentityVerificationSession
+	var ii identityVerificationSession
+	err := json.Unmarshal(data, &ii)
+	if err != nil {
+		return err
+	}
+
+	*i = IdentityVerificationSession(ii)
+	return nil
+}
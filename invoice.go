@@ -1,6 +1,10 @@
 package stripe
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/stripe/stripe-go/form"
+)
 
 // InvoiceLineType is the list of allowed values for the invoice line's type.
 // Allowed values are "invoiceitem", "subscription".
@@ -10,6 +14,10 @@ type InvoiceLineType string
 // Currently supported values are "send_invoice" and "charge_automatically".
 type InvoiceBilling string
 
+// InvoiceStatus is the list of allowed values for the invoice's status.
+// Allowed values are "draft", "open", "paid", "uncollectible", "void".
+type InvoiceStatus string
+
 // InvoiceParams is the set of parameters that can be used when creating or updating an invoice.
 // For more details see https://stripe.com/docs/api#create_invoice, https://stripe.com/docs/api#update_invoice.
 type InvoiceParams struct {
@@ -44,13 +52,16 @@ type InvoiceParams struct {
 // InvoiceListParams is the set of parameters that can be used when listing invoices.
 // For more details see https://stripe.com/docs/api#list_customer_invoices.
 type InvoiceListParams struct {
-	ListParams `form:"*"`
-	Billing    InvoiceBilling    `form:"billing"`
-	Customer   string            `form:"customer"`
-	Date       int64             `form:"date"`
-	DateRange  *RangeQueryParams `form:"date"`
-	DueDate    int64             `form:"due_date"`
-	Sub        string            `form:"subscription"`
+	ListParams   `form:"*"`
+	Billing      InvoiceBilling    `form:"billing"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Customer     string            `form:"customer"`
+	Date         int64             `form:"date"`
+	DateRange    *RangeQueryParams `form:"date"`
+	DueDate      int64             `form:"due_date"`
+	DueDateRange *RangeQueryParams `form:"due_date"`
+	Sub          string            `form:"subscription"`
 }
 
 // InvoiceLineListParams is the set of parameters that can be used when listing invoice line items.
@@ -66,9 +77,81 @@ type InvoiceLineListParams struct {
 	Sub string `form:"subscription"`
 }
 
+// InvoiceUpcomingParams is the set of parameters that can be used when
+// previewing the upcoming invoice of a customer. The Sub fields preview the
+// invoice as if the subscription had been updated with them, including the
+// prorations that the update would create.
+// For more details see https://stripe.com/docs/api#upcoming_invoice.
+type InvoiceUpcomingParams struct {
+	Params                         `form:"*"`
+	Coupon                         string               `form:"coupon"`
+	Customer                       string               `form:"customer"`
+	Sub                            string               `form:"subscription"`
+	SubBillingCycleAnchor          int64                `form:"subscription_billing_cycle_anchor"`
+	SubBillingCycleAnchorNow       bool                 `form:"-"` // See custom AppendTo
+	SubBillingCycleAnchorUnchanged bool                 `form:"-"` // See custom AppendTo
+	SubCancelAtPeriodEnd           *bool                `form:"subscription_cancel_at_period_end"`
+	SubItems                       []*SubItemsParams    `form:"subscription_items,indexed"`
+	SubNoProrate                   bool                 `form:"subscription_prorate,invert"`
+	SubPlan                        string               `form:"subscription_plan"`
+	SubProrationBehavior           SubProrationBehavior `form:"subscription_proration_behavior"`
+	SubProrationDate               int64                `form:"subscription_proration_date"`
+	SubQuantity                    uint64               `form:"subscription_quantity"`
+	SubQuantityZero                bool                 `form:"subscription_quantity,zero"`
+	SubTrialEnd                    int64                `form:"subscription_trial_end"`
+}
+
+// InvoiceUpcomingLinesParams is the set of parameters that can be used when
+// listing the line items of the upcoming invoice of a customer. It takes the
+// same preview parameters as InvoiceUpcomingParams.
+// For more details see https://stripe.com/docs/api#upcoming_invoice_lines.
+type InvoiceUpcomingLinesParams struct {
+	ListParams                     `form:"*"`
+	Coupon                         string               `form:"coupon"`
+	Customer                       string               `form:"customer"`
+	Sub                            string               `form:"subscription"`
+	SubBillingCycleAnchor          int64                `form:"subscription_billing_cycle_anchor"`
+	SubBillingCycleAnchorNow       bool                 `form:"-"` // See custom AppendTo
+	SubBillingCycleAnchorUnchanged bool                 `form:"-"` // See custom AppendTo
+	SubCancelAtPeriodEnd           *bool                `form:"subscription_cancel_at_period_end"`
+	SubItems                       []*SubItemsParams    `form:"subscription_items,indexed"`
+	SubNoProrate                   bool                 `form:"subscription_prorate,invert"`
+	SubPlan                        string               `form:"subscription_plan"`
+	SubProrationBehavior           SubProrationBehavior `form:"subscription_proration_behavior"`
+	SubProrationDate               int64                `form:"subscription_proration_date"`
+	SubQuantity                    uint64               `form:"subscription_quantity"`
+	SubQuantityZero                bool                 `form:"subscription_quantity,zero"`
+	SubTrialEnd                    int64                `form:"subscription_trial_end"`
+}
+
+// AppendTo implements custom encoding logic for InvoiceUpcomingParams so
+// that the special "now" and "unchanged" values for
+// subscription_billing_cycle_anchor can be implemented (it's otherwise a
+// timestamp rather than a string).
+func (p *InvoiceUpcomingParams) AppendTo(body *form.Values, keyParts []string) {
+	appendSubBillingCycleAnchor(body, keyParts, p.SubBillingCycleAnchorNow, p.SubBillingCycleAnchorUnchanged)
+}
+
+// AppendTo implements custom encoding logic for InvoiceUpcomingLinesParams.
+// See InvoiceUpcomingParams.AppendTo.
+func (p *InvoiceUpcomingLinesParams) AppendTo(body *form.Values, keyParts []string) {
+	appendSubBillingCycleAnchor(body, keyParts, p.SubBillingCycleAnchorNow, p.SubBillingCycleAnchorUnchanged)
+}
+
+func appendSubBillingCycleAnchor(body *form.Values, keyParts []string, now, unchanged bool) {
+	if now {
+		body.Add(form.FormatKey(append(keyParts, "subscription_billing_cycle_anchor")), "now")
+	}
+
+	if unchanged {
+		body.Add(form.FormatKey(append(keyParts, "subscription_billing_cycle_anchor")), "unchanged")
+	}
+}
+
 // Invoice is the resource representing a Stripe invoice.
 // For more details see https://stripe.com/docs/api#invoice_object.
 type Invoice struct {
+	APIResource
 	Amount        int64             `json:"amount_due"`
 	Attempted     bool              `json:"attempted"`
 	Attempts      uint64            `json:"attempt_count"`
@@ -77,11 +160,12 @@ type Invoice struct {
 	Closed        bool              `json:"closed"`
 	Currency      Currency          `json:"currency"`
 	Customer      *Customer         `json:"customer"`
-	Date          int64             `json:"date"`
+	Date          Timestamp         `json:"date"`
 	Desc          string            `json:"description"`
 	Discount      *Discount         `json:"discount"`
-	DueDate       int64             `json:"due_date"`
-	End           int64             `json:"period_end"`
+	Discounts     []*Discount       `json:"discounts"`
+	DueDate       Timestamp         `json:"due_date"`
+	End           Timestamp         `json:"period_end"`
 	EndBalance    int64             `json:"ending_balance"`
 	Fee           uint64            `json:"application_fee"`
 	Forgive       bool              `json:"forgiven"`
@@ -89,23 +173,25 @@ type Invoice struct {
 	Lines         *InvoiceLineList  `json:"lines"`
 	Live          bool              `json:"livemode"`
 	Meta          map[string]string `json:"metadata"`
-	NextAttempt   int64             `json:"next_payment_attempt"`
+	NextAttempt   Timestamp         `json:"next_payment_attempt"`
 	Number        string            `json:"number"`
 	Paid          bool              `json:"paid"`
 	ReceiptNumber string            `json:"receipt_number"`
-	Start         int64             `json:"period_start"`
+	Start         Timestamp         `json:"period_start"`
 	StartBalance  int64             `json:"starting_balance"`
 	Statement     string            `json:"statement_descriptor"`
-	Sub           string            `json:"subscription"`
+	Status        InvoiceStatus     `json:"status"`
+	Sub           *Sub              `json:"subscription"`
 	Subtotal      int64             `json:"subtotal"`
 	Tax           int64             `json:"tax"`
 	TaxPercent    float64           `json:"tax_percent"`
 	Total         int64             `json:"total"`
-	Webhook       int64             `json:"webhooks_delivered_at"`
+	Webhook       Timestamp         `json:"webhooks_delivered_at"`
 }
 
 // InvoiceList is a list of invoices as retrieved from a list endpoint.
 type InvoiceList struct {
+	APIResource
 	ListMeta
 	Values []*Invoice `json:"data"`
 }
@@ -113,15 +199,18 @@ type InvoiceList struct {
 // InvoiceLine is the resource representing a Stripe invoice line item.
 // For more details see https://stripe.com/docs/api#invoice_line_item_object.
 type InvoiceLine struct {
+	APIResource
 	Amount       int64             `json:"amount"`
 	Currency     Currency          `json:"currency"`
 	Desc         string            `json:"description"`
 	Discountable bool              `json:"discountable"`
+	Discounts    []*Discount       `json:"discounts"`
 	ID           string            `json:"id"`
 	Live         bool              `json:"live_mode"`
 	Meta         map[string]string `json:"metadata"`
 	Period       *Period           `json:"period"`
 	Plan         *Plan             `json:"plan"`
+	Price        *Price            `json:"price"`
 	Proration    bool              `json:"proration"`
 	Quantity     int64             `json:"quantity"`
 	Sub          string            `json:"subscription"`
@@ -130,12 +219,13 @@ type InvoiceLine struct {
 
 // Period is a structure representing a start and end dates.
 type Period struct {
-	End   int64 `json:"end"`
-	Start int64 `json:"start"`
+	End   Timestamp `json:"end"`
+	Start Timestamp `json:"start"`
 }
 
 // InvoiceLineList is a list object for invoice line items.
 type InvoiceLineList struct {
+	APIResource
 	ListMeta
 	Values []*InvoiceLine `json:"data"`
 }
@@ -144,23 +234,61 @@ type InvoiceLineList struct {
 // paying invoices. For more details, see:
 // https://stripe.com/docs/api#pay_invoice.
 type InvoicePayParams struct {
-	Params `form:"*"`
+	Params  `form:"*"`
+	Forgive *bool `form:"forgive"`
+
+	// PaidOutOfBand marks the invoice as paid without charging the customer,
+	// for invoices that were paid outside of Stripe, like by check.
+	PaidOutOfBand *bool `form:"paid_out_of_band"`
+
 	Source string `form:"source"`
 }
 
+// InvoiceFinalizeParams is the set of parameters that can be used when
+// finalizing invoices.
+// For more details see https://stripe.com/docs/api#finalize_invoice.
+type InvoiceFinalizeParams struct {
+	Params      `form:"*"`
+	AutoAdvance *bool `form:"auto_advance"`
+}
+
+// InvoiceMarkUncollectibleParams is the set of parameters that can be used
+// when marking invoices as uncollectible.
+// For more details see https://stripe.com/docs/api#mark_uncollectible_invoice.
+type InvoiceMarkUncollectibleParams struct {
+	Params `form:"*"`
+}
+
+// InvoiceSendParams is the set of parameters that can be used when sending
+// invoices.
+// For more details see https://stripe.com/docs/api#send_invoice.
+type InvoiceSendParams struct {
+	Params `form:"*"`
+}
+
+// InvoiceVoidParams is the set of parameters that can be used when voiding
+// invoices.
+// For more details see https://stripe.com/docs/api#void_invoice.
+type InvoiceVoidParams struct {
+	Params `form:"*"`
+}
+
 // UnmarshalJSON handles deserialization of an Invoice.
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (i *Invoice) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		i.ID = id
+		return nil
+	}
+
 	type invoice Invoice
 	var ii invoice
 	err := json.Unmarshal(data, &ii)
-	if err == nil {
-		*i = Invoice(ii)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		i.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*i = Invoice(ii)
 	return nil
 }
@@ -13,6 +13,14 @@ const (
 	TypeSubscription stripe.InvoiceLineType = "subscription"
 )
 
+const (
+	StatusDraft         stripe.InvoiceStatus = "draft"
+	StatusOpen          stripe.InvoiceStatus = "open"
+	StatusPaid          stripe.InvoiceStatus = "paid"
+	StatusUncollectible stripe.InvoiceStatus = "uncollectible"
+	StatusVoid          stripe.InvoiceStatus = "void"
+)
+
 // Client is the client used to invoke /invoices APIs.
 type Client struct {
 	B   stripe.Backend
@@ -57,7 +65,54 @@ func (c Client) Get(id string, params *stripe.InvoiceParams) (*stripe.Invoice, e
 	return invoice, err
 }
 
-// Pay pays an invoice.
+// FinalizeInvoice finalizes a draft invoice, which can't be edited
+// afterwards, and opens it for payment.
+// For more details see https://stripe.com/docs/api#finalize_invoice.
+func FinalizeInvoice(id string, params *stripe.InvoiceFinalizeParams) (*stripe.Invoice, error) {
+	return getC().FinalizeInvoice(id, params)
+}
+
+func (c Client) FinalizeInvoice(id string, params *stripe.InvoiceFinalizeParams) (*stripe.Invoice, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	invoice := &stripe.Invoice{}
+	err := c.B.Call("POST", fmt.Sprintf("/invoices/%v/finalize", id), c.Key, body, commonParams, invoice)
+
+	return invoice, err
+}
+
+// MarkUncollectible marks an open invoice as uncollectible, for invoices
+// that aren't expected to be paid but should be kept for accounting.
+// For more details see https://stripe.com/docs/api#mark_uncollectible_invoice.
+func MarkUncollectible(id string, params *stripe.InvoiceMarkUncollectibleParams) (*stripe.Invoice, error) {
+	return getC().MarkUncollectible(id, params)
+}
+
+func (c Client) MarkUncollectible(id string, params *stripe.InvoiceMarkUncollectibleParams) (*stripe.Invoice, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	invoice := &stripe.Invoice{}
+	err := c.B.Call("POST", fmt.Sprintf("/invoices/%v/mark_uncollectible", id), c.Key, body, commonParams, invoice)
+
+	return invoice, err
+}
+
+// Pay pays an invoice. Setting PaidOutOfBand marks it as paid without
+// charging the customer.
 // For more details see https://stripe.com/docs/api#pay_invoice.
 func Pay(id string, params *stripe.InvoicePayParams) (*stripe.Invoice, error) {
 	return getC().Pay(id, params)
@@ -79,6 +134,29 @@ func (c Client) Pay(id string, params *stripe.InvoicePayParams) (*stripe.Invoice
 	return invoice, err
 }
 
+// SendInvoice emails an open invoice to the customer, for invoices that
+// are paid by the customer rather than charged automatically.
+// For more details see https://stripe.com/docs/api#send_invoice.
+func SendInvoice(id string, params *stripe.InvoiceSendParams) (*stripe.Invoice, error) {
+	return getC().SendInvoice(id, params)
+}
+
+func (c Client) SendInvoice(id string, params *stripe.InvoiceSendParams) (*stripe.Invoice, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	invoice := &stripe.Invoice{}
+	err := c.B.Call("POST", fmt.Sprintf("/invoices/%v/send", id), c.Key, body, commonParams, invoice)
+
+	return invoice, err
+}
+
 // Update updates an invoice's properties.
 // For more details see https://stripe.com/docs/api#update_invoice.
 func Update(id string, params *stripe.InvoiceParams) (*stripe.Invoice, error) {
@@ -101,7 +179,31 @@ func (c Client) Update(id string, params *stripe.InvoiceParams) (*stripe.Invoice
 	return invoice, err
 }
 
-// GetNext returns the upcoming invoice's properties.
+// VoidInvoice voids an open invoice, for invoices that were issued by
+// mistake. Voided invoices can't be paid anymore.
+// For more details see https://stripe.com/docs/api#void_invoice.
+func VoidInvoice(id string, params *stripe.InvoiceVoidParams) (*stripe.Invoice, error) {
+	return getC().VoidInvoice(id, params)
+}
+
+func (c Client) VoidInvoice(id string, params *stripe.InvoiceVoidParams) (*stripe.Invoice, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	invoice := &stripe.Invoice{}
+	err := c.B.Call("POST", fmt.Sprintf("/invoices/%v/void", id), c.Key, body, commonParams, invoice)
+
+	return invoice, err
+}
+
+// GetNext returns the upcoming invoice's properties. Upcoming takes
+// parameters dedicated to previews and should be preferred.
 // For more details see https://stripe.com/docs/api#retrieve_customer_invoice.
 func GetNext(params *stripe.InvoiceParams) (*stripe.Invoice, error) {
 	return getC().GetNext(params)
@@ -117,6 +219,31 @@ func (c Client) GetNext(params *stripe.InvoiceParams) (*stripe.Invoice, error) {
 	return invoice, err
 }
 
+// Upcoming returns a preview of the next invoice of a customer, which
+// hasn't been created yet. Setting the Sub fields of params previews the
+// invoice that an update of the subscription would lead to, so that its
+// prorations can be shown before making the update.
+// For more details see https://stripe.com/docs/api#upcoming_invoice.
+func Upcoming(params *stripe.InvoiceUpcomingParams) (*stripe.Invoice, error) {
+	return getC().Upcoming(params)
+}
+
+func (c Client) Upcoming(params *stripe.InvoiceUpcomingParams) (*stripe.Invoice, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	invoice := &stripe.Invoice{}
+	err := c.B.Call("GET", "/invoices/upcoming", c.Key, body, commonParams, invoice)
+
+	return invoice, err
+}
+
 // List returns a list of invoices.
 // For more details see https://stripe.com/docs/api#list_customer_invoices.
 func List(params *stripe.InvoiceListParams) *Iter {
@@ -173,6 +300,39 @@ func (c Client) ListLines(params *stripe.InvoiceLineListParams) *LineIter {
 	})}
 }
 
+// UpcomingLines returns a list of the line items of the upcoming invoice of
+// a customer. Unlike the lines embedded in the invoice returned by Upcoming,
+// the iterator goes through all of them.
+// For more details see https://stripe.com/docs/api#upcoming_invoice_lines.
+func UpcomingLines(params *stripe.InvoiceUpcomingLinesParams) *LineIter {
+	return getC().UpcomingLines(params)
+}
+
+func (c Client) UpcomingLines(params *stripe.InvoiceUpcomingLinesParams) *LineIter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &LineIter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.InvoiceLineList{}
+		err := c.B.Call("GET", "/invoices/upcoming/lines", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
 // Iter is an iterator for lists of Invoices.
 // The embedded Iter carries methods with it;
 // see its documentation for details.
@@ -200,5 +360,5 @@ func (i *LineIter) InvoiceLine() *stripe.InvoiceLine {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
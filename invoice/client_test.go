@@ -6,8 +6,18 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
+func TestInvoiceFinalizeInvoice(t *testing.T) {
+	autoAdvance := true
+	invoice, err := FinalizeInvoice("in_123", &stripe.InvoiceFinalizeParams{
+		AutoAdvance: &autoAdvance,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, invoice)
+}
+
 func TestInvoiceGet(t *testing.T) {
 	invoice, err := Get("in_123", nil)
 	assert.Nil(t, err)
@@ -34,6 +44,12 @@ func TestInvoiceListLines(t *testing.T) {
 	assert.NotNil(t, i.InvoiceLine())
 }
 
+func TestInvoiceMarkUncollectible(t *testing.T) {
+	invoice, err := MarkUncollectible("in_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, invoice)
+}
+
 func TestInvoiceNew(t *testing.T) {
 	invoice, err := New(&stripe.InvoiceParams{
 		Customer: "cus_123",
@@ -50,6 +66,81 @@ func TestInvoicePay(t *testing.T) {
 	assert.NotNil(t, invoice)
 }
 
+func TestInvoicePayOutOfBand(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/invoices/in_123/pay", `{"id": "in_123", "paid": true, "status": "paid"}`)
+
+	paidOutOfBand := true
+	c := Client{B: b, Key: "sk_test_123"}
+	invoice, err := c.Pay("in_123", &stripe.InvoicePayParams{
+		PaidOutOfBand: &paidOutOfBand,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, StatusPaid, invoice.Status)
+	assert.Equal(t, "true", b.LastCall().Values().Get("paid_out_of_band"))
+}
+
+func TestInvoiceSendInvoice(t *testing.T) {
+	invoice, err := SendInvoice("in_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, invoice)
+}
+
+func TestInvoiceUpcoming(t *testing.T) {
+	invoice, err := Upcoming(&stripe.InvoiceUpcomingParams{
+		Customer: "cus_123",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, invoice)
+}
+
+func TestInvoiceUpcomingLines(t *testing.T) {
+	i := UpcomingLines(&stripe.InvoiceUpcomingLinesParams{
+		Customer: "cus_123",
+	})
+
+	// Verify that we can get at least one line
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.InvoiceLine())
+}
+
+func TestInvoiceUpcomingProration(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/invoices/upcoming", `{
+		"amount_due": 1500,
+		"customer": "cus_123",
+		"lines": {
+			"object": "list",
+			"data": [{"id": "ii_123", "amount": 1500, "proration": true}]
+		}
+	}`)
+
+	c := Client{B: b, Key: "sk_test_123"}
+	invoice, err := c.Upcoming(&stripe.InvoiceUpcomingParams{
+		Customer: "cus_123",
+		Sub:      "sub_123",
+		SubItems: []*stripe.SubItemsParams{
+			{ID: "si_123", Plan: "gold"},
+		},
+		SubBillingCycleAnchorNow: true,
+		SubProrationBehavior:     "always_invoice",
+		SubProrationDate:         1546300800,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1500), invoice.Amount)
+	assert.True(t, invoice.Lines.Values[0].Proration)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "cus_123", values.Get("customer"))
+	assert.Equal(t, "sub_123", values.Get("subscription"))
+	assert.Equal(t, "si_123", values.Get("subscription_items[0][id]"))
+	assert.Equal(t, "gold", values.Get("subscription_items[0][plan]"))
+	assert.Equal(t, "now", values.Get("subscription_billing_cycle_anchor"))
+	assert.Equal(t, "always_invoice", values.Get("subscription_proration_behavior"))
+	assert.Equal(t, "1546300800", values.Get("subscription_proration_date"))
+}
+
 func TestInvoiceUpdate(t *testing.T) {
 	invoice, err := Update("in_123", &stripe.InvoiceParams{
 		Closed: true,
@@ -57,3 +148,9 @@ func TestInvoiceUpdate(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, invoice)
 }
+
+func TestInvoiceVoidInvoice(t *testing.T) {
+	invoice, err := VoidInvoice("in_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, invoice)
+}
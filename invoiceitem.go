@@ -13,7 +13,10 @@ type InvoiceItemParams struct {
 	Discountable   bool     `form:"discountable"`
 	Invoice        string   `form:"invoice"`
 	NoDiscountable bool     `form:"discountable,invert"`
+	Price          string   `form:"price"`
 	Sub            string   `form:"subscription"`
+	TaxRates       []string `form:"tax_rates"`
+	TaxRatesEmpty  bool     `form:"tax_rates,empty"`
 }
 
 // InvoiceItemListParams is the set of parameters that can be used when listing invoice items.
@@ -28,26 +31,31 @@ type InvoiceItemListParams struct {
 // InvoiceItem is the resource represneting a Stripe invoice item.
 // For more details see https://stripe.com/docs/api#invoiceitems.
 type InvoiceItem struct {
+	APIResource
 	Amount       int64             `json:"amount"`
 	Currency     Currency          `json:"currency"`
 	Customer     *Customer         `json:"customer"`
-	Date         int64             `json:"date"`
+	Date         Timestamp         `json:"date"`
 	Deleted      bool              `json:"deleted"`
 	Desc         string            `json:"description"`
 	Discountable bool              `json:"discountable"`
+	Discounts    []*Discount       `json:"discounts"`
 	ID           string            `json:"id"`
 	Invoice      *Invoice          `json:"invoice"`
 	Live         bool              `json:"livemode"`
 	Meta         map[string]string `json:"metadata"`
 	Period       *Period           `json:"period"`
 	Plan         *Plan             `json:"plan"`
+	Price        *Price            `json:"price"`
 	Proration    bool              `json:"proration"`
 	Quantity     int64             `json:"quantity"`
-	Sub          string            `json:"subscription"`
+	Sub          *Sub              `json:"subscription"`
+	TaxRates     []*TaxRate        `json:"tax_rates"`
 }
 
 // InvoiceItemList is a list of invoice items as retrieved from a list endpoint.
 type InvoiceItemList struct {
+	APIResource
 	ListMeta
 	Values []*InvoiceItem `json:"data"`
 }
@@ -56,15 +64,18 @@ type InvoiceItemList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (i *InvoiceItem) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		i.ID = id
+		return nil
+	}
+
 	type invoiceitem InvoiceItem
 	var ii invoiceitem
 	err := json.Unmarshal(data, &ii)
-	if err == nil {
-		*i = InvoiceItem(ii)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		i.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*i = InvoiceItem(ii)
 	return nil
 }
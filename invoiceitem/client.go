@@ -139,5 +139,5 @@ func (i *Iter) InvoiceItem() *stripe.InvoiceItem {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
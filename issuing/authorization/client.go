@@ -0,0 +1,163 @@
+// Package authorization provides the /issuing/authorizations APIs
+package authorization
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	MethodChip        stripe.IssuingAuthorizationMethod = "chip"
+	MethodContactless stripe.IssuingAuthorizationMethod = "contactless"
+	MethodKeyedIn     stripe.IssuingAuthorizationMethod = "keyed_in"
+	MethodOnline      stripe.IssuingAuthorizationMethod = "online"
+	MethodSwipe       stripe.IssuingAuthorizationMethod = "swipe"
+
+	StatusClosed   stripe.IssuingAuthorizationStatus = "closed"
+	StatusPending  stripe.IssuingAuthorizationStatus = "pending"
+	StatusReversed stripe.IssuingAuthorizationStatus = "reversed"
+)
+
+// Client is used to invoke /issuing/authorizations APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of a authorization.
+// For more details see https://stripe.com/docs/api#retrieve_issuing_authorization.
+func Get(id string, params *stripe.IssuingAuthorizationParams) (*stripe.IssuingAuthorization, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.IssuingAuthorizationParams) (*stripe.IssuingAuthorization, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingAuthorization := &stripe.IssuingAuthorization{}
+	err := c.B.Call("GET", fmt.Sprintf("/issuing/authorizations/%v", id), c.Key, body, commonParams, issuingAuthorization)
+
+	return issuingAuthorization, err
+}
+
+// Update updates a authorization's properties.
+// For more details see https://stripe.com/docs/api#update_issuing_authorization.
+func Update(id string, params *stripe.IssuingAuthorizationParams) (*stripe.IssuingAuthorization, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.IssuingAuthorizationParams) (*stripe.IssuingAuthorization, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingAuthorization := &stripe.IssuingAuthorization{}
+	err := c.B.Call("POST", fmt.Sprintf("/issuing/authorizations/%v", id), c.Key, body, commonParams, issuingAuthorization)
+
+	return issuingAuthorization, err
+}
+
+// Approve approves a pending authorization.
+// For more details see https://stripe.com/docs/api#approve_issuing_authorization.
+func Approve(id string, params *stripe.IssuingAuthorizationApproveParams) (*stripe.IssuingAuthorization, error) {
+	return getC().Approve(id, params)
+}
+
+func (c Client) Approve(id string, params *stripe.IssuingAuthorizationApproveParams) (*stripe.IssuingAuthorization, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingAuthorization := &stripe.IssuingAuthorization{}
+	err := c.B.Call("POST", fmt.Sprintf("/issuing/authorizations/%v/approve", id), c.Key, body, commonParams, issuingAuthorization)
+
+	return issuingAuthorization, err
+}
+
+// Decline declines a pending authorization.
+// For more details see https://stripe.com/docs/api#decline_issuing_authorization.
+func Decline(id string, params *stripe.IssuingAuthorizationDeclineParams) (*stripe.IssuingAuthorization, error) {
+	return getC().Decline(id, params)
+}
+
+func (c Client) Decline(id string, params *stripe.IssuingAuthorizationDeclineParams) (*stripe.IssuingAuthorization, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingAuthorization := &stripe.IssuingAuthorization{}
+	err := c.B.Call("POST", fmt.Sprintf("/issuing/authorizations/%v/decline", id), c.Key, body, commonParams, issuingAuthorization)
+
+	return issuingAuthorization, err
+}
+
+// List returns a list of authorizations.
+// For more details see https://stripe.com/docs/api#list_issuing_authorizations.
+func List(params *stripe.IssuingAuthorizationListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.IssuingAuthorizationListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.IssuingAuthorizationList{}
+		err := c.B.Call("GET", "/issuing/authorizations", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of IssuingAuthorizations.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// IssuingAuthorization returns the most recent IssuingAuthorization
+// visited by a call to Next.
+func (i *Iter) IssuingAuthorization() *stripe.IssuingAuthorization {
+	return i.Current().(*stripe.IssuingAuthorization)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
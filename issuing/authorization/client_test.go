@@ -0,0 +1,47 @@
+package authorization
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestIssuingAuthorizationApprove(t *testing.T) {
+	authorization, err := Approve("iauth_123", &stripe.IssuingAuthorizationApproveParams{
+		Amount: 500,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, authorization)
+}
+
+func TestIssuingAuthorizationDecline(t *testing.T) {
+	authorization, err := Decline("iauth_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, authorization)
+}
+
+func TestIssuingAuthorizationGet(t *testing.T) {
+	authorization, err := Get("iauth_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, authorization)
+}
+
+func TestIssuingAuthorizationList(t *testing.T) {
+	i := List(&stripe.IssuingAuthorizationListParams{Status: StatusPending})
+
+	// Verify that we can get at least one authorization
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.IssuingAuthorization())
+}
+
+func TestIssuingAuthorizationUpdate(t *testing.T) {
+	params := &stripe.IssuingAuthorizationParams{}
+	params.AddMeta("expense_report", "er_123")
+
+	authorization, err := Update("iauth_123", params)
+	assert.Nil(t, err)
+	assert.NotNil(t, authorization)
+}
@@ -0,0 +1,154 @@
+// Package card provides the /issuing/cards APIs
+package card
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	ShippingCarrierDHL   stripe.IssuingCardShippingCarrier = "dhl"
+	ShippingCarrierFedEx stripe.IssuingCardShippingCarrier = "fedex"
+	ShippingCarrierUPS   stripe.IssuingCardShippingCarrier = "ups"
+	ShippingCarrierUSPS  stripe.IssuingCardShippingCarrier = "usps"
+
+	ShippingServiceExpress  stripe.IssuingCardShippingService = "express"
+	ShippingServicePriority stripe.IssuingCardShippingService = "priority"
+	ShippingServiceStandard stripe.IssuingCardShippingService = "standard"
+
+	ShippingStatusCanceled  stripe.IssuingCardShippingStatus = "canceled"
+	ShippingStatusDelivered stripe.IssuingCardShippingStatus = "delivered"
+	ShippingStatusFailure   stripe.IssuingCardShippingStatus = "failure"
+	ShippingStatusPending   stripe.IssuingCardShippingStatus = "pending"
+	ShippingStatusReturned  stripe.IssuingCardShippingStatus = "returned"
+	ShippingStatusShipped   stripe.IssuingCardShippingStatus = "shipped"
+
+	StatusActive   stripe.IssuingCardStatus = "active"
+	StatusCanceled stripe.IssuingCardStatus = "canceled"
+	StatusInactive stripe.IssuingCardStatus = "inactive"
+
+	TypePhysical stripe.IssuingCardType = "physical"
+	TypeVirtual  stripe.IssuingCardType = "virtual"
+)
+
+// Client is used to invoke /issuing/cards APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new card.
+// For more details see https://stripe.com/docs/api#create_issuing_card.
+func New(params *stripe.IssuingCardParams) (*stripe.IssuingCard, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.IssuingCardParams) (*stripe.IssuingCard, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingCard := &stripe.IssuingCard{}
+	err := c.B.Call("POST", "/issuing/cards", c.Key, body, commonParams, issuingCard)
+
+	return issuingCard, err
+}
+
+// Get returns the details of a card.
+// For more details see https://stripe.com/docs/api#retrieve_issuing_card.
+func Get(id string, params *stripe.IssuingCardParams) (*stripe.IssuingCard, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.IssuingCardParams) (*stripe.IssuingCard, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingCard := &stripe.IssuingCard{}
+	err := c.B.Call("GET", fmt.Sprintf("/issuing/cards/%v", id), c.Key, body, commonParams, issuingCard)
+
+	return issuingCard, err
+}
+
+// Update updates a card's properties.
+// For more details see https://stripe.com/docs/api#update_issuing_card.
+func Update(id string, params *stripe.IssuingCardParams) (*stripe.IssuingCard, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.IssuingCardParams) (*stripe.IssuingCard, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingCard := &stripe.IssuingCard{}
+	err := c.B.Call("POST", fmt.Sprintf("/issuing/cards/%v", id), c.Key, body, commonParams, issuingCard)
+
+	return issuingCard, err
+}
+
+// List returns a list of cards.
+// For more details see https://stripe.com/docs/api#list_issuing_cards.
+func List(params *stripe.IssuingCardListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.IssuingCardListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.IssuingCardList{}
+		err := c.B.Call("GET", "/issuing/cards", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of IssuingCards.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// IssuingCard returns the most recent IssuingCard
+// visited by a call to Next.
+func (i *Iter) IssuingCard() *stripe.IssuingCard {
+	return i.Current().(*stripe.IssuingCard)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,53 @@
+package card
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestIssuingCardGet(t *testing.T) {
+	card, err := Get("ic_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, card)
+}
+
+func TestIssuingCardList(t *testing.T) {
+	i := List(&stripe.IssuingCardListParams{Cardholder: "ich_123"})
+
+	// Verify that we can get at least one card
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.IssuingCard())
+}
+
+func TestIssuingCardNew(t *testing.T) {
+	card, err := New(&stripe.IssuingCardParams{
+		Cardholder: "ich_123",
+		Currency:   "usd",
+		Shipping: &stripe.IssuingCardShippingParams{
+			Address: &stripe.AddressParams{
+				City:       "San Francisco",
+				Country:    "US",
+				Line1:      "1234 Main Street",
+				PostalCode: "94111",
+				State:      "CA",
+			},
+			Name:    "Jenny Rosen",
+			Service: ShippingServiceExpress,
+		},
+		Type: TypePhysical,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, card)
+}
+
+func TestIssuingCardUpdate(t *testing.T) {
+	card, err := Update("ic_123", &stripe.IssuingCardParams{
+		Status: StatusCanceled,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, card)
+}
@@ -0,0 +1,145 @@
+// Package cardholder provides the /issuing/cardholders APIs
+package cardholder
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	StatusActive   stripe.IssuingCardholderStatus = "active"
+	StatusBlocked  stripe.IssuingCardholderStatus = "blocked"
+	StatusInactive stripe.IssuingCardholderStatus = "inactive"
+
+	TypeCompany    stripe.IssuingCardholderType = "company"
+	TypeIndividual stripe.IssuingCardholderType = "individual"
+
+	SpendingLimitIntervalAllTime          stripe.IssuingSpendingLimitInterval = "all_time"
+	SpendingLimitIntervalDaily            stripe.IssuingSpendingLimitInterval = "daily"
+	SpendingLimitIntervalMonthly          stripe.IssuingSpendingLimitInterval = "monthly"
+	SpendingLimitIntervalPerAuthorization stripe.IssuingSpendingLimitInterval = "per_authorization"
+	SpendingLimitIntervalWeekly           stripe.IssuingSpendingLimitInterval = "weekly"
+	SpendingLimitIntervalYearly           stripe.IssuingSpendingLimitInterval = "yearly"
+)
+
+// Client is used to invoke /issuing/cardholders APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new cardholder.
+// For more details see https://stripe.com/docs/api#create_issuing_cardholder.
+func New(params *stripe.IssuingCardholderParams) (*stripe.IssuingCardholder, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.IssuingCardholderParams) (*stripe.IssuingCardholder, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingCardholder := &stripe.IssuingCardholder{}
+	err := c.B.Call("POST", "/issuing/cardholders", c.Key, body, commonParams, issuingCardholder)
+
+	return issuingCardholder, err
+}
+
+// Get returns the details of a cardholder.
+// For more details see https://stripe.com/docs/api#retrieve_issuing_cardholder.
+func Get(id string, params *stripe.IssuingCardholderParams) (*stripe.IssuingCardholder, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.IssuingCardholderParams) (*stripe.IssuingCardholder, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingCardholder := &stripe.IssuingCardholder{}
+	err := c.B.Call("GET", fmt.Sprintf("/issuing/cardholders/%v", id), c.Key, body, commonParams, issuingCardholder)
+
+	return issuingCardholder, err
+}
+
+// Update updates a cardholder's properties.
+// For more details see https://stripe.com/docs/api#update_issuing_cardholder.
+func Update(id string, params *stripe.IssuingCardholderParams) (*stripe.IssuingCardholder, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.IssuingCardholderParams) (*stripe.IssuingCardholder, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingCardholder := &stripe.IssuingCardholder{}
+	err := c.B.Call("POST", fmt.Sprintf("/issuing/cardholders/%v", id), c.Key, body, commonParams, issuingCardholder)
+
+	return issuingCardholder, err
+}
+
+// List returns a list of cardholders.
+// For more details see https://stripe.com/docs/api#list_issuing_cardholders.
+func List(params *stripe.IssuingCardholderListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.IssuingCardholderListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.IssuingCardholderList{}
+		err := c.B.Call("GET", "/issuing/cardholders", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of IssuingCardholders.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// IssuingCardholder returns the most recent IssuingCardholder
+// visited by a call to Next.
+func (i *Iter) IssuingCardholder() *stripe.IssuingCardholder {
+	return i.Current().(*stripe.IssuingCardholder)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
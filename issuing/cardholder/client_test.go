@@ -0,0 +1,55 @@
+package cardholder
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestIssuingCardholderGet(t *testing.T) {
+	cardholder, err := Get("ich_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, cardholder)
+}
+
+func TestIssuingCardholderList(t *testing.T) {
+	i := List(&stripe.IssuingCardholderListParams{Status: StatusActive})
+
+	// Verify that we can get at least one cardholder
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.IssuingCardholder())
+}
+
+func TestIssuingCardholderNew(t *testing.T) {
+	cardholder, err := New(&stripe.IssuingCardholderParams{
+		Billing: &stripe.IssuingBillingParams{
+			Address: &stripe.AddressParams{
+				City:       "San Francisco",
+				Country:    "US",
+				Line1:      "1234 Main Street",
+				PostalCode: "94111",
+				State:      "CA",
+			},
+		},
+		Name: "Jenny Rosen",
+		SpendingControls: &stripe.IssuingSpendingControlsParams{
+			SpendingLimits: []*stripe.IssuingSpendingLimitParams{
+				{Amount: 50000, Interval: SpendingLimitIntervalMonthly},
+			},
+		},
+		Type: TypeIndividual,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, cardholder)
+}
+
+func TestIssuingCardholderUpdate(t *testing.T) {
+	cardholder, err := Update("ich_123", &stripe.IssuingCardholderParams{
+		Status: StatusInactive,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, cardholder)
+}
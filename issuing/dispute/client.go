@@ -0,0 +1,168 @@
+// Package dispute provides the /issuing/disputes APIs
+package dispute
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	ReasonCanceled                  stripe.IssuingDisputeReason = "canceled"
+	ReasonDuplicate                 stripe.IssuingDisputeReason = "duplicate"
+	ReasonFraudulent                stripe.IssuingDisputeReason = "fraudulent"
+	ReasonMerchandiseNotAsDescribed stripe.IssuingDisputeReason = "merchandise_not_as_described"
+	ReasonNotReceived               stripe.IssuingDisputeReason = "not_received"
+	ReasonOther                     stripe.IssuingDisputeReason = "other"
+	ReasonServiceNotAsDescribed     stripe.IssuingDisputeReason = "service_not_as_described"
+
+	StatusExpired     stripe.IssuingDisputeStatus = "expired"
+	StatusLost        stripe.IssuingDisputeStatus = "lost"
+	StatusSubmitted   stripe.IssuingDisputeStatus = "submitted"
+	StatusUnsubmitted stripe.IssuingDisputeStatus = "unsubmitted"
+	StatusWon         stripe.IssuingDisputeStatus = "won"
+)
+
+// Client is used to invoke /issuing/disputes APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new dispute.
+// For more details see https://stripe.com/docs/api#create_issuing_dispute.
+func New(params *stripe.IssuingDisputeParams) (*stripe.IssuingDispute, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.IssuingDisputeParams) (*stripe.IssuingDispute, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingDispute := &stripe.IssuingDispute{}
+	err := c.B.Call("POST", "/issuing/disputes", c.Key, body, commonParams, issuingDispute)
+
+	return issuingDispute, err
+}
+
+// Get returns the details of a dispute.
+// For more details see https://stripe.com/docs/api#retrieve_issuing_dispute.
+func Get(id string, params *stripe.IssuingDisputeParams) (*stripe.IssuingDispute, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.IssuingDisputeParams) (*stripe.IssuingDispute, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingDispute := &stripe.IssuingDispute{}
+	err := c.B.Call("GET", fmt.Sprintf("/issuing/disputes/%v", id), c.Key, body, commonParams, issuingDispute)
+
+	return issuingDispute, err
+}
+
+// Update updates a dispute's properties.
+// For more details see https://stripe.com/docs/api#update_issuing_dispute.
+func Update(id string, params *stripe.IssuingDisputeParams) (*stripe.IssuingDispute, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.IssuingDisputeParams) (*stripe.IssuingDispute, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingDispute := &stripe.IssuingDispute{}
+	err := c.B.Call("POST", fmt.Sprintf("/issuing/disputes/%v", id), c.Key, body, commonParams, issuingDispute)
+
+	return issuingDispute, err
+}
+
+// Submit submits a dispute to the card network. Its evidence can't be
+// changed afterwards.
+// For more details see https://stripe.com/docs/api#submit_issuing_dispute.
+func Submit(id string, params *stripe.IssuingDisputeSubmitParams) (*stripe.IssuingDispute, error) {
+	return getC().Submit(id, params)
+}
+
+func (c Client) Submit(id string, params *stripe.IssuingDisputeSubmitParams) (*stripe.IssuingDispute, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingDispute := &stripe.IssuingDispute{}
+	err := c.B.Call("POST", fmt.Sprintf("/issuing/disputes/%v/submit", id), c.Key, body, commonParams, issuingDispute)
+
+	return issuingDispute, err
+}
+
+// List returns a list of disputes.
+// For more details see https://stripe.com/docs/api#list_issuing_disputes.
+func List(params *stripe.IssuingDisputeListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.IssuingDisputeListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.IssuingDisputeList{}
+		err := c.B.Call("GET", "/issuing/disputes", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of IssuingDisputes.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// IssuingDispute returns the most recent IssuingDispute
+// visited by a call to Next.
+func (i *Iter) IssuingDispute() *stripe.IssuingDispute {
+	return i.Current().(*stripe.IssuingDispute)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,57 @@
+package dispute
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestIssuingDisputeGet(t *testing.T) {
+	dispute, err := Get("idp_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, dispute)
+}
+
+func TestIssuingDisputeList(t *testing.T) {
+	i := List(&stripe.IssuingDisputeListParams{Transaction: "ipi_123"})
+
+	// Verify that we can get at least one dispute
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.IssuingDispute())
+}
+
+func TestIssuingDisputeNew(t *testing.T) {
+	dispute, err := New(&stripe.IssuingDisputeParams{
+		Evidence: &stripe.IssuingDisputeEvidenceParams{
+			Fraudulent: &stripe.IssuingDisputeEvidenceReasonParams{
+				Explanation: "The cardholder didn't make this purchase.",
+			},
+			Reason: ReasonFraudulent,
+		},
+		Transaction: "ipi_123",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, dispute)
+}
+
+func TestIssuingDisputeSubmit(t *testing.T) {
+	dispute, err := Submit("idp_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, dispute)
+}
+
+func TestIssuingDisputeUpdate(t *testing.T) {
+	dispute, err := Update("idp_123", &stripe.IssuingDisputeParams{
+		Evidence: &stripe.IssuingDisputeEvidenceParams{
+			Other: &stripe.IssuingDisputeEvidenceReasonParams{
+				Explanation: "The merchant charged the wrong amount.",
+			},
+			Reason: ReasonOther,
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, dispute)
+}
@@ -0,0 +1,112 @@
+// Package transaction provides the /issuing/transactions APIs
+package transaction
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	TypeCapture stripe.IssuingTransactionType = "capture"
+	TypeRefund  stripe.IssuingTransactionType = "refund"
+)
+
+// Client is used to invoke /issuing/transactions APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of a transaction.
+// For more details see https://stripe.com/docs/api#retrieve_issuing_transaction.
+func Get(id string, params *stripe.IssuingTransactionParams) (*stripe.IssuingTransaction, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.IssuingTransactionParams) (*stripe.IssuingTransaction, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingTransaction := &stripe.IssuingTransaction{}
+	err := c.B.Call("GET", fmt.Sprintf("/issuing/transactions/%v", id), c.Key, body, commonParams, issuingTransaction)
+
+	return issuingTransaction, err
+}
+
+// Update updates a transaction's properties.
+// For more details see https://stripe.com/docs/api#update_issuing_transaction.
+func Update(id string, params *stripe.IssuingTransactionParams) (*stripe.IssuingTransaction, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.IssuingTransactionParams) (*stripe.IssuingTransaction, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingTransaction := &stripe.IssuingTransaction{}
+	err := c.B.Call("POST", fmt.Sprintf("/issuing/transactions/%v", id), c.Key, body, commonParams, issuingTransaction)
+
+	return issuingTransaction, err
+}
+
+// List returns a list of transactions.
+// For more details see https://stripe.com/docs/api#list_issuing_transactions.
+func List(params *stripe.IssuingTransactionListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.IssuingTransactionListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.IssuingTransactionList{}
+		err := c.B.Call("GET", "/issuing/transactions", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of IssuingTransactions.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// IssuingTransaction returns the most recent IssuingTransaction
+// visited by a call to Next.
+func (i *Iter) IssuingTransaction() *stripe.IssuingTransaction {
+	return i.Current().(*stripe.IssuingTransaction)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
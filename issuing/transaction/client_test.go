@@ -0,0 +1,33 @@
+package transaction
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestIssuingTransactionGet(t *testing.T) {
+	transaction, err := Get("ipi_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, transaction)
+}
+
+func TestIssuingTransactionList(t *testing.T) {
+	i := List(&stripe.IssuingTransactionListParams{Card: "ic_123"})
+
+	// Verify that we can get at least one transaction
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.IssuingTransaction())
+}
+
+func TestIssuingTransactionUpdate(t *testing.T) {
+	params := &stripe.IssuingTransactionParams{}
+	params.AddMeta("expense_report", "er_123")
+
+	transaction, err := Update("ipi_123", params)
+	assert.Nil(t, err)
+	assert.NotNil(t, transaction)
+}
@@ -0,0 +1,139 @@
+package stripe
+
+import "encoding/json"
+
+// IssuingAuthorizationMethod is the list of possible values for how the card
+// details were provided for an issuing authorization. Possible values are
+// "chip", "contactless", "keyed_in", "online", and "swipe".
+type IssuingAuthorizationMethod string
+
+// IssuingAuthorizationReason is the list of possible values for why an
+// issuing authorization request was approved or declined.
+type IssuingAuthorizationReason string
+
+// IssuingAuthorizationStatus is the list of possible values for the status
+// of an issuing authorization. Possible values are "closed", "pending", and
+// "reversed".
+type IssuingAuthorizationStatus string
+
+// IssuingAuthorizationParams is the set of parameters that can be used when
+// retrieving or updating an issuing authorization.
+// For more details see https://stripe.com/docs/api#retrieve_issuing_authorization.
+type IssuingAuthorizationParams struct {
+	Params `form:"*"`
+}
+
+// IssuingAuthorizationApproveParams is the set of parameters that can be
+// used when approving an issuing authorization. Amount may be set to approve
+// less than the requested amount.
+// For more details see https://stripe.com/docs/api#approve_issuing_authorization.
+type IssuingAuthorizationApproveParams struct {
+	Params `form:"*"`
+	Amount int64 `form:"amount"`
+}
+
+// IssuingAuthorizationDeclineParams is the set of parameters that can be
+// used when declining an issuing authorization.
+// For more details see https://stripe.com/docs/api#decline_issuing_authorization.
+type IssuingAuthorizationDeclineParams struct {
+	Params `form:"*"`
+}
+
+// IssuingAuthorizationListParams is the set of parameters that can be used
+// when listing issuing authorizations.
+// For more details see https://stripe.com/docs/api#list_issuing_authorizations.
+type IssuingAuthorizationListParams struct {
+	ListParams   `form:"*"`
+	Card         string                     `form:"card"`
+	Cardholder   string                     `form:"cardholder"`
+	Created      int64                      `form:"created"`
+	CreatedRange *RangeQueryParams          `form:"created"`
+	Status       IssuingAuthorizationStatus `form:"status"`
+}
+
+// IssuingMerchantData represents the merchant that a card was used with.
+type IssuingMerchantData struct {
+	Category   string `json:"category"`
+	City       string `json:"city"`
+	Country    string `json:"country"`
+	Name       string `json:"name"`
+	NetworkID  string `json:"network_id"`
+	PostalCode string `json:"postal_code"`
+	State      string `json:"state"`
+}
+
+// IssuingAuthorizationPendingRequest represents the request that's waiting
+// for the authorization to be approved or declined.
+type IssuingAuthorizationPendingRequest struct {
+	Amount               int64    `json:"amount"`
+	Currency             Currency `json:"currency"`
+	IsAmountControllable bool     `json:"is_amount_controllable"`
+	MerchantAmount       int64    `json:"merchant_amount"`
+	MerchantCurrency     Currency `json:"merchant_currency"`
+}
+
+// IssuingAuthorizationRequest represents a past request of an authorization
+// and how it was decided.
+type IssuingAuthorizationRequest struct {
+	Amount           int64                      `json:"amount"`
+	Approved         bool                       `json:"approved"`
+	Created          Timestamp                  `json:"created"`
+	Currency         Currency                   `json:"currency"`
+	MerchantAmount   int64                      `json:"merchant_amount"`
+	MerchantCurrency Currency                   `json:"merchant_currency"`
+	Reason           IssuingAuthorizationReason `json:"reason"`
+}
+
+// IssuingAuthorization is the resource representing a Stripe issuing
+// authorization, which is created whenever an issuing card is used.
+// For more details see https://stripe.com/docs/api#issuing_authorizations.
+type IssuingAuthorization struct {
+	APIResource
+	Amount              int64                               `json:"amount"`
+	Approved            bool                                `json:"approved"`
+	AuthorizationMethod IssuingAuthorizationMethod          `json:"authorization_method"`
+	BalanceTransactions []*Transaction                      `json:"balance_transactions"`
+	Card                *IssuingCard                        `json:"card"`
+	Cardholder          *IssuingCardholder                  `json:"cardholder"`
+	Created             Timestamp                           `json:"created"`
+	Currency            Currency                            `json:"currency"`
+	ID                  string                              `json:"id"`
+	Live                bool                                `json:"livemode"`
+	MerchantAmount      int64                               `json:"merchant_amount"`
+	MerchantCurrency    Currency                            `json:"merchant_currency"`
+	MerchantData        *IssuingMerchantData                `json:"merchant_data"`
+	Meta                map[string]string                   `json:"metadata"`
+	PendingRequest      *IssuingAuthorizationPendingRequest `json:"pending_request"`
+	RequestHistory      []*IssuingAuthorizationRequest      `json:"request_history"`
+	Status              IssuingAuthorizationStatus          `json:"status"`
+	Transactions        []*IssuingTransaction               `json:"transactions"`
+	Wallet              string                              `json:"wallet"`
+}
+
+// IssuingAuthorizationList is a list of issuing authorizations as retrieved
+// from a list endpoint.
+type IssuingAuthorizationList struct {
+	APIResource
+	ListMeta
+	Values []*IssuingAuthorization `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of an IssuingAuthorization.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (i *IssuingAuthorization) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		i.ID = id
+		return nil
+	}
+
+	type issuingAuthorization IssuingAuthorization
+	var ii issuingAuthorization
+	err := json.Unmarshal(data, &ii)
+	if err != nil {
+		return err
+	}
+
+	*i = IssuingAuthorization(ii)
+	return nil
+}
@@ -0,0 +1,126 @@
+package stripe
+
+import "encoding/json"
+
+// IssuingCardShippingCarrier is the list of possible values for the carrier
+// shipping an issuing card. Possible values are "dhl", "fedex", "ups", and
+// "usps".
+type IssuingCardShippingCarrier string
+
+// IssuingCardShippingService is the list of allowed values for the shipping
+// service of an issuing card. Allowed values are "express", "priority", and
+// "standard".
+type IssuingCardShippingService string
+
+// IssuingCardShippingStatus is the list of possible values for the shipping
+// status of an issuing card. Possible values are "canceled", "delivered",
+// "failure", "pending", "returned", and "shipped".
+type IssuingCardShippingStatus string
+
+// IssuingCardStatus is the list of allowed values for the status of an
+// issuing card. Allowed values are "active", "canceled", and "inactive".
+type IssuingCardStatus string
+
+// IssuingCardType is the list of allowed values for the type of an issuing
+// card. Allowed values are "physical" and "virtual".
+type IssuingCardType string
+
+// IssuingCardShippingParams is the set of parameters for where and how a
+// physical issuing card is shipped.
+type IssuingCardShippingParams struct {
+	Address *AddressParams             `form:"address"`
+	Name    string                     `form:"name"`
+	Service IssuingCardShippingService `form:"service"`
+}
+
+// IssuingCardParams is the set of parameters that can be used when creating
+// or updating an issuing card.
+// For more details see https://stripe.com/docs/api#create_issuing_card and https://stripe.com/docs/api#update_issuing_card.
+type IssuingCardParams struct {
+	Params           `form:"*"`
+	Cardholder       string                         `form:"cardholder"`
+	Currency         Currency                       `form:"currency"`
+	ReplacementFor   string                         `form:"replacement_for"`
+	Shipping         *IssuingCardShippingParams     `form:"shipping"`
+	SpendingControls *IssuingSpendingControlsParams `form:"spending_controls"`
+	Status           IssuingCardStatus              `form:"status"`
+	Type             IssuingCardType                `form:"type"`
+}
+
+// IssuingCardListParams is the set of parameters that can be used when
+// listing issuing cards.
+// For more details see https://stripe.com/docs/api#list_issuing_cards.
+type IssuingCardListParams struct {
+	ListParams   `form:"*"`
+	Cardholder   string            `form:"cardholder"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Last4        string            `form:"last4"`
+	Month        uint8             `form:"exp_month"`
+	Status       IssuingCardStatus `form:"status"`
+	Type         IssuingCardType   `form:"type"`
+	Year         uint16            `form:"exp_year"`
+}
+
+// IssuingCardShipping represents the shipment of a physical issuing card.
+// ETA is the estimated delivery time, and the tracking fields are set once
+// the card has been shipped.
+type IssuingCardShipping struct {
+	Address        *Address                   `json:"address"`
+	Carrier        IssuingCardShippingCarrier `json:"carrier"`
+	ETA            Timestamp                  `json:"eta"`
+	Name           string                     `json:"name"`
+	Service        IssuingCardShippingService `json:"service"`
+	Status         IssuingCardShippingStatus  `json:"status"`
+	TrackingNumber string                     `json:"tracking_number"`
+	TrackingURL    string                     `json:"tracking_url"`
+}
+
+// IssuingCard is the resource representing a Stripe issuing card.
+// For more details see https://stripe.com/docs/api#issuing_cards.
+type IssuingCard struct {
+	APIResource
+	Brand            string                   `json:"brand"`
+	Cardholder       *IssuingCardholder       `json:"cardholder"`
+	Created          Timestamp                `json:"created"`
+	Currency         Currency                 `json:"currency"`
+	ID               string                   `json:"id"`
+	Last4            string                   `json:"last4"`
+	Live             bool                     `json:"livemode"`
+	Meta             map[string]string        `json:"metadata"`
+	Month            uint8                    `json:"exp_month"`
+	ReplacementFor   *IssuingCard             `json:"replacement_for"`
+	Shipping         *IssuingCardShipping     `json:"shipping"`
+	SpendingControls *IssuingSpendingControls `json:"spending_controls"`
+	Status           IssuingCardStatus        `json:"status"`
+	Type             IssuingCardType          `json:"type"`
+	Year             uint16                   `json:"exp_year"`
+}
+
+// IssuingCardList is a list of issuing cards as retrieved from a list
+// endpoint.
+type IssuingCardList struct {
+	APIResource
+	ListMeta
+	Values []*IssuingCard `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of an IssuingCard.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (i *IssuingCard) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		i.ID = id
+		return nil
+	}
+
+	type issuingCard IssuingCard
+	var ii issuingCard
+	err := json.Unmarshal(data, &ii)
+	if err != nil {
+		return err
+	}
+
+	*i = IssuingCard(ii)
+	return nil
+}
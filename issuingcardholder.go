@@ -0,0 +1,136 @@
+package stripe
+
+import "encoding/json"
+
+// IssuingCardholderStatus is the list of allowed values for the status of an
+// issuing cardholder. Allowed values are "active", "blocked", and "inactive".
+type IssuingCardholderStatus string
+
+// IssuingCardholderType is the list of allowed values for the type of an
+// issuing cardholder. Allowed values are "company" and "individual".
+type IssuingCardholderType string
+
+// IssuingSpendingLimitInterval is the list of allowed values for the interval
+// of an issuing spending limit. Allowed values are "all_time", "daily",
+// "monthly", "per_authorization", "weekly", and "yearly".
+type IssuingSpendingLimitInterval string
+
+// IssuingBillingParams is the set of parameters for the billing details of
+// an issuing cardholder.
+type IssuingBillingParams struct {
+	Address *AddressParams `form:"address"`
+}
+
+// IssuingSpendingLimitParams is the set of parameters for a single spending
+// limit of an issuing card or cardholder. Amount is in the smallest currency
+// unit.
+type IssuingSpendingLimitParams struct {
+	Amount     uint64                       `form:"amount"`
+	Categories []string                     `form:"categories"`
+	Interval   IssuingSpendingLimitInterval `form:"interval"`
+}
+
+// IssuingSpendingControlsParams is the set of parameters for the spending
+// controls of an issuing card or cardholder. Categories are merchant category
+// names, like "taxicabs_limousines".
+type IssuingSpendingControlsParams struct {
+	AllowedCategories      []string                      `form:"allowed_categories"`
+	BlockedCategories      []string                      `form:"blocked_categories"`
+	SpendingLimits         []*IssuingSpendingLimitParams `form:"spending_limits,indexed"`
+	SpendingLimitsCurrency Currency                      `form:"spending_limits_currency"`
+}
+
+// IssuingCardholderParams is the set of parameters that can be used when
+// creating or updating an issuing cardholder.
+// For more details see https://stripe.com/docs/api#create_issuing_cardholder and https://stripe.com/docs/api#update_issuing_cardholder.
+type IssuingCardholderParams struct {
+	Params           `form:"*"`
+	Billing          *IssuingBillingParams          `form:"billing"`
+	Email            string                         `form:"email"`
+	Name             string                         `form:"name"`
+	PhoneNumber      string                         `form:"phone_number"`
+	SpendingControls *IssuingSpendingControlsParams `form:"spending_controls"`
+	Status           IssuingCardholderStatus        `form:"status"`
+	Type             IssuingCardholderType          `form:"type"`
+}
+
+// IssuingCardholderListParams is the set of parameters that can be used when
+// listing issuing cardholders.
+// For more details see https://stripe.com/docs/api#list_issuing_cardholders.
+type IssuingCardholderListParams struct {
+	ListParams   `form:"*"`
+	Created      int64                   `form:"created"`
+	CreatedRange *RangeQueryParams       `form:"created"`
+	Email        string                  `form:"email"`
+	PhoneNumber  string                  `form:"phone_number"`
+	Status       IssuingCardholderStatus `form:"status"`
+	Type         IssuingCardholderType   `form:"type"`
+}
+
+// IssuingBilling represents the billing details of an issuing cardholder.
+type IssuingBilling struct {
+	Address *Address `json:"address"`
+}
+
+// IssuingSpendingLimit represents a single spending limit of an issuing card
+// or cardholder.
+type IssuingSpendingLimit struct {
+	Amount     uint64                       `json:"amount"`
+	Categories []string                     `json:"categories"`
+	Interval   IssuingSpendingLimitInterval `json:"interval"`
+}
+
+// IssuingSpendingControls represents the spending controls of an issuing
+// card or cardholder.
+type IssuingSpendingControls struct {
+	AllowedCategories      []string                `json:"allowed_categories"`
+	BlockedCategories      []string                `json:"blocked_categories"`
+	SpendingLimits         []*IssuingSpendingLimit `json:"spending_limits"`
+	SpendingLimitsCurrency Currency                `json:"spending_limits_currency"`
+}
+
+// IssuingCardholder is the resource representing a Stripe issuing
+// cardholder.
+// For more details see https://stripe.com/docs/api#issuing_cardholders.
+type IssuingCardholder struct {
+	APIResource
+	Billing          *IssuingBilling          `json:"billing"`
+	Created          Timestamp                `json:"created"`
+	Email            string                   `json:"email"`
+	ID               string                   `json:"id"`
+	Live             bool                     `json:"livemode"`
+	Meta             map[string]string        `json:"metadata"`
+	Name             string                   `json:"name"`
+	PhoneNumber      string                   `json:"phone_number"`
+	SpendingControls *IssuingSpendingControls `json:"spending_controls"`
+	Status           IssuingCardholderStatus  `json:"status"`
+	Type             IssuingCardholderType    `json:"type"`
+}
+
+// IssuingCardholderList is a list of issuing cardholders as retrieved from a
+// list endpoint.
+type IssuingCardholderList struct {
+	APIResource
+	ListMeta
+	Values []*IssuingCardholder `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of an IssuingCardholder.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (i *IssuingCardholder) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		i.ID = id
+		return nil
+	}
+
+	type issuingCardholder IssuingCardholder
+	var ii issuingCardholder
+	err := json.Unmarshal(data, &ii)
+	if err != nil {
+		return err
+	}
+
+	*i = IssuingCardholder(ii)
+	return nil
+}
@@ -0,0 +1,126 @@
+package stripe
+
+import "encoding/json"
+
+// IssuingDisputeReason is the list of allowed values for the reason of an
+// issuing dispute. Allowed values are "canceled", "duplicate", "fraudulent",
+// "merchandise_not_as_described", "not_received", "other", and
+// "service_not_as_described".
+type IssuingDisputeReason string
+
+// IssuingDisputeStatus is the list of possible values for the status of an
+// issuing dispute. Possible values are "expired", "lost", "submitted",
+// "unsubmitted", and "won".
+type IssuingDisputeStatus string
+
+// IssuingDisputeEvidenceReasonParams is the set of parameters for the
+// evidence supporting a specific dispute reason. AdditionalDocumentation is
+// the ID of an uploaded file.
+type IssuingDisputeEvidenceReasonParams struct {
+	AdditionalDocumentation string `form:"additional_documentation"`
+	Explanation             string `form:"explanation"`
+}
+
+// IssuingDisputeEvidenceParams is the set of parameters for the evidence of
+// an issuing dispute. Only the field matching Reason should be set.
+type IssuingDisputeEvidenceParams struct {
+	Canceled                  *IssuingDisputeEvidenceReasonParams `form:"canceled"`
+	Duplicate                 *IssuingDisputeEvidenceReasonParams `form:"duplicate"`
+	Fraudulent                *IssuingDisputeEvidenceReasonParams `form:"fraudulent"`
+	MerchandiseNotAsDescribed *IssuingDisputeEvidenceReasonParams `form:"merchandise_not_as_described"`
+	NotReceived               *IssuingDisputeEvidenceReasonParams `form:"not_received"`
+	Other                     *IssuingDisputeEvidenceReasonParams `form:"other"`
+	Reason                    IssuingDisputeReason                `form:"reason"`
+	ServiceNotAsDescribed     *IssuingDisputeEvidenceReasonParams `form:"service_not_as_described"`
+}
+
+// IssuingDisputeParams is the set of parameters that can be used when
+// creating or updating an issuing dispute.
+// For more details see https://stripe.com/docs/api#create_issuing_dispute.
+type IssuingDisputeParams struct {
+	Params      `form:"*"`
+	Evidence    *IssuingDisputeEvidenceParams `form:"evidence"`
+	Transaction string                        `form:"transaction"`
+}
+
+// IssuingDisputeSubmitParams is the set of parameters that can be used when
+// submitting an issuing dispute.
+// For more details see https://stripe.com/docs/api#submit_issuing_dispute.
+type IssuingDisputeSubmitParams struct {
+	Params `form:"*"`
+}
+
+// IssuingDisputeListParams is the set of parameters that can be used when
+// listing issuing disputes.
+// For more details see https://stripe.com/docs/api#list_issuing_disputes.
+type IssuingDisputeListParams struct {
+	ListParams   `form:"*"`
+	Created      int64                `form:"created"`
+	CreatedRange *RangeQueryParams    `form:"created"`
+	Status       IssuingDisputeStatus `form:"status"`
+	Transaction  string               `form:"transaction"`
+}
+
+// IssuingDisputeEvidenceReason represents the evidence supporting a specific
+// dispute reason.
+type IssuingDisputeEvidenceReason struct {
+	AdditionalDocumentation *File  `json:"additional_documentation"`
+	Explanation             string `json:"explanation"`
+}
+
+// IssuingDisputeEvidence represents the evidence of an issuing dispute.
+type IssuingDisputeEvidence struct {
+	Canceled                  *IssuingDisputeEvidenceReason `json:"canceled"`
+	Duplicate                 *IssuingDisputeEvidenceReason `json:"duplicate"`
+	Fraudulent                *IssuingDisputeEvidenceReason `json:"fraudulent"`
+	MerchandiseNotAsDescribed *IssuingDisputeEvidenceReason `json:"merchandise_not_as_described"`
+	NotReceived               *IssuingDisputeEvidenceReason `json:"not_received"`
+	Other                     *IssuingDisputeEvidenceReason `json:"other"`
+	Reason                    IssuingDisputeReason          `json:"reason"`
+	ServiceNotAsDescribed     *IssuingDisputeEvidenceReason `json:"service_not_as_described"`
+}
+
+// IssuingDispute is the resource representing a Stripe issuing dispute,
+// which is raised with the merchant to recover the funds of a transaction.
+// For more details see https://stripe.com/docs/api#issuing_disputes.
+type IssuingDispute struct {
+	APIResource
+	Amount              int64                   `json:"amount"`
+	BalanceTransactions []*Transaction          `json:"balance_transactions"`
+	Created             Timestamp               `json:"created"`
+	Currency            Currency                `json:"currency"`
+	Evidence            *IssuingDisputeEvidence `json:"evidence"`
+	ID                  string                  `json:"id"`
+	Live                bool                    `json:"livemode"`
+	Meta                map[string]string       `json:"metadata"`
+	Status              IssuingDisputeStatus    `json:"status"`
+	Transaction         *IssuingTransaction     `json:"transaction"`
+}
+
+// IssuingDisputeList is a list of issuing disputes as retrieved from a list
+// endpoint.
+type IssuingDisputeList struct {
+	APIResource
+	ListMeta
+	Values []*IssuingDispute `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of an IssuingDispute.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (i *IssuingDispute) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		i.ID = id
+		return nil
+	}
+
+	type issuingDispute IssuingDispute
+	var ii issuingDispute
+	err := json.Unmarshal(data, &ii)
+	if err != nil {
+		return err
+	}
+
+	*i = IssuingDispute(ii)
+	return nil
+}
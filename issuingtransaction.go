@@ -0,0 +1,77 @@
+package stripe
+
+import "encoding/json"
+
+// IssuingTransactionType is the list of possible values for the type of an
+// issuing transaction. Possible values are "capture" and "refund".
+type IssuingTransactionType string
+
+// IssuingTransactionParams is the set of parameters that can be used when
+// retrieving or updating an issuing transaction.
+// For more details see https://stripe.com/docs/api#update_issuing_transaction.
+type IssuingTransactionParams struct {
+	Params `form:"*"`
+}
+
+// IssuingTransactionListParams is the set of parameters that can be used
+// when listing issuing transactions.
+// For more details see https://stripe.com/docs/api#list_issuing_transactions.
+type IssuingTransactionListParams struct {
+	ListParams   `form:"*"`
+	Card         string                 `form:"card"`
+	Cardholder   string                 `form:"cardholder"`
+	Created      int64                  `form:"created"`
+	CreatedRange *RangeQueryParams      `form:"created"`
+	Type         IssuingTransactionType `form:"type"`
+}
+
+// IssuingTransaction is the resource representing a Stripe issuing
+// transaction, which moves funds in or out of the balance when an issuing
+// authorization is captured or refunded.
+// For more details see https://stripe.com/docs/api#issuing_transactions.
+type IssuingTransaction struct {
+	APIResource
+	Amount           int64                  `json:"amount"`
+	Authorization    *IssuingAuthorization  `json:"authorization"`
+	Card             *IssuingCard           `json:"card"`
+	Cardholder       *IssuingCardholder     `json:"cardholder"`
+	Created          Timestamp              `json:"created"`
+	Currency         Currency               `json:"currency"`
+	Dispute          *IssuingDispute        `json:"dispute"`
+	ID               string                 `json:"id"`
+	Live             bool                   `json:"livemode"`
+	MerchantAmount   int64                  `json:"merchant_amount"`
+	MerchantCurrency Currency               `json:"merchant_currency"`
+	MerchantData     *IssuingMerchantData   `json:"merchant_data"`
+	Meta             map[string]string      `json:"metadata"`
+	Tx               *Transaction           `json:"balance_transaction"`
+	Type             IssuingTransactionType `json:"type"`
+}
+
+// IssuingTransactionList is a list of issuing transactions as retrieved from
+// a list endpoint.
+type IssuingTransactionList struct {
+	APIResource
+	ListMeta
+	Values []*IssuingTransaction `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of an IssuingTransaction.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (i *IssuingTransaction) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		i.ID = id
+		return nil
+	}
+
+	type issuingTransaction IssuingTransaction
+	var ii issuingTransaction
+	err := json.Unmarshal(data, &ii)
+	if err != nil {
+		return err
+	}
+
+	*i = IssuingTransaction(ii)
+	return nil
+}
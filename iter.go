@@ -18,13 +18,16 @@ type Query func(*form.Values) ([]interface{}, ListMeta, error)
 // Iterators are not thread-safe, so they should not be consumed
 // across multiple goroutines.
 type Iter struct {
-	cur    interface{}
-	err    error
-	meta   ListMeta
-	params ListParams
-	qs     *form.Values
-	query  Query
-	values []interface{}
+	cur        interface{}
+	cursor     string
+	decodeErrs DecodeErrors
+	err        error
+	meta       ListMeta
+	pageEmpty  bool
+	params     ListParams
+	qs         *form.Values
+	query      Query
+	values     []interface{}
 }
 
 // GetIter returns a new Iter for a given query and its options.
@@ -50,6 +53,38 @@ func GetIter(params *ListParams, qs *form.Values, query Query) *Iter {
 
 func (it *Iter) getPage() {
 	it.values, it.meta, it.err = it.query(it.qs)
+	it.cursor = ""
+
+	if errs, ok := it.err.(DecodeErrors); ok {
+		// Keep going past the objects that couldn't be decoded, but remember
+		// them so that they're reported by Err once the iteration is over.
+		it.decodeErrs = append(it.decodeErrs, errs...)
+		it.err = nil
+
+		// If the objects at the edge of the page couldn't be decoded, the
+		// next page has to start from the one closest to the edge that has
+		// a known ID rather than from the last object that was visited.
+		failed := make(map[int]*DecodeError, len(errs))
+		for _, err := range errs {
+			failed[err.Index] = err
+		}
+		edge, step := len(it.values)+len(errs)-1, -1
+		if it.params.End != "" {
+			edge, step = 0, 1
+		}
+		for i := edge; i >= 0 && i < len(it.values)+len(errs); i += step {
+			err, ok := failed[i]
+			if !ok {
+				break
+			}
+			if err.ID != "" {
+				it.cursor = err.ID
+				break
+			}
+		}
+	}
+	it.pageEmpty = len(it.values) == 0
+
 	if it.params.End != "" {
 		// We are moving backward,
 		// but items arrive in forward order.
@@ -63,13 +98,24 @@ func (it *Iter) getPage() {
 // It returns false when the iterator stops
 // at the end of the list.
 func (it *Iter) Next() bool {
-	if len(it.values) == 0 && it.meta.More && !it.params.Single {
+	// Pages where nothing could be decoded are skipped
+	for len(it.values) == 0 && it.err == nil && it.meta.More && !it.params.Single {
+		cursor := it.cursor
+		if cursor == "" && !it.pageEmpty && it.cur != nil {
+			cursor = listItemID(it.cur)
+		}
+		if cursor == "" {
+			// None of the objects at the edge of this page has a known ID,
+			// so there's no way to tell where the next page starts.
+			return false
+		}
+
 		// determine if we're moving forward or backwards in paging
 		if it.params.End != "" {
-			it.params.End = listItemID(it.cur)
+			it.params.End = cursor
 			it.qs.Set(endbefore, it.params.End)
 		} else {
-			it.params.Start = listItemID(it.cur)
+			it.params.Start = cursor
 			it.qs.Set(startafter, it.params.Start)
 		}
 		it.getPage()
@@ -92,7 +138,13 @@ func (it *Iter) Current() interface{} {
 // that caused the Iter to stop.
 // It must be inspected
 // after Next returns false.
+// If the Iter went through every page
+// but some objects couldn't be decoded,
+// Err returns a DecodeErrors describing them.
 func (it *Iter) Err() error {
+	if it.err == nil && len(it.decodeErrs) > 0 {
+		return it.decodeErrs
+	}
 	return it.err
 }
 
@@ -111,6 +111,57 @@ func TestIterReversedTwoPages(t *testing.T) {
 	assert.NoError(t, gerr)
 }
 
+func TestIterDecodeErrors(t *testing.T) {
+	errX := &DecodeError{ID: "x", Index: 0}
+	errY := &DecodeError{ID: "y", Index: 1}
+	tq := testQuery{
+		{[]interface{}{&item{"1"}}, ListMeta{0, true, ""}, DecodeErrors{errX}},
+		{[]interface{}{&item{"2"}}, ListMeta{0, false, ""}, DecodeErrors{errY}},
+	}
+	want := []interface{}{&item{"1"}, &item{"2"}}
+	g, gerr := collect(GetIter(nil, nil, tq.query))
+	assert.Equal(t, 0, len(tq))
+	assert.Equal(t, want, g)
+	assert.Equal(t, DecodeErrors{errX, errY}, gerr)
+}
+
+func TestIterDecodeErrorAtEdge(t *testing.T) {
+	tq := testQuery{
+		{[]interface{}{&item{"1"}}, ListMeta{0, true, ""}, DecodeErrors{{ID: "2", Index: 1}}},
+		{[]interface{}{&item{"3"}}, ListMeta{0, false, ""}, nil},
+	}
+	it := GetIter(nil, nil, tq.query)
+	_, gerr := collect(it)
+	assert.Equal(t, 0, len(tq))
+	assert.Equal(t, []string{"2"}, it.qs.Get(startafter))
+	assert.Error(t, gerr)
+
+	// Without any object that can be used as a cursor, the iterator stops
+	tq = testQuery{
+		{nil, ListMeta{0, true, ""}, DecodeErrors{{Index: 0}}},
+	}
+	g, gerr := collect(GetIter(nil, nil, tq.query))
+	assert.Equal(t, 0, len(tq))
+	assert.Equal(t, 0, len(g))
+	assert.Error(t, gerr)
+}
+
+func TestIterUndecodablePage(t *testing.T) {
+	errX := &DecodeError{ID: "x", Index: 0}
+	errY := &DecodeError{ID: "y", Index: 1}
+	tq := testQuery{
+		{[]interface{}{&item{"1"}}, ListMeta{0, true, ""}, nil},
+		{nil, ListMeta{0, true, ""}, DecodeErrors{errX, errY}},
+		{[]interface{}{&item{"2"}}, ListMeta{0, false, ""}, nil},
+	}
+	it := GetIter(nil, nil, tq.query)
+	g, gerr := collect(it)
+	assert.Equal(t, 0, len(tq))
+	assert.Equal(t, []interface{}{&item{"1"}, &item{"2"}}, g)
+	assert.Equal(t, []string{"y"}, it.qs.Get(startafter))
+	assert.Equal(t, DecodeErrors{errX, errY}, gerr)
+}
+
 func TestReverse(t *testing.T) {
 	var cases = [][]interface{}{
 		{},
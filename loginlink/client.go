@@ -23,12 +23,13 @@ func New(params *stripe.LoginLinkParams) (*stripe.LoginLink, error) {
 
 func (c Client) New(params *stripe.LoginLinkParams) (*stripe.LoginLink, error) {
 	body := &form.Values{}
+	form.AppendTo(body, params)
 
 	loginLink := &stripe.LoginLink{}
 	var err error
 
 	if len(params.Account) > 0 {
-		err = c.B.Call("POST", fmt.Sprintf("/accounts/%v/login_links", params.Account), c.Key, body, nil, loginLink)
+		err = c.B.Call("POST", fmt.Sprintf("/accounts/%v/login_links", params.Account), c.Key, body, &params.Params, loginLink)
 	} else {
 		err = errors.New("Invalid login link params: Account must be set")
 	}
@@ -37,5 +38,5 @@ func (c Client) New(params *stripe.LoginLinkParams) (*stripe.LoginLink, error) {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
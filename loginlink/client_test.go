@@ -6,6 +6,7 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestLoginLinkNew(t *testing.T) {
@@ -15,3 +16,16 @@ func TestLoginLinkNew(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, link)
 }
+
+func TestLoginLinkNewRedirectURL(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/accounts/acct_EXPRESS/login_links", `{"url": "https://connect.stripe.com/express/login"}`)
+
+	link, err := Client{B: b}.New(&stripe.LoginLinkParams{
+		Account:     "acct_EXPRESS",
+		RedirectURL: "https://example.com/dashboard",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, link)
+	assert.Equal(t, "https://example.com/dashboard", b.LastCall().Values().Get("redirect_url"))
+}
@@ -0,0 +1,149 @@
+package stripe
+
+import "encoding/json"
+
+// MandateACSSDebitPaymentSchedule is the list of allowed values for the
+// payment schedule of an ACSS debit mandate. Allowed values are "combined",
+// "interval", "sporadic".
+type MandateACSSDebitPaymentSchedule string
+
+// MandateACSSDebitTransactionType is the list of allowed values for the
+// transaction type of an ACSS debit mandate. Allowed values are "business",
+// "personal".
+type MandateACSSDebitTransactionType string
+
+// MandateBACSDebitNetworkStatus is the list of allowed values for the status
+// of a BACS debit mandate on the network. Allowed values are "accepted",
+// "pending", "refused", "revoked".
+type MandateBACSDebitNetworkStatus string
+
+// MandateCustomerAcceptanceType is the list of allowed values for the way a
+// customer accepted a mandate. Allowed values are "offline", "online".
+type MandateCustomerAcceptanceType string
+
+// MandateStatus is the list of allowed values for the status of a mandate.
+// Allowed values are "active", "inactive", "pending".
+type MandateStatus string
+
+// MandateType is the list of allowed values for the type of a mandate.
+// Allowed values are "multi_use", "single_use".
+type MandateType string
+
+// MandateParams is the set of parameters that can be used when retrieving a
+// mandate.
+// For more details see https://stripe.com/docs/api#retrieve_mandate.
+type MandateParams struct {
+	Params `form:"*"`
+}
+
+// MandateCustomerAcceptanceOnlineParams is the set of parameters describing
+// the session in which a customer accepted a mandate online.
+type MandateCustomerAcceptanceOnlineParams struct {
+	IPAddress string `form:"ip_address"`
+	UserAgent string `form:"user_agent"`
+}
+
+// MandateCustomerAcceptanceParams is the set of parameters describing how a
+// customer accepted a mandate.
+type MandateCustomerAcceptanceParams struct {
+	AcceptedAt int64                                  `form:"accepted_at"`
+	Online     *MandateCustomerAcceptanceOnlineParams `form:"online"`
+	Type       MandateCustomerAcceptanceType          `form:"type"`
+}
+
+// MandateDataParams is the set of parameters used to create a mandate when
+// confirming a payment with a debit scheme like SEPA Direct Debit, which
+// requires the customer's authorization.
+type MandateDataParams struct {
+	CustomerAcceptance *MandateCustomerAcceptanceParams `form:"customer_acceptance"`
+}
+
+// MandateCustomerAcceptanceOnline describes the session in which a customer
+// accepted a mandate online.
+type MandateCustomerAcceptanceOnline struct {
+	IPAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+}
+
+// MandateCustomerAcceptance describes how a customer accepted a mandate.
+type MandateCustomerAcceptance struct {
+	AcceptedAt Timestamp                        `json:"accepted_at"`
+	Online     *MandateCustomerAcceptanceOnline `json:"online"`
+	Type       MandateCustomerAcceptanceType    `json:"type"`
+}
+
+// MandateACSSDebit holds the details of a mandate for an ACSS debit
+// payment method.
+type MandateACSSDebit struct {
+	DefaultFor          []string                        `json:"default_for"`
+	IntervalDescription string                          `json:"interval_description"`
+	PaymentSchedule     MandateACSSDebitPaymentSchedule `json:"payment_schedule"`
+	TransactionType     MandateACSSDebitTransactionType `json:"transaction_type"`
+}
+
+// MandateBACSDebit holds the details of a mandate for a BACS debit payment
+// method.
+type MandateBACSDebit struct {
+	NetworkStatus MandateBACSDebitNetworkStatus `json:"network_status"`
+	Reference     string                        `json:"reference"`
+	URL           string                        `json:"url"`
+}
+
+// MandateSEPADebit holds the details of a mandate for a SEPA debit payment
+// method. Reference is the unique mandate reference that must be shown to
+// the customer.
+type MandateSEPADebit struct {
+	Reference string `json:"reference"`
+	URL       string `json:"url"`
+}
+
+// MandatePaymentMethodDetails holds the details of a mandate that are
+// specific to its payment method. Type tells which of them is set.
+type MandatePaymentMethodDetails struct {
+	ACSSDebit *MandateACSSDebit `json:"acss_debit"`
+	BACSDebit *MandateBACSDebit `json:"bacs_debit"`
+	SEPADebit *MandateSEPADebit `json:"sepa_debit"`
+	Type      string            `json:"type"`
+}
+
+// MandateSingleUse describes the payment that a single use mandate
+// authorizes.
+type MandateSingleUse struct {
+	Amount   uint64   `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+// Mandate is the resource representing the authorization given by a customer
+// to debit their payment method.
+// For more details see https://stripe.com/docs/api#mandates.
+type Mandate struct {
+	APIResource
+	CustomerAcceptance   *MandateCustomerAcceptance   `json:"customer_acceptance"`
+	ID                   string                       `json:"id"`
+	Live                 bool                         `json:"livemode"`
+	PaymentMethod        string                       `json:"payment_method"`
+	PaymentMethodDetails *MandatePaymentMethodDetails `json:"payment_method_details"`
+	SingleUse            *MandateSingleUse            `json:"single_use"`
+	Status               MandateStatus                `json:"status"`
+	Type                 MandateType                  `json:"type"`
+}
+
+// UnmarshalJSON handles deserialization of a Mandate.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (m *Mandate) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		m.ID = id
+		return nil
+	}
+
+	type mandate Mandate
+	var mm mandate
+	err := json.Unmarshal(data, &mm)
+	if err != nil {
+		return err
+	}
+
+	*m = Mandate(mm)
+	return nil
+}
@@ -0,0 +1,63 @@
+// Package mandate provides the /mandates APIs
+package mandate
+
+import (
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	ACSSDebitPaymentScheduleCombined stripe.MandateACSSDebitPaymentSchedule = "combined"
+	ACSSDebitPaymentScheduleInterval stripe.MandateACSSDebitPaymentSchedule = "interval"
+	ACSSDebitPaymentScheduleSporadic stripe.MandateACSSDebitPaymentSchedule = "sporadic"
+
+	ACSSDebitTransactionTypeBusiness stripe.MandateACSSDebitTransactionType = "business"
+	ACSSDebitTransactionTypePersonal stripe.MandateACSSDebitTransactionType = "personal"
+
+	BACSDebitNetworkStatusAccepted stripe.MandateBACSDebitNetworkStatus = "accepted"
+	BACSDebitNetworkStatusPending  stripe.MandateBACSDebitNetworkStatus = "pending"
+	BACSDebitNetworkStatusRefused  stripe.MandateBACSDebitNetworkStatus = "refused"
+	BACSDebitNetworkStatusRevoked  stripe.MandateBACSDebitNetworkStatus = "revoked"
+
+	CustomerAcceptanceTypeOffline stripe.MandateCustomerAcceptanceType = "offline"
+	CustomerAcceptanceTypeOnline  stripe.MandateCustomerAcceptanceType = "online"
+
+	StatusActive   stripe.MandateStatus = "active"
+	StatusInactive stripe.MandateStatus = "inactive"
+	StatusPending  stripe.MandateStatus = "pending"
+
+	TypeMultiUse  stripe.MandateType = "multi_use"
+	TypeSingleUse stripe.MandateType = "single_use"
+)
+
+// Client is used to invoke /mandates APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of a mandate.
+// For more details see https://stripe.com/docs/api#retrieve_mandate.
+func Get(id string, params *stripe.MandateParams) (*stripe.Mandate, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.MandateParams) (*stripe.Mandate, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	mandate := &stripe.Mandate{}
+	err := c.B.Call("GET", "/mandates/"+id, c.Key, body, commonParams, mandate)
+
+	return mandate, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
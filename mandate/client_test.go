@@ -0,0 +1,14 @@
+package mandate
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestMandateGet(t *testing.T) {
+	mandate, err := Get("mandate_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, mandate)
+}
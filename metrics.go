@@ -0,0 +1,162 @@
+package stripe
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// MetricsCollector is the interface implemented by collectors of request
+// metrics, like an adapter recording them as Prometheus counters and
+// histograms. Implementations must be safe for concurrent use.
+type MetricsCollector interface {
+	// ObserveRequest is called once for every completed request.
+	ObserveRequest(m *RequestMetrics)
+}
+
+// RequestMetrics describes a completed request to Stripe.
+type RequestMetrics struct {
+	// Duration is the time the request took, including reading its
+	// response.
+	Duration time.Duration
+
+	// Endpoint is the path of the request with the IDs of objects replaced
+	// by ":id", like "/v1/charges/:id/capture", which keeps the number of
+	// distinct values low enough to use as a metric label.
+	Endpoint string
+
+	// ErrorCode is the code of the error returned by the API, if any.
+	ErrorCode ErrorCode
+
+	// ErrorType is the type of the error returned by the API, if any. It's
+	// ErrorTypeAPIConnection when no response was received.
+	ErrorType ErrorType
+
+	Method string
+
+	// StatusCode is the status code of the response, or 0 when no response
+	// was received.
+	StatusCode int
+}
+
+// idCollections are the names of the collections whose objects are retrieved
+// by ID, at the path of the collection followed by the ID. The segment after
+// one of them is replaced in endpoints whatever it looks like, since some IDs
+// are chosen by users, like the IDs of coupons and plans.
+var idCollections = map[string]bool{
+	"3d_secure":              true,
+	"accounts":               true,
+	"application_fees":       true,
+	"authorizations":         true,
+	"balance_transactions":   true,
+	"bank_accounts":          true,
+	"calculations":           true,
+	"capabilities":           true,
+	"cardholders":            true,
+	"cards":                  true,
+	"charges":                true,
+	"country_specs":          true,
+	"coupons":                true,
+	"credit_notes":           true,
+	"customers":              true,
+	"disputes":               true,
+	"domains":                true,
+	"early_fraud_warnings":   true,
+	"ephemeral_keys":         true,
+	"events":                 true,
+	"exchange_rates":         true,
+	"external_accounts":      true,
+	"file_links":             true,
+	"files":                  true,
+	"history":                true,
+	"invoiceitems":           true,
+	"invoices":               true,
+	"locations":              true,
+	"mandates":               true,
+	"orders":                 true,
+	"payment_intents":        true,
+	"payment_links":          true,
+	"payouts":                true,
+	"persons":                true,
+	"plans":                  true,
+	"prices":                 true,
+	"products":               true,
+	"quotes":                 true,
+	"readers":                true,
+	"receivers":              true,
+	"recipients":             true,
+	"refunds":                true,
+	"registrations":          true,
+	"report_runs":            true,
+	"report_types":           true,
+	"reversals":              true,
+	"reviews":                true,
+	"shipping_rates":         true,
+	"skus":                   true,
+	"sources":                true,
+	"subscription_items":     true,
+	"subscription_schedules": true,
+	"subscriptions":          true,
+	"suppliers":              true,
+	"tax_ids":                true,
+	"tax_rates":              true,
+	"test_clocks":            true,
+	"tokens":                 true,
+	"topups":                 true,
+	"transactions":           true,
+	"transfers":              true,
+	"value_list_items":       true,
+	"value_lists":            true,
+	"verification_reports":   true,
+	"verification_sessions":  true,
+	"webhook_endpoints":      true,
+}
+
+// collectionActions are the paths under a collection which aren't IDs, like
+// "/v1/customers/search".
+var collectionActions = map[string]bool{
+	"create_force_capture":    true,
+	"create_from_calculation": true,
+	"create_reversal":         true,
+	"create_unlinked_refund":  true,
+	"preview":                 true,
+	"search":                  true,
+	"upcoming":                true,
+}
+
+// endpoint returns path with the IDs of objects replaced by ":id".
+func endpoint(path string) string {
+	segments := strings.Split(path, "/")
+	for i := 1; i < len(segments); i++ {
+		if segments[i] != "" && idCollections[segments[i-1]] && !collectionActions[segments[i]] {
+			segments[i] = ":id"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// observe reports the request req to the metrics collector of the backend,
+// if any.
+func (s *BackendConfiguration) observe(req *http.Request, res *http.Response, err error, start time.Time) {
+	if s.Metrics == nil {
+		return
+	}
+
+	m := &RequestMetrics{
+		Duration: time.Since(start),
+		Endpoint: endpoint(req.URL.Path),
+		Method:   req.Method,
+	}
+	if res != nil {
+		m.StatusCode = res.StatusCode
+	}
+
+	if stripeErr, ok := err.(*Error); ok {
+		m.ErrorCode = stripeErr.Code
+		m.ErrorType = stripeErr.Type
+	} else if err != nil && res == nil {
+		m.ErrorType = ErrorTypeAPIConnection
+	}
+
+	s.Metrics.ObserveRequest(m)
+}
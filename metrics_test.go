@@ -0,0 +1,81 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+// recordingCollector is a MetricsCollector that keeps every metric it's
+// given.
+type recordingCollector struct {
+	mu      sync.Mutex
+	metrics []*RequestMetrics
+}
+
+func (c *recordingCollector) ObserveRequest(m *RequestMetrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.metrics = append(c.metrics, m)
+}
+
+func TestEndpoint(t *testing.T) {
+	assert.Equal(t, "/v1/charges/:id/capture", endpoint("/v1/charges/ch_1A2b3C/capture"))
+	assert.Equal(t, "/v1/payment_intents/:id", endpoint("/v1/payment_intents/pi_123"))
+	assert.Equal(t, "/v1/accounts/:id/external_accounts/:id", endpoint("/v1/accounts/acct_1Ab/external_accounts/ba_2Cd"))
+	assert.Equal(t, "/v1/invoices/upcoming/lines", endpoint("/v1/invoices/upcoming/lines"))
+	assert.Equal(t, "/v1/balance/history", endpoint("/v1/balance/history"))
+}
+
+func TestEndpointUserChosenIDs(t *testing.T) {
+	assert.Equal(t, "/v1/coupons/:id", endpoint("/v1/coupons/25OFF"))
+	assert.Equal(t, "/v1/plans/:id", endpoint("/v1/plans/gold-monthly"))
+	assert.Equal(t, "/v1/exchange_rates/:id", endpoint("/v1/exchange_rates/usd"))
+	assert.Equal(t, "/v1/customers/search", endpoint("/v1/customers/search"))
+	assert.Equal(t, "/v1/test_helpers/issuing/cards/:id/shipping/ship",
+		endpoint("/v1/test_helpers/issuing/cards/ic_123/shipping/ship"))
+}
+
+func TestBackendMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/charges/ch_missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"type":"invalid_request_error","code":"resource_missing","message":"No such charge"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"ch_123"}`))
+	}))
+	defer server.Close()
+
+	collector := &recordingCollector{}
+	c := &BackendConfiguration{URL: server.URL + "/v1", HTTPClient: http.DefaultClient, Metrics: collector}
+
+	err := c.Call("GET", "/charges/ch_123", "", nil, nil, &Charge{})
+	assert.NoError(t, err)
+
+	err = c.Call("GET", "/charges/ch_missing", "", nil, nil, &Charge{})
+	assert.Error(t, err)
+
+	assert.Equal(t, 2, len(collector.metrics))
+
+	m := collector.metrics[0]
+	assert.Equal(t, "GET", m.Method)
+	assert.Equal(t, "/v1/charges/:id", m.Endpoint)
+	assert.Equal(t, http.StatusOK, m.StatusCode)
+	assert.Equal(t, ErrorType(""), m.ErrorType)
+
+	m = collector.metrics[1]
+	assert.Equal(t, http.StatusNotFound, m.StatusCode)
+	assert.Equal(t, ErrorTypeInvalidRequest, m.ErrorType)
+	assert.Equal(t, ErrorCode("resource_missing"), m.ErrorCode)
+
+	server.Close()
+	err = c.Call("GET", "/charges/ch_123", "", nil, nil, &Charge{})
+	assert.Error(t, err)
+	m = collector.metrics[2]
+	assert.Equal(t, 0, m.StatusCode)
+	assert.Equal(t, ErrorTypeAPIConnection, m.ErrorType)
+}
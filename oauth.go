@@ -0,0 +1,116 @@
+package stripe
+
+import "fmt"
+
+// OAuthErrorCode is the list of allowed values for the code of an error
+// returned by the OAuth endpoints. Allowed values are "access_denied",
+// "invalid_client", "invalid_grant", "invalid_request", "invalid_scope",
+// "unsupported_grant_type", and "unsupported_response_type".
+type OAuthErrorCode string
+
+// OAuthGrantType is the list of allowed values for the grant type of a token
+// request. Allowed values are "authorization_code" and "refresh_token".
+type OAuthGrantType string
+
+// OAuthScope is the list of allowed values for the scope of an OAuth
+// connection. Allowed values are "read_only" and "read_write".
+type OAuthScope string
+
+// OAuthTokenType is the list of allowed values for the type of an access
+// token. Allowed values are "bearer".
+type OAuthTokenType string
+
+// OAuthStripeUserParams is the set of parameters that prefill the onboarding
+// form of the user connecting their account.
+type OAuthStripeUserParams struct {
+	BusinessName string `form:"business_name"`
+	BusinessType string `form:"business_type"`
+	City         string `form:"city"`
+	Country      string `form:"country"`
+	Email        string `form:"email"`
+	FirstName    string `form:"first_name"`
+	LastName     string `form:"last_name"`
+	PhoneNumber  string `form:"phone_number"`
+	State        string `form:"state"`
+	StreetAddr   string `form:"street_address"`
+	URL          string `form:"url"`
+	Zip          string `form:"zip"`
+}
+
+// AuthorizeURLParams is the set of parameters that can be used when building
+// the URL that a user is sent to in order to connect their account.
+// For more details see https://stripe.com/docs/connect/oauth-reference#get-authorize.
+type AuthorizeURLParams struct {
+	// ClientID is the client ID of the platform. The global ClientID is
+	// used if it's empty.
+	ClientID string `form:"client_id"`
+
+	// Express sends the user to the onboarding of Express accounts rather
+	// than Standard accounts.
+	Express bool `form:"-"`
+
+	RedirectURI   string                 `form:"redirect_uri"`
+	ResponseType  string                 `form:"response_type"`
+	Scope         OAuthScope             `form:"scope"`
+	State         string                 `form:"state"`
+	StripeLanding string                 `form:"stripe_landing"`
+	StripeUser    *OAuthStripeUserParams `form:"stripe_user"`
+}
+
+// OAuthTokenParams is the set of parameters that can be used when exchanging
+// an authorization code or a refresh token for an access token.
+// For more details see https://stripe.com/docs/connect/oauth-reference#post-token.
+type OAuthTokenParams struct {
+	Params       `form:"*"`
+	Code         string         `form:"code"`
+	GrantType    OAuthGrantType `form:"grant_type"`
+	RefreshToken string         `form:"refresh_token"`
+	Scope        OAuthScope     `form:"scope"`
+}
+
+// OAuthToken is the result of exchanging an authorization code or a refresh
+// token. StripeUserID is the ID of the connected account.
+type OAuthToken struct {
+	APIResource
+	AccessToken          string         `json:"access_token"`
+	Live                 bool           `json:"livemode"`
+	RefreshToken         string         `json:"refresh_token"`
+	Scope                OAuthScope     `json:"scope"`
+	StripePublishableKey string         `json:"stripe_publishable_key"`
+	StripeUserID         string         `json:"stripe_user_id"`
+	TokenType            OAuthTokenType `json:"token_type"`
+}
+
+// DeauthorizeParams is the set of parameters that can be used when
+// disconnecting an account from the platform.
+// For more details see https://stripe.com/docs/connect/oauth-reference#post-deauthorize.
+type DeauthorizeParams struct {
+	Params `form:"*"`
+
+	// ClientID is the client ID of the platform. The global ClientID is
+	// used if it's empty.
+	ClientID     string `form:"client_id"`
+	StripeUserID string `form:"stripe_user_id"`
+}
+
+// Deauthorization is the result of disconnecting an account from the
+// platform.
+type Deauthorization struct {
+	APIResource
+	StripeUserID string `json:"stripe_user_id"`
+}
+
+// OAuthError is the error returned by the OAuth endpoints, which report
+// errors in a different format than the rest of the API.
+// For more details see https://stripe.com/docs/connect/oauth-reference#post-token-errors.
+type OAuthError struct {
+	Code           OAuthErrorCode `json:"error"`
+	Desc           string         `json:"error_description"`
+	HTTPStatusCode int            `json:"-"`
+	RequestID      string         `json:"-"`
+}
+
+// Error serializes the code and the description of the error.
+func (e *OAuthError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Code, e.Desc)
+}
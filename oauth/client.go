@@ -0,0 +1,127 @@
+// Package oauth provides the OAuth APIs used to connect Standard and Express
+// accounts to a platform.
+package oauth
+
+import (
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	ErrorCodeAccessDenied            stripe.OAuthErrorCode = "access_denied"
+	ErrorCodeInvalidClient           stripe.OAuthErrorCode = "invalid_client"
+	ErrorCodeInvalidGrant            stripe.OAuthErrorCode = "invalid_grant"
+	ErrorCodeInvalidRequest          stripe.OAuthErrorCode = "invalid_request"
+	ErrorCodeInvalidScope            stripe.OAuthErrorCode = "invalid_scope"
+	ErrorCodeUnsupportedGrantType    stripe.OAuthErrorCode = "unsupported_grant_type"
+	ErrorCodeUnsupportedResponseType stripe.OAuthErrorCode = "unsupported_response_type"
+
+	GrantTypeAuthorizationCode stripe.OAuthGrantType = "authorization_code"
+	GrantTypeRefreshToken      stripe.OAuthGrantType = "refresh_token"
+
+	ScopeReadOnly  stripe.OAuthScope = "read_only"
+	ScopeReadWrite stripe.OAuthScope = "read_write"
+
+	TokenTypeBearer stripe.OAuthTokenType = "bearer"
+)
+
+// Client is used to invoke the OAuth APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+
+	// ClientID is the Connect client ID of the platform. It defaults to
+	// stripe.ClientID.
+	ClientID string
+}
+
+// AuthorizeURL returns the URL that a user is sent to in order to connect
+// their account to the platform. Once they're done, they're redirected to
+// RedirectURI with an authorization code that can be exchanged with New.
+// For more details see https://stripe.com/docs/connect/oauth-reference#get-authorize.
+func AuthorizeURL(params *stripe.AuthorizeURLParams) string {
+	return getC().AuthorizeURL(params)
+}
+
+func (c Client) AuthorizeURL(params *stripe.AuthorizeURLParams) string {
+	path := "/oauth/authorize"
+	body := &form.Values{}
+
+	if params == nil {
+		params = &stripe.AuthorizeURLParams{}
+	}
+
+	if params.Express {
+		path = "/express" + path
+	}
+
+	if params.ClientID == "" {
+		body.Add("client_id", c.clientID())
+	}
+	if params.ResponseType == "" {
+		body.Add("response_type", "code")
+	}
+	form.AppendTo(body, params)
+
+	return stripe.ConnectURL + path + "?" + body.Encode()
+}
+
+// New exchanges an authorization code or a refresh token for an access token
+// to the connected account.
+// For more details see https://stripe.com/docs/connect/oauth-reference#post-token.
+func New(params *stripe.OAuthTokenParams) (*stripe.OAuthToken, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.OAuthTokenParams) (*stripe.OAuthToken, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	token := &stripe.OAuthToken{}
+	err := c.B.Call("POST", "/oauth/token", c.Key, body, commonParams, token)
+
+	return token, err
+}
+
+// Del disconnects an account from the platform, revoking its access tokens.
+// For more details see https://stripe.com/docs/connect/oauth-reference#post-deauthorize.
+func Del(params *stripe.DeauthorizeParams) (*stripe.Deauthorization, error) {
+	return getC().Del(params)
+}
+
+func (c Client) Del(params *stripe.DeauthorizeParams) (*stripe.Deauthorization, error) {
+	body := &form.Values{}
+	var commonParams *stripe.Params
+
+	if params == nil || params.ClientID == "" {
+		body.Add("client_id", c.clientID())
+	}
+
+	if params != nil {
+		commonParams = &params.Params
+		form.AppendTo(body, params)
+	}
+
+	deauthorization := &stripe.Deauthorization{}
+	err := c.B.Call("POST", "/oauth/deauthorize", c.Key, body, commonParams, deauthorization)
+
+	return deauthorization, err
+}
+
+// clientID returns the Connect client ID of the platform.
+func (c Client) clientID() string {
+	if c.ClientID != "" {
+		return c.ClientID
+	}
+	return stripe.GetClientID()
+}
+
+func getC() Client {
+	return Client{B: stripe.GetBackend(stripe.ConnectBackend), Key: stripe.GetKey()}
+}
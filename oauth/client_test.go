@@ -0,0 +1,106 @@
+package oauth
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestAuthorizeURL(t *testing.T) {
+	stripe.ClientID = "ca_123"
+	defer func() { stripe.ClientID = "" }()
+
+	rawURL := AuthorizeURL(&stripe.AuthorizeURLParams{
+		Scope: ScopeReadWrite,
+		State: "csrf_token",
+		StripeUser: &stripe.OAuthStripeUserParams{
+			Email: "jenny@example.com",
+		},
+	})
+	assert.True(t, strings.HasPrefix(rawURL, "https://connect.stripe.com/oauth/authorize?"))
+
+	u, err := url.Parse(rawURL)
+	assert.NoError(t, err)
+	query := u.Query()
+	assert.Equal(t, "ca_123", query.Get("client_id"))
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, "read_write", query.Get("scope"))
+	assert.Equal(t, "csrf_token", query.Get("state"))
+	assert.Equal(t, "jenny@example.com", query.Get("stripe_user[email]"))
+}
+
+func TestAuthorizeURLExpress(t *testing.T) {
+	rawURL := AuthorizeURL(&stripe.AuthorizeURLParams{
+		ClientID: "ca_456",
+		Express:  true,
+	})
+	assert.True(t, strings.HasPrefix(rawURL, "https://connect.stripe.com/express/oauth/authorize?"))
+
+	u, err := url.Parse(rawURL)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ca_456"}, u.Query()["client_id"])
+}
+
+func TestOAuthClientID(t *testing.T) {
+	stripe.ClientID = "ca_123"
+	defer func() { stripe.ClientID = "" }()
+
+	b := testbackend.New()
+	b.Respond("POST", "/oauth/deauthorize", `{"stripe_user_id": "acct_123"}`)
+
+	c := Client{B: b, Key: "sk_test_123", ClientID: "ca_456"}
+
+	u, err := url.Parse(c.AuthorizeURL(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "ca_456", u.Query().Get("client_id"))
+
+	_, err = c.Del(&stripe.DeauthorizeParams{StripeUserID: "acct_123"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ca_456", b.LastCall().Values().Get("client_id"))
+}
+
+func TestOAuthDel(t *testing.T) {
+	stripe.ClientID = "ca_123"
+	defer func() { stripe.ClientID = "" }()
+
+	b := testbackend.New()
+	b.Respond("POST", "/oauth/deauthorize", `{"stripe_user_id": "acct_123"}`)
+
+	c := Client{B: b, Key: "sk_test_123"}
+	deauthorization, err := c.Del(&stripe.DeauthorizeParams{StripeUserID: "acct_123"})
+	assert.NoError(t, err)
+	assert.Equal(t, "acct_123", deauthorization.StripeUserID)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "ca_123", values.Get("client_id"))
+	assert.Equal(t, "acct_123", values.Get("stripe_user_id"))
+}
+
+func TestOAuthNew(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/oauth/token", `{
+		"access_token": "sk_test_456",
+		"scope": "read_write",
+		"stripe_user_id": "acct_123",
+		"token_type": "bearer"
+	}`)
+
+	c := Client{B: b, Key: "sk_test_123"}
+	token, err := c.New(&stripe.OAuthTokenParams{
+		Code:      "ac_123",
+		GrantType: GrantTypeAuthorizationCode,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "sk_test_456", token.AccessToken)
+	assert.Equal(t, ScopeReadWrite, token.Scope)
+	assert.Equal(t, "acct_123", token.StripeUserID)
+	assert.Equal(t, TokenTypeBearer, token.TokenType)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "ac_123", values.Get("code"))
+	assert.Equal(t, "authorization_code", values.Get("grant_type"))
+}
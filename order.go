@@ -76,12 +76,13 @@ type DeliveryEstimate struct {
 }
 
 type Order struct {
+	APIResource
 	Amount                 int64             `json:"amount"`
 	AmountReturned         int64             `json:"amount_returned"`
 	Application            string            `json:"application"`
 	ApplicationFee         int64             `json:"application_fee"`
 	Charge                 Charge            `json:"charge"`
-	Created                int64             `json:"created"`
+	Created                Timestamp         `json:"created"`
 	Currency               Currency          `json:"currency"`
 	Customer               Customer          `json:"customer"`
 	Email                  string            `json:"email"`
@@ -95,11 +96,12 @@ type Order struct {
 	ShippingMethods        []ShippingMethod  `json:"shipping_methods"`
 	Status                 OrderStatus       `json:"status"`
 	StatusTransitions      StatusTransitions `json:"status_transitions"`
-	Updated                int64             `json:"updated"`
+	Updated                Timestamp         `json:"updated"`
 }
 
 // OrderList is a list of orders as retrieved from a list endpoint.
 type OrderList struct {
+	APIResource
 	ListMeta
 	Values []*Order `json:"data"`
 }
@@ -118,10 +120,10 @@ type OrderListParams struct {
 // StatsuTransitions are the timestamps at which the order status was updated
 // https://stripe.com/docs/api#order_object
 type StatusTransitions struct {
-	Canceled  int64 `json:"canceled"`
-	Fulfilled int64 `json:"fulfiled"`
-	Paid      int64 `json:"paid"`
-	Returned  int64 `json:"returned"`
+	Canceled  Timestamp `json:"canceled"`
+	Fulfilled Timestamp `json:"fulfiled"`
+	Paid      Timestamp `json:"paid"`
+	Returned  Timestamp `json:"returned"`
 }
 
 // OrderPayParams is the set of parameters that can be used when
@@ -147,17 +149,18 @@ func (op *OrderPayParams) SetSource(sp interface{}) error {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (o *Order) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		o.ID = id
+		return nil
+	}
+
 	type order Order
 	var oo order
 	err := json.Unmarshal(data, &oo)
-	if err == nil {
-		*o = Order(oo)
-		{
-		}
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		o.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*o = Order(oo)
 	return nil
 }
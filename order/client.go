@@ -180,5 +180,5 @@ func (c Client) Return(id string, params *stripe.OrderReturnParams) (*stripe.Ord
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -3,8 +3,9 @@ package stripe
 import "encoding/json"
 
 type OrderReturn struct {
+	APIResource
 	Amount   int64       `json:"amount"`
-	Created  int64       `json:"created"`
+	Created  Timestamp   `json:"created"`
 	Currency Currency    `json:"currency"`
 	ID       string      `json:"id"`
 	Items    []OrderItem `json:"items"`
@@ -15,6 +16,7 @@ type OrderReturn struct {
 
 // OrderReturnList is a list of returns as retrieved from a list endpoint.
 type OrderReturnList struct {
+	APIResource
 	ListMeta
 	Values []*OrderReturn `json:"data"`
 }
@@ -32,15 +34,18 @@ type OrderReturnListParams struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (ret *OrderReturn) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		ret.ID = id
+		return nil
+	}
+
 	type orderReturn OrderReturn
 	var rr orderReturn
 	err := json.Unmarshal(data, &rr)
-	if err == nil {
-		*ret = OrderReturn(rr)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		ret.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*ret = OrderReturn(rr)
 	return nil
 }
@@ -55,5 +55,5 @@ func (i *Iter) OrderReturn() *stripe.OrderReturn {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
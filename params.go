@@ -29,14 +29,22 @@ type Params struct {
 	// Headers may be used to provide extra header lines on the HTTP request.
 	Headers http.Header `form:"-"`
 
-	IdempotencyKey string            `form:"-"` // Passed as header
-	Meta           map[string]string `form:"metadata"`
+	IdempotencyKey string `form:"-"` // Passed as header
+
+	// Meta holds the metadata to set on the object. A key set to an empty
+	// string is unset. Use MetaEmpty to unset all of them instead.
+	Meta      map[string]string `form:"metadata"`
+	MetaEmpty bool              `form:"metadata,empty"`
 
 	// StripeAccount may contain the ID of a connected account. By including
 	// this field, the request is made as if it originated from the connected
 	// account instead of under the account of the owner of the configured
 	// Stripe key.
 	StripeAccount string `form:"-"` // Passed as header
+
+	// StripeVersion, if set, is the API version the request is made with,
+	// overriding the version of the backend.
+	StripeVersion string `form:"-"` // Passed as header
 }
 
 // ExtraValues are extra parameters that are attached to an API request.
@@ -76,6 +84,10 @@ type ListParams struct {
 	// account instead of under the account of the owner of the configured
 	// Stripe key.
 	StripeAccount string `form:"-"` // Passed as header
+
+	// StripeVersion, if set, is the API version the request is made with,
+	// overriding the version of the backend.
+	StripeVersion string `form:"-"` // Passed as header
 }
 
 // ListMeta is the structure that contains the common properties
@@ -106,6 +118,22 @@ type RangeQueryParams struct {
 	LesserThanOrEqual int64 `form:"lte"`
 }
 
+// NewRangeQueryParamsSince returns the RangeQueryParams matching the
+// timestamps at or after t, like every object created since yesterday:
+//
+//	params := &stripe.ChargeListParams{
+//	    CreatedRange: stripe.NewRangeQueryParamsSince(time.Now().AddDate(0, 0, -1)),
+//	}
+func NewRangeQueryParamsSince(t time.Time) *RangeQueryParams {
+	return &RangeQueryParams{GreaterThanOrEqual: t.Unix()}
+}
+
+// NewRangeQueryParamsBetween returns the RangeQueryParams matching the
+// timestamps at or after start and before end.
+func NewRangeQueryParamsBetween(start, end time.Time) *RangeQueryParams {
+	return &RangeQueryParams{GreaterThanOrEqual: start.Unix(), LesserThan: end.Unix()}
+}
+
 // Filters is a structure that contains a collection of filters for list-related APIs.
 type Filters struct {
 	f []*filter `form:"-"` // See custom AppendTo implementation
@@ -161,8 +189,15 @@ func (p *Params) Expand(f string) {
 	p.Exp = append(p.Exp, f)
 }
 
-// AddMeta adds a new key-value pair to the Metadata.
+// AddMeta adds a new key-value pair to the Metadata. It's the same as
+// AddMetadata.
 func (p *Params) AddMeta(key, value string) {
+	p.AddMetadata(key, value)
+}
+
+// AddMetadata adds a new key-value pair to the Metadata. Setting a key to an
+// empty string unsets it.
+func (p *Params) AddMetadata(key, value string) {
 	if p.Meta == nil {
 		p.Meta = make(map[string]string)
 	}
@@ -170,6 +205,18 @@ func (p *Params) AddMeta(key, value string) {
 	p.Meta[key] = value
 }
 
+// DelMetadata unsets a key of the Metadata.
+func (p *Params) DelMetadata(key string) {
+	p.AddMetadata(key, "")
+}
+
+// ClearMetadata unsets every key of the Metadata, discarding any key-value
+// pair added to the params.
+func (p *Params) ClearMetadata() {
+	p.Meta = nil
+	p.MetaEmpty = true
+}
+
 // AddExtra adds a new arbitrary key-value pair to the request data
 func (p *Params) AddExtra(key, value string) {
 	if p.Extra == nil {
@@ -179,11 +226,17 @@ func (p *Params) AddExtra(key, value string) {
 	p.Extra.Add(key, value)
 }
 
-// Expand appends a new field to expand.
+// Expand appends a new field to expand. Fields of the listed objects must be
+// prefixed with "data.", as in "data.customer".
 func (p *ListParams) Expand(f string) {
 	p.Exp = append(p.Exp, f)
 }
 
+// SetStripeAccount sets a value for the Stripe-Account header.
+func (p *ListParams) SetStripeAccount(val string) {
+	p.StripeAccount = val
+}
+
 // ToParams converts a ListParams to a Params by moving over any fields that
 // have valid targets in the new type. This is useful because fields in
 // Params can be injected directly into an http.Request while generally
@@ -191,5 +244,6 @@ func (p *ListParams) Expand(f string) {
 func (p *ListParams) ToParams() *Params {
 	return &Params{
 		StripeAccount: p.StripeAccount,
+		StripeVersion: p.StripeVersion,
 	}
 }
@@ -2,6 +2,7 @@ package stripe_test
 
 import (
 	"testing"
+	"time"
 
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
@@ -66,6 +67,38 @@ func TestRangeQueryParamsAppendTo(t *testing.T) {
 	}
 }
 
+func TestListParams_CreatedRange(t *testing.T) {
+	createdRange := &stripe.RangeQueryParams{GreaterThanOrEqual: 99, LesserThan: 199}
+
+	testCases := []interface{}{
+		&stripe.AccountListParams{CreatedRange: createdRange},
+		&stripe.ChargeListParams{CreatedRange: createdRange},
+		&stripe.CreditNoteListParams{CreatedRange: createdRange},
+		&stripe.InvoiceListParams{CreatedRange: createdRange},
+		&stripe.ProductListParams{CreatedRange: createdRange},
+		&stripe.RadarEarlyFraudWarningListParams{CreatedRange: createdRange},
+		&stripe.RecipientListParams{CreatedRange: createdRange},
+		&stripe.RefundListParams{CreatedRange: createdRange},
+	}
+	for _, params := range testCases {
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		assert.Equal(t, []string{"99"}, body.Get("created[gte]"))
+		assert.Equal(t, []string{"199"}, body.Get("created[lt]"))
+	}
+}
+
+func TestNewRangeQueryParams(t *testing.T) {
+	start := time.Unix(1500000000, 0)
+	end := start.Add(24 * time.Hour)
+
+	since := stripe.NewRangeQueryParamsSince(start)
+	assert.Equal(t, &stripe.RangeQueryParams{GreaterThanOrEqual: 1500000000}, since)
+
+	between := stripe.NewRangeQueryParamsBetween(start, end)
+	assert.Equal(t, &stripe.RangeQueryParams{GreaterThanOrEqual: 1500000000, LesserThan: 1500086400}, between)
+}
+
 type testListParams struct {
 	stripe.ListParams `form:"*"`
 	Field             string `form:"field"`
@@ -140,6 +173,31 @@ type testSubParams struct {
 	SubField      string `form:"sub_field"`
 }
 
+func TestParams_Metadata(t *testing.T) {
+	params := &stripe.Params{}
+	params.AddMetadata("foo", "bar")
+	params.DelMetadata("baz")
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	assert.Equal(t, []string{"bar"}, body.Get("metadata[foo]"))
+	assert.Equal(t, []string{""}, body.Get("metadata[baz]"))
+
+	params.ClearMetadata()
+	body = &form.Values{}
+	form.AppendTo(body, params)
+	assert.Equal(t, "metadata=", body.Encode())
+
+	// Metadata of nested params is encoded under their key
+	subParams := &stripe.SubParams{
+		Items: []*stripe.SubItemsParams{{Price: "price_123"}},
+	}
+	subParams.Items[0].AddMetadata("foo", "bar")
+	body = &form.Values{}
+	form.AppendTo(body, subParams)
+	assert.Equal(t, []string{"bar"}, body.Get("items[0][metadata][foo]"))
+}
+
 func TestParams_AppendTo_Nested(t *testing.T) {
 	params := &testParams{
 		Field: "field_value",
@@ -222,6 +280,15 @@ func TestListParams_ToParams(t *testing.T) {
 	}
 }
 
+func TestListParams_SetStripeAccount(t *testing.T) {
+	p := &stripe.ListParams{}
+	p.SetStripeAccount(TestMerchantID)
+
+	if p.ToParams().StripeAccount != TestMerchantID {
+		t.Fatalf("Expected StripeAccount of %v but got %v.", TestMerchantID, p.ToParams().StripeAccount)
+	}
+}
+
 func TestParams_SetAccount(t *testing.T) {
 	p := &stripe.Params{}
 	p.SetAccount(TestMerchantID)
@@ -0,0 +1,424 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PaymentIntentAction is the list of actions that move a payment intent from
+// one status to another. Allowed values are "cancel", "capture", and
+// "confirm".
+type PaymentIntentAction string
+
+// PaymentIntentCancellationReason is the list of allowed values for the reason
+// why a payment intent was canceled. Allowed values are "abandoned",
+// "automatic", "duplicate", "failed_invoice", "fraudulent",
+// "requested_by_customer", and "void_invoice".
+type PaymentIntentCancellationReason string
+
+// PaymentIntentCaptureMethod is the list of allowed values for the capture
+// method of a payment intent. Allowed values are "automatic" and "manual".
+type PaymentIntentCaptureMethod string
+
+// PaymentIntentConfirmationMethod is the list of allowed values for the
+// confirmation method of a payment intent. Allowed values are "automatic" and
+// "manual".
+type PaymentIntentConfirmationMethod string
+
+// PaymentIntentNextActionType is the list of allowed values for the type of
+// action required to move a payment intent forward. Allowed values are
+// "display_bank_transfer_instructions", "redirect_to_url", "use_stripe_sdk"
+// and "verify_with_microdeposits".
+type PaymentIntentNextActionType string
+
+// PaymentIntentPaymentMethodOptionsACSSDebitPaymentSchedule is the list of
+// allowed values for the payment schedule of an ACSS debit mandate. Allowed
+// values are "combined", "interval" and "sporadic".
+type PaymentIntentPaymentMethodOptionsACSSDebitPaymentSchedule string
+
+// PaymentIntentPaymentMethodOptionsACSSDebitTransactionType is the list of
+// allowed values for the transaction type of an ACSS debit mandate. Allowed
+// values are "business" and "personal".
+type PaymentIntentPaymentMethodOptionsACSSDebitTransactionType string
+
+// PaymentIntentPaymentMethodOptionsCardRequestThreeDSecure is the list of
+// allowed values for requesting 3D Secure on a card payment. Allowed values
+// are "any" and "automatic".
+type PaymentIntentPaymentMethodOptionsCardRequestThreeDSecure string
+
+// PaymentIntentPaymentMethodOptionsVerificationMethod is the list of allowed
+// values for how a bank account used for a debit is verified. Allowed values
+// are "automatic", "instant" and "microdeposits".
+type PaymentIntentPaymentMethodOptionsVerificationMethod string
+
+// PaymentIntentPaymentMethodOptionsCardRequest is the list of allowed values
+// for requesting an optional card feature, like multicapture or overcapture,
+// on a payment intent. Allowed values are "if_available" and "never".
+type PaymentIntentPaymentMethodOptionsCardRequest string
+
+// PaymentIntentStatus is the list of allowed values for the status of a
+// payment intent. Allowed values are "canceled", "processing",
+// "requires_action", "requires_capture", "requires_confirmation",
+// "requires_payment_method", and "succeeded".
+type PaymentIntentStatus string
+
+// paymentIntentTransitions are the statuses from which each action can be
+// taken on a payment intent.
+var paymentIntentTransitions = map[PaymentIntentAction][]PaymentIntentStatus{
+	"cancel": {
+		"processing",
+		"requires_action",
+		"requires_capture",
+		"requires_confirmation",
+		"requires_payment_method",
+	},
+	"capture": {
+		"requires_capture",
+	},
+	"confirm": {
+		"requires_confirmation",
+		"requires_payment_method",
+	},
+}
+
+// PaymentIntentAutomaticPaymentMethodsParams is the set of parameters that
+// let Stripe pick the payment methods a payment intent can be paid with,
+// based on the settings of the account, instead of PaymentMethodTypes.
+type PaymentIntentAutomaticPaymentMethodsParams struct {
+	Enabled bool `form:"enabled"`
+}
+
+// PaymentIntentPaymentMethodOptionsACSSDebitMandateOptionsParams is the set
+// of options of the mandate created for an ACSS debit.
+type PaymentIntentPaymentMethodOptionsACSSDebitMandateOptionsParams struct {
+	CustomMandateURL    string                                                    `form:"custom_mandate_url"`
+	IntervalDescription string                                                    `form:"interval_description"`
+	PaymentSchedule     PaymentIntentPaymentMethodOptionsACSSDebitPaymentSchedule `form:"payment_schedule"`
+	TransactionType     PaymentIntentPaymentMethodOptionsACSSDebitTransactionType `form:"transaction_type"`
+}
+
+// PaymentIntentPaymentMethodOptionsACSSDebitParams is the set of ACSS
+// debit-specific options of a payment intent.
+type PaymentIntentPaymentMethodOptionsACSSDebitParams struct {
+	MandateOptions     *PaymentIntentPaymentMethodOptionsACSSDebitMandateOptionsParams `form:"mandate_options"`
+	VerificationMethod PaymentIntentPaymentMethodOptionsVerificationMethod             `form:"verification_method"`
+}
+
+// PaymentIntentPaymentMethodOptionsCardParams is the set of card-specific
+// options of a payment intent.
+type PaymentIntentPaymentMethodOptionsCardParams struct {
+	RequestMulticapture PaymentIntentPaymentMethodOptionsCardRequest             `form:"request_multicapture"`
+	RequestOvercapture  PaymentIntentPaymentMethodOptionsCardRequest             `form:"request_overcapture"`
+	RequestThreeDSecure PaymentIntentPaymentMethodOptionsCardRequestThreeDSecure `form:"request_three_d_secure"`
+}
+
+// PaymentIntentPaymentMethodOptionsSEPADebitMandateOptionsParams is the set
+// of options of the mandate created for a SEPA debit.
+type PaymentIntentPaymentMethodOptionsSEPADebitMandateOptionsParams struct {
+	ReferencePrefix string `form:"reference_prefix"`
+}
+
+// PaymentIntentPaymentMethodOptionsSEPADebitParams is the set of SEPA
+// debit-specific options of a payment intent.
+type PaymentIntentPaymentMethodOptionsSEPADebitParams struct {
+	MandateOptions *PaymentIntentPaymentMethodOptionsSEPADebitMandateOptionsParams `form:"mandate_options"`
+}
+
+// PaymentIntentPaymentMethodOptionsUSBankAccountParams is the set of US bank
+// account-specific options of a payment intent.
+type PaymentIntentPaymentMethodOptionsUSBankAccountParams struct {
+	VerificationMethod PaymentIntentPaymentMethodOptionsVerificationMethod `form:"verification_method"`
+}
+
+// PaymentIntentPaymentMethodOptionsParams is the set of payment method
+// specific options of a payment intent.
+type PaymentIntentPaymentMethodOptionsParams struct {
+	ACSSDebit     *PaymentIntentPaymentMethodOptionsACSSDebitParams     `form:"acss_debit"`
+	Card          *PaymentIntentPaymentMethodOptionsCardParams          `form:"card"`
+	SEPADebit     *PaymentIntentPaymentMethodOptionsSEPADebitParams     `form:"sepa_debit"`
+	USBankAccount *PaymentIntentPaymentMethodOptionsUSBankAccountParams `form:"us_bank_account"`
+}
+
+// PaymentIntentParams is the set of parameters that can be used when creating
+// or updating a payment intent.
+// For more details see https://stripe.com/docs/api#create_payment_intent and https://stripe.com/docs/api#update_payment_intent.
+type PaymentIntentParams struct {
+	Params                  `form:"*"`
+	Amount                  uint64                                      `form:"amount"`
+	ApplicationFeeAmount    uint64                                      `form:"application_fee_amount"`
+	AutomaticPaymentMethods *PaymentIntentAutomaticPaymentMethodsParams `form:"automatic_payment_methods"`
+	CaptureMethod           PaymentIntentCaptureMethod                  `form:"capture_method"`
+	Confirm                 bool                                        `form:"confirm"`
+	ConfirmationMethod      PaymentIntentConfirmationMethod             `form:"confirmation_method"`
+	Currency                Currency                                    `form:"currency"`
+	Customer                string                                      `form:"customer"`
+	Desc                    string                                      `form:"description"`
+	Email                   string                                      `form:"receipt_email"`
+	MandateData             *MandateDataParams                          `form:"mandate_data"`
+	OnBehalfOf              string                                      `form:"on_behalf_of"`
+	PaymentMethod           string                                      `form:"payment_method"`
+	PaymentMethodOptions    *PaymentIntentPaymentMethodOptionsParams    `form:"payment_method_options"`
+	PaymentMethodTypes      []string                                    `form:"payment_method_types"`
+	ReturnURL               string                                      `form:"return_url"`
+	Shipping                *ShippingDetails                            `form:"shipping"`
+	Statement               string                                      `form:"statement_descriptor"`
+	StatementSuffix         string                                      `form:"statement_descriptor_suffix"`
+	TransferGroup           string                                      `form:"transfer_group"`
+}
+
+// PaymentIntentCancelParams is the set of parameters that can be used when
+// canceling a payment intent.
+// For more details see https://stripe.com/docs/api#cancel_payment_intent.
+type PaymentIntentCancelParams struct {
+	Params             `form:"*"`
+	CancellationReason PaymentIntentCancellationReason `form:"cancellation_reason"`
+}
+
+// PaymentIntentCaptureParams is the set of parameters that can be used when
+// capturing a payment intent. AmountToCapture captures less than the
+// authorized amount, or more when overcapture is available. With
+// multicapture, NoFinalCapture leaves the rest of the amount capturable by
+// later captures.
+// For more details see https://stripe.com/docs/api#capture_payment_intent.
+type PaymentIntentCaptureParams struct {
+	Params               `form:"*"`
+	AmountToCapture      uint64 `form:"amount_to_capture"`
+	ApplicationFeeAmount uint64 `form:"application_fee_amount"`
+	NoFinalCapture       bool   `form:"final_capture,invert"`
+}
+
+// PaymentIntentConfirmParams is the set of parameters that can be used when
+// confirming a payment intent.
+// For more details see https://stripe.com/docs/api#confirm_payment_intent.
+type PaymentIntentConfirmParams struct {
+	Params               `form:"*"`
+	Email                string                                   `form:"receipt_email"`
+	MandateData          *MandateDataParams                       `form:"mandate_data"`
+	PaymentMethod        string                                   `form:"payment_method"`
+	PaymentMethodOptions *PaymentIntentPaymentMethodOptionsParams `form:"payment_method_options"`
+	ReturnURL            string                                   `form:"return_url"`
+	Shipping             *ShippingDetails                         `form:"shipping"`
+}
+
+// PaymentIntentListParams is the set of parameters that can be used when
+// listing payment intents.
+// For more details see https://stripe.com/docs/api#list_payment_intents.
+type PaymentIntentListParams struct {
+	ListParams   `form:"*"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Customer     string            `form:"customer"`
+}
+
+// PaymentIntentAutomaticPaymentMethods describes whether Stripe picks the
+// payment methods a payment intent can be paid with.
+type PaymentIntentAutomaticPaymentMethods struct {
+	Enabled bool `json:"enabled"`
+}
+
+// PaymentIntentNextActionRedirectToURL is the redirect the customer must
+// follow, typically to authenticate with 3D Secure, before returning to
+// ReturnURL.
+type PaymentIntentNextActionRedirectToURL struct {
+	ReturnURL string `json:"return_url"`
+	URL       string `json:"url"`
+}
+
+// PaymentIntentFinancialAddressABA is the details of an ABA bank account.
+type PaymentIntentFinancialAddressABA struct {
+	AccountNumber string `json:"account_number"`
+	BankName      string `json:"bank_name"`
+	RoutingNumber string `json:"routing_number"`
+}
+
+// PaymentIntentFinancialAddressIBAN is the details of an IBAN bank account.
+type PaymentIntentFinancialAddressIBAN struct {
+	AccountHolderName string `json:"account_holder_name"`
+	BIC               string `json:"bic"`
+	Country           string `json:"country"`
+	IBAN              string `json:"iban"`
+}
+
+// PaymentIntentFinancialAddressSortCode is the details of a UK bank account.
+type PaymentIntentFinancialAddressSortCode struct {
+	AccountHolderName string `json:"account_holder_name"`
+	AccountNumber     string `json:"account_number"`
+	SortCode          string `json:"sort_code"`
+}
+
+// PaymentIntentFinancialAddress is a bank account a customer can send a bank
+// transfer to. Type indicates which of the other fields is filled in.
+type PaymentIntentFinancialAddress struct {
+	ABA               *PaymentIntentFinancialAddressABA      `json:"aba"`
+	IBAN              *PaymentIntentFinancialAddressIBAN     `json:"iban"`
+	SortCode          *PaymentIntentFinancialAddressSortCode `json:"sort_code"`
+	SupportedNetworks []string                               `json:"supported_networks"`
+	Type              string                                 `json:"type"`
+}
+
+// PaymentIntentNextActionDisplayBankTransferInstructions is the instructions
+// to show to a customer who pays with a bank transfer.
+type PaymentIntentNextActionDisplayBankTransferInstructions struct {
+	AmountRemaining       int64                            `json:"amount_remaining"`
+	Currency              Currency                         `json:"currency"`
+	FinancialAddresses    []*PaymentIntentFinancialAddress `json:"financial_addresses"`
+	HostedInstructionsURL string                           `json:"hosted_instructions_url"`
+	Reference             string                           `json:"reference"`
+	Type                  string                           `json:"type"`
+}
+
+// PaymentIntentNextActionVerifyWithMicrodeposits is the information needed to
+// verify a bank account with the microdeposits sent to it.
+type PaymentIntentNextActionVerifyWithMicrodeposits struct {
+	ArrivalDate           Timestamp `json:"arrival_date"`
+	HostedVerificationURL string    `json:"hosted_verification_url"`
+	MicrodepositType      string    `json:"microdeposit_type"`
+}
+
+// PaymentIntentNextAction is the action required to move a payment intent
+// forward when its status is "requires_action". Type indicates which of the
+// other fields is filled in.
+type PaymentIntentNextAction struct {
+	DisplayBankTransferInstructions *PaymentIntentNextActionDisplayBankTransferInstructions `json:"display_bank_transfer_instructions"`
+	RedirectToURL                   *PaymentIntentNextActionRedirectToURL                   `json:"redirect_to_url"`
+	Type                            PaymentIntentNextActionType                             `json:"type"`
+
+	// UseStripeSDK is meant to be handed unchanged to Stripe.js or the mobile
+	// SDKs, which handle the action themselves. Its contents aren't
+	// documented and may change, so it's left untyped.
+	UseStripeSDK map[string]interface{} `json:"use_stripe_sdk"`
+
+	VerifyWithMicrodeposits *PaymentIntentNextActionVerifyWithMicrodeposits `json:"verify_with_microdeposits"`
+}
+
+// PaymentIntent is the resource representing a Stripe payment intent.
+// For more details see https://stripe.com/docs/api#payment_intents.
+type PaymentIntent struct {
+	APIResource
+	Amount                  uint64                                `json:"amount"`
+	AmountCapturable        uint64                                `json:"amount_capturable"`
+	AmountReceived          uint64                                `json:"amount_received"`
+	Application             *Application                          `json:"application"`
+	ApplicationFeeAmount    uint64                                `json:"application_fee_amount"`
+	AutomaticPaymentMethods *PaymentIntentAutomaticPaymentMethods `json:"automatic_payment_methods"`
+	CanceledAt              Timestamp                             `json:"canceled_at"`
+	CancellationReason      PaymentIntentCancellationReason       `json:"cancellation_reason"`
+	CaptureMethod           PaymentIntentCaptureMethod            `json:"capture_method"`
+	Charges                 *ChargeList                           `json:"charges"`
+	ClientSecret            string                                `json:"client_secret"`
+	ConfirmationMethod      PaymentIntentConfirmationMethod       `json:"confirmation_method"`
+	Created                 Timestamp                             `json:"created"`
+	Currency                Currency                              `json:"currency"`
+	Customer                *Customer                             `json:"customer"`
+	Desc                    string                                `json:"description"`
+	Email                   string                                `json:"receipt_email"`
+	ID                      string                                `json:"id"`
+	LastPaymentError        *Error                                `json:"last_payment_error"`
+	Live                    bool                                  `json:"livemode"`
+	Meta                    map[string]string                     `json:"metadata"`
+	NextAction              *PaymentIntentNextAction              `json:"next_action"`
+	OnBehalfOf              *Account                              `json:"on_behalf_of"`
+	PaymentMethod           string                                `json:"payment_method"`
+	PaymentMethodTypes      []string                              `json:"payment_method_types"`
+	Shipping                *ShippingDetails                      `json:"shipping"`
+	Statement               string                                `json:"statement_descriptor"`
+	StatementSuffix         string                                `json:"statement_descriptor_suffix"`
+	Status                  PaymentIntentStatus                   `json:"status"`
+	TransferGroup           string                                `json:"transfer_group"`
+}
+
+// PaymentIntentList is a list of payment intents as retrieved from a list
+// endpoint.
+type PaymentIntentList struct {
+	APIResource
+	ListMeta
+	Values []*PaymentIntent `json:"data"`
+}
+
+// PaymentIntentTransitionError is the error returned when an action can't be
+// taken on a payment intent because of its status, like capturing an intent
+// that was canceled.
+type PaymentIntentTransitionError struct {
+	Action PaymentIntentAction
+	ID     string
+	Status PaymentIntentStatus
+}
+
+// Error returns a description of the rejected action.
+func (e *PaymentIntentTransitionError) Error() string {
+	return fmt.Sprintf("cannot %v payment intent %v in status %v", e.Action, e.ID, e.Status)
+}
+
+// CheckAction returns a *PaymentIntentTransitionError if action can't be
+// taken on the payment intent given its current status. It allows rejecting
+// an illegal action without a round trip to the API, which would reject it as
+// well. Actions that it doesn't know about are always allowed.
+func (p *PaymentIntent) CheckAction(action PaymentIntentAction) error {
+	statuses, ok := paymentIntentTransitions[action]
+	if !ok {
+		return nil
+	}
+
+	for _, status := range statuses {
+		if p.Status == status {
+			return nil
+		}
+	}
+
+	return &PaymentIntentTransitionError{Action: action, ID: p.ID, Status: p.Status}
+}
+
+// UnmarshalJSON handles deserialization of a PaymentIntent.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (p *PaymentIntent) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		p.ID = id
+		return nil
+	}
+
+	type paymentIntent PaymentIntent
+	var pp paymentIntent
+	err := json.Unmarshal(data, &pp)
+	if err != nil {
+		return err
+	}
+
+	*p = PaymentIntent(pp)
+	return nil
+}
+
+// PaymentIntentID returns the ID of the payment intent that created the
+// charge, or an empty string if it wasn't created by one.
+func (c *Charge) PaymentIntentID() string {
+	if c.PaymentIntent == nil {
+		return ""
+	}
+	return c.PaymentIntent.ID
+}
+
+// PaymentIntentID returns the ID of the payment intent of the refunded
+// payment, falling back to the payment intent of the charge if it was
+// expanded.
+func (r *Refund) PaymentIntentID() string {
+	if r.PaymentIntent != nil {
+		return r.PaymentIntent.ID
+	}
+	if r.Charge != nil {
+		return r.Charge.PaymentIntentID()
+	}
+	return ""
+}
+
+// PaymentIntentID returns the ID of the payment intent of the disputed
+// payment, falling back to the payment intent of the charge if it was
+// expanded.
+func (d *Dispute) PaymentIntentID() string {
+	if d.PaymentIntent != nil {
+		return d.PaymentIntent.ID
+	}
+	if d.Charge != nil {
+		return d.Charge.PaymentIntentID()
+	}
+	return ""
+}
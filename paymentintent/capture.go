@@ -0,0 +1,147 @@
+package paymentintent
+
+import (
+	"time"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/charge"
+)
+
+// NeedsCapture returns true if the payment intent was authorized but hasn't
+// been captured or canceled yet.
+func NeedsCapture(pi *stripe.PaymentIntent) bool {
+	return pi != nil && pi.Status == StatusRequiresCapture
+}
+
+// CaptureBefore returns the time by which an uncaptured payment intent must
+// be captured before its authorization expires. It's the deadline of its
+// uncaptured charge if it was retrieved with its charges, and otherwise
+// follows charge.CaptureWindows for its payment method type. It returns a
+// zero time if the payment intent doesn't need to be captured.
+func CaptureBefore(pi *stripe.PaymentIntent) time.Time {
+	if !NeedsCapture(pi) {
+		return time.Time{}
+	}
+
+	if pi.Charges != nil {
+		for _, ch := range pi.Charges.Values {
+			if captureBefore := charge.CaptureBefore(ch); !captureBefore.IsZero() {
+				return captureBefore
+			}
+		}
+	}
+
+	window := charge.DefaultCaptureWindow
+	if len(pi.PaymentMethodTypes) > 0 {
+		if w, ok := charge.CaptureWindows[pi.PaymentMethodTypes[0]]; ok {
+			window = w
+		}
+	}
+	return pi.Created.Time().Add(window)
+}
+
+// ExpiringPaymentIntents lists payment intents and returns the ones that need
+// to be captured within the given duration from now. Params may be used to
+// narrow down the payment intents that are listed; if it doesn't specify a
+// creation range, only payment intents created recently enough to still be
+// capturable are listed.
+func ExpiringPaymentIntents(params *stripe.PaymentIntentListParams, within time.Duration) ([]*stripe.PaymentIntent, error) {
+	return getC().ExpiringPaymentIntents(params, within)
+}
+
+func (c Client) ExpiringPaymentIntents(params *stripe.PaymentIntentListParams, within time.Duration) ([]*stripe.PaymentIntent, error) {
+	p := stripe.PaymentIntentListParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.Created == 0 && p.CreatedRange == nil {
+		p.CreatedRange = &stripe.RangeQueryParams{
+			GreaterThanOrEqual: time.Now().Add(-longestCaptureWindow()).Unix(),
+		}
+	}
+
+	deadline := time.Now().Add(within)
+
+	var intents []*stripe.PaymentIntent
+	i := c.List(&p)
+	for i.Next() {
+		pi := i.PaymentIntent()
+		captureBefore := CaptureBefore(pi)
+		if !captureBefore.IsZero() && !captureBefore.After(deadline) {
+			intents = append(intents, pi)
+		}
+	}
+
+	return intents, i.Err()
+}
+
+// SettleResult is the result of settling a single payment intent with
+// Settle.
+type SettleResult struct {
+	// Canceled is the canceled payment intent, if the payment intent was
+	// canceled.
+	Canceled *stripe.PaymentIntent
+
+	// Captured is the captured payment intent, if the payment intent was
+	// captured.
+	Captured *stripe.PaymentIntent
+
+	// Err is the error encountered while capturing or canceling the payment
+	// intent, if any.
+	Err error
+
+	// PaymentIntent is the payment intent that was settled.
+	PaymentIntent *stripe.PaymentIntent
+}
+
+// Settle captures or cancels each of the given uncaptured payment intents,
+// which will typically have come from ExpiringPaymentIntents. The capture
+// function decides what happens to each payment intent: it's captured in full
+// if capture returns true, and canceled (which releases the authorization)
+// otherwise.
+//
+// Payment intents are settled one after the other, and an error settling one
+// of them doesn't prevent the others from being settled; check the Err of
+// each result.
+//
+// Only the StripeAccount of params is used, for payment intents of a
+// connected account; params may be nil.
+func Settle(intents []*stripe.PaymentIntent, capture func(*stripe.PaymentIntent) bool, params *stripe.Params) []*SettleResult {
+	return getC().Settle(intents, capture, params)
+}
+
+func (c Client) Settle(intents []*stripe.PaymentIntent, capture func(*stripe.PaymentIntent) bool, params *stripe.Params) []*SettleResult {
+	var stripeAccount string
+	if params != nil {
+		stripeAccount = params.StripeAccount
+	}
+
+	results := make([]*SettleResult, len(intents))
+	for i, pi := range intents {
+		result := &SettleResult{PaymentIntent: pi}
+
+		if capture(pi) {
+			captureParams := &stripe.PaymentIntentCaptureParams{}
+			captureParams.StripeAccount = stripeAccount
+			result.Captured, result.Err = c.Capture(pi.ID, captureParams)
+		} else {
+			cancelParams := &stripe.PaymentIntentCancelParams{}
+			cancelParams.StripeAccount = stripeAccount
+			result.Canceled, result.Err = c.Cancel(pi.ID, cancelParams)
+		}
+
+		results[i] = result
+	}
+
+	return results
+}
+
+func longestCaptureWindow() time.Duration {
+	longest := charge.DefaultCaptureWindow
+	for _, window := range charge.CaptureWindows {
+		if window > longest {
+			longest = window
+		}
+	}
+	return longest
+}
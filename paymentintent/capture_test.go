@@ -0,0 +1,78 @@
+package paymentintent
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/charge"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestPaymentIntentCaptureBefore(t *testing.T) {
+	created := time.Date(2017, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	pi := &stripe.PaymentIntent{
+		Created:            stripe.NewTimestamp(created),
+		PaymentMethodTypes: []string{"card"},
+		Status:             StatusRequiresCapture,
+	}
+	assert.Equal(t, created.Add(charge.DefaultCaptureWindow).Unix(), CaptureBefore(pi).Unix())
+
+	// The deadline of the uncaptured charge wins when it's known
+	chargeCreated := created.Add(time.Hour)
+	pi.Charges = &stripe.ChargeList{Values: []*stripe.Charge{
+		{Created: stripe.NewTimestamp(chargeCreated)},
+	}}
+	assert.Equal(t, chargeCreated.Add(charge.DefaultCaptureWindow).Unix(), CaptureBefore(pi).Unix())
+
+	// Captured payment intents don't have a deadline
+	pi.Status = StatusSucceeded
+	assert.True(t, CaptureBefore(pi).IsZero())
+}
+
+func TestPaymentIntentSettle(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/payment_intents/pi_capture/capture", `{"id": "pi_capture", "status": "succeeded"}`)
+	b.Respond("POST", "/payment_intents/pi_cancel/cancel", `{"id": "pi_cancel", "status": "canceled"}`)
+
+	intents := []*stripe.PaymentIntent{{ID: "pi_capture"}, {ID: "pi_cancel"}}
+	results := Client{B: b}.Settle(intents, func(pi *stripe.PaymentIntent) bool {
+		return pi.ID == "pi_capture"
+	}, &stripe.Params{StripeAccount: "acct_123"})
+	assert.Equal(t, 2, len(results))
+
+	assert.Nil(t, results[0].Err)
+	assert.Equal(t, StatusSucceeded, results[0].Captured.Status)
+	assert.Nil(t, results[0].Canceled)
+
+	assert.Nil(t, results[1].Err)
+	assert.Nil(t, results[1].Captured)
+	assert.Equal(t, StatusCanceled, results[1].Canceled.Status)
+
+	// Both calls are made on the connected account
+	calls := b.Calls()
+	assert.Equal(t, 2, len(calls))
+	assert.Equal(t, "acct_123", calls[0].Params.StripeAccount)
+	assert.Equal(t, "acct_123", calls[1].Params.StripeAccount)
+}
+
+func TestExpiringPaymentIntents(t *testing.T) {
+	b := testbackend.New()
+	now := time.Now()
+	b.Respond("GET", "/payment_intents", `{"data": [
+		{"id": "pi_expiring", "status": "requires_capture", "created": `+
+		strconv.FormatInt(now.Add(-charge.DefaultCaptureWindow+time.Hour).Unix(), 10)+`},
+		{"id": "pi_recent", "status": "requires_capture", "created": `+strconv.FormatInt(now.Unix(), 10)+`},
+		{"id": "pi_captured", "status": "succeeded", "created": `+
+		strconv.FormatInt(now.Add(-charge.DefaultCaptureWindow+time.Hour).Unix(), 10)+`}
+	]}`)
+
+	intents, err := Client{B: b}.ExpiringPaymentIntents(nil, 2*time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(intents))
+	assert.Equal(t, "pi_expiring", intents[0].ID)
+	assert.NotEqual(t, "", b.LastCall().Values().Get("created[gte]"))
+}
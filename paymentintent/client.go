@@ -0,0 +1,276 @@
+// Package paymentintent provides the /payment_intents APIs
+package paymentintent
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/charge"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	ActionCancel  stripe.PaymentIntentAction = "cancel"
+	ActionCapture stripe.PaymentIntentAction = "capture"
+	ActionConfirm stripe.PaymentIntentAction = "confirm"
+
+	CancellationReasonAbandoned           stripe.PaymentIntentCancellationReason = "abandoned"
+	CancellationReasonAutomatic           stripe.PaymentIntentCancellationReason = "automatic"
+	CancellationReasonDuplicate           stripe.PaymentIntentCancellationReason = "duplicate"
+	CancellationReasonFailedInvoice       stripe.PaymentIntentCancellationReason = "failed_invoice"
+	CancellationReasonFraudulent          stripe.PaymentIntentCancellationReason = "fraudulent"
+	CancellationReasonRequestedByCustomer stripe.PaymentIntentCancellationReason = "requested_by_customer"
+	CancellationReasonVoidInvoice         stripe.PaymentIntentCancellationReason = "void_invoice"
+
+	CaptureMethodAutomatic stripe.PaymentIntentCaptureMethod = "automatic"
+	CaptureMethodManual    stripe.PaymentIntentCaptureMethod = "manual"
+
+	CardRequestIfAvailable stripe.PaymentIntentPaymentMethodOptionsCardRequest = "if_available"
+	CardRequestNever       stripe.PaymentIntentPaymentMethodOptionsCardRequest = "never"
+
+	CardRequestThreeDSecureAny       stripe.PaymentIntentPaymentMethodOptionsCardRequestThreeDSecure = "any"
+	CardRequestThreeDSecureAutomatic stripe.PaymentIntentPaymentMethodOptionsCardRequestThreeDSecure = "automatic"
+
+	ACSSDebitPaymentScheduleCombined stripe.PaymentIntentPaymentMethodOptionsACSSDebitPaymentSchedule = "combined"
+	ACSSDebitPaymentScheduleInterval stripe.PaymentIntentPaymentMethodOptionsACSSDebitPaymentSchedule = "interval"
+	ACSSDebitPaymentScheduleSporadic stripe.PaymentIntentPaymentMethodOptionsACSSDebitPaymentSchedule = "sporadic"
+
+	ACSSDebitTransactionTypeBusiness stripe.PaymentIntentPaymentMethodOptionsACSSDebitTransactionType = "business"
+	ACSSDebitTransactionTypePersonal stripe.PaymentIntentPaymentMethodOptionsACSSDebitTransactionType = "personal"
+
+	VerificationMethodAutomatic     stripe.PaymentIntentPaymentMethodOptionsVerificationMethod = "automatic"
+	VerificationMethodInstant       stripe.PaymentIntentPaymentMethodOptionsVerificationMethod = "instant"
+	VerificationMethodMicrodeposits stripe.PaymentIntentPaymentMethodOptionsVerificationMethod = "microdeposits"
+
+	ConfirmationMethodAutomatic stripe.PaymentIntentConfirmationMethod = "automatic"
+	ConfirmationMethodManual    stripe.PaymentIntentConfirmationMethod = "manual"
+
+	NextActionTypeDisplayBankTransferInstructions stripe.PaymentIntentNextActionType = "display_bank_transfer_instructions"
+	NextActionTypeRedirectToURL                   stripe.PaymentIntentNextActionType = "redirect_to_url"
+	NextActionTypeUseStripeSDK                    stripe.PaymentIntentNextActionType = "use_stripe_sdk"
+	NextActionTypeVerifyWithMicrodeposits         stripe.PaymentIntentNextActionType = "verify_with_microdeposits"
+
+	StatusCanceled              stripe.PaymentIntentStatus = "canceled"
+	StatusProcessing            stripe.PaymentIntentStatus = "processing"
+	StatusRequiresAction        stripe.PaymentIntentStatus = "requires_action"
+	StatusRequiresCapture       stripe.PaymentIntentStatus = "requires_capture"
+	StatusRequiresConfirmation  stripe.PaymentIntentStatus = "requires_confirmation"
+	StatusRequiresPaymentMethod stripe.PaymentIntentStatus = "requires_payment_method"
+	StatusSucceeded             stripe.PaymentIntentStatus = "succeeded"
+)
+
+// Client is used to invoke /payment_intents APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new payment intent.
+// For more details see https://stripe.com/docs/api#create_payment_intent.
+func New(params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	paymentIntent := &stripe.PaymentIntent{}
+	err := c.B.Call("POST", "/payment_intents", c.Key, body, commonParams, paymentIntent)
+
+	return paymentIntent, err
+}
+
+// Get returns the details of a payment intent.
+// For more details see https://stripe.com/docs/api#retrieve_payment_intent.
+func Get(id string, params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	paymentIntent := &stripe.PaymentIntent{}
+	err := c.B.Call("GET", fmt.Sprintf("/payment_intents/%v", id), c.Key, body, commonParams, paymentIntent)
+
+	return paymentIntent, err
+}
+
+// GetForCharge returns the details of the payment intent that created a
+// charge, or an error if the charge wasn't created by a payment intent.
+func GetForCharge(chargeID string, params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	return getC().GetForCharge(chargeID, params)
+}
+
+func (c Client) GetForCharge(chargeID string, params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	// The charge belongs to the same account as the payment intent
+	var chargeParams *stripe.ChargeParams
+	if params != nil && params.StripeAccount != "" {
+		chargeParams = &stripe.ChargeParams{Params: stripe.Params{StripeAccount: params.StripeAccount}}
+	}
+
+	ch, err := charge.Client{B: c.B, Key: c.Key}.Get(chargeID, chargeParams)
+	if err != nil {
+		return nil, err
+	}
+
+	id := ch.PaymentIntentID()
+	if id == "" {
+		return nil, fmt.Errorf("charge %v wasn't created by a payment intent", chargeID)
+	}
+
+	return c.Get(id, params)
+}
+
+// Update updates a payment intent's properties.
+// For more details see https://stripe.com/docs/api#update_payment_intent.
+func Update(id string, params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	paymentIntent := &stripe.PaymentIntent{}
+	err := c.B.Call("POST", fmt.Sprintf("/payment_intents/%v", id), c.Key, body, commonParams, paymentIntent)
+
+	return paymentIntent, err
+}
+
+// Cancel cancels a payment intent. See PaymentIntent.CheckAction to reject
+// cancellations that would fail without calling the API.
+// For more details see https://stripe.com/docs/api#cancel_payment_intent.
+func Cancel(id string, params *stripe.PaymentIntentCancelParams) (*stripe.PaymentIntent, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.PaymentIntentCancelParams) (*stripe.PaymentIntent, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	paymentIntent := &stripe.PaymentIntent{}
+	err := c.B.Call("POST", fmt.Sprintf("/payment_intents/%v/cancel", id), c.Key, body, commonParams, paymentIntent)
+
+	return paymentIntent, err
+}
+
+// Capture captures the funds of a payment intent that was confirmed with
+// manual capture. See PaymentIntent.CheckAction to reject captures that would
+// fail without calling the API.
+// For more details see https://stripe.com/docs/api#capture_payment_intent.
+func Capture(id string, params *stripe.PaymentIntentCaptureParams) (*stripe.PaymentIntent, error) {
+	return getC().Capture(id, params)
+}
+
+func (c Client) Capture(id string, params *stripe.PaymentIntentCaptureParams) (*stripe.PaymentIntent, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	paymentIntent := &stripe.PaymentIntent{}
+	err := c.B.Call("POST", fmt.Sprintf("/payment_intents/%v/capture", id), c.Key, body, commonParams, paymentIntent)
+
+	return paymentIntent, err
+}
+
+// Confirm confirms a payment intent. See PaymentIntent.CheckAction to reject
+// confirmations that would fail without calling the API.
+// For more details see https://stripe.com/docs/api#confirm_payment_intent.
+func Confirm(id string, params *stripe.PaymentIntentConfirmParams) (*stripe.PaymentIntent, error) {
+	return getC().Confirm(id, params)
+}
+
+func (c Client) Confirm(id string, params *stripe.PaymentIntentConfirmParams) (*stripe.PaymentIntent, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	paymentIntent := &stripe.PaymentIntent{}
+	err := c.B.Call("POST", fmt.Sprintf("/payment_intents/%v/confirm", id), c.Key, body, commonParams, paymentIntent)
+
+	return paymentIntent, err
+}
+
+// List returns a list of payment intents.
+// For more details see https://stripe.com/docs/api#list_payment_intents.
+func List(params *stripe.PaymentIntentListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.PaymentIntentListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.PaymentIntentList{}
+		err := c.B.Call("GET", "/payment_intents", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of PaymentIntents.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// PaymentIntent returns the most recent PaymentIntent
+// visited by a call to Next.
+func (i *Iter) PaymentIntent() *stripe.PaymentIntent {
+	return i.Current().(*stripe.PaymentIntent)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
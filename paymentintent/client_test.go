@@ -0,0 +1,291 @@
+package paymentintent
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/mandate"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestPaymentIntentCancel(t *testing.T) {
+	intent, err := Cancel("pi_123", &stripe.PaymentIntentCancelParams{
+		CancellationReason: CancellationReasonRequestedByCustomer,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, intent)
+}
+
+func TestPaymentIntentCapture(t *testing.T) {
+	intent, err := Capture("pi_123", &stripe.PaymentIntentCaptureParams{
+		AmountToCapture: 123,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, intent)
+}
+
+func TestPaymentIntentCapturePartial(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/payment_intents/pi_123/capture", `{
+		"id": "pi_123",
+		"amount": 1000,
+		"amount_capturable": 600,
+		"amount_received": 400,
+		"status": "requires_capture",
+		"charges": {"data": [{
+			"id": "ch_123",
+			"amount_captured": 400,
+			"payment_method_details": {
+				"type": "card",
+				"card": {"amount_authorized": 1000, "multicapture": {"status": "available"}}
+			}
+		}]}
+	}`)
+
+	intent, err := Client{B: b}.Capture("pi_123", &stripe.PaymentIntentCaptureParams{
+		AmountToCapture: 400,
+		NoFinalCapture:  true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(600), intent.AmountCapturable)
+	assert.Equal(t, uint64(400), intent.AmountReceived)
+
+	ch := intent.Charges.Values[0]
+	assert.Equal(t, uint64(400), ch.AmountCaptured)
+	assert.Equal(t, "available", ch.PaymentMethodDetails.Card.Multicapture.Status)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "400", values.Get("amount_to_capture"))
+	assert.Equal(t, "false", values.Get("final_capture"))
+}
+
+func TestPaymentIntentCheckAction(t *testing.T) {
+	intent := &stripe.PaymentIntent{ID: "pi_123", Status: StatusCanceled}
+
+	err := intent.CheckAction(ActionCapture)
+	assert.NotNil(t, err)
+	transitionErr, ok := err.(*stripe.PaymentIntentTransitionError)
+	assert.True(t, ok)
+	assert.Equal(t, ActionCapture, transitionErr.Action)
+	assert.Equal(t, StatusCanceled, transitionErr.Status)
+	assert.Equal(t, "cannot capture payment intent pi_123 in status canceled", err.Error())
+
+	intent.Status = StatusRequiresCapture
+	assert.Nil(t, intent.CheckAction(ActionCapture))
+	assert.Nil(t, intent.CheckAction(ActionCancel))
+	assert.NotNil(t, intent.CheckAction(ActionConfirm))
+
+	// Some payment methods, like ACH debits, can be canceled while processing
+	intent.Status = StatusProcessing
+	assert.Nil(t, intent.CheckAction(ActionCancel))
+	assert.NotNil(t, intent.CheckAction(ActionCapture))
+}
+
+func TestPaymentIntentConfirm(t *testing.T) {
+	intent, err := Confirm("pi_123", &stripe.PaymentIntentConfirmParams{
+		PaymentMethod: "pm_123",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, intent)
+}
+
+func TestPaymentIntentConfirmMandateData(t *testing.T) {
+	intent, err := Confirm("pi_123", &stripe.PaymentIntentConfirmParams{
+		MandateData: &stripe.MandateDataParams{
+			CustomerAcceptance: &stripe.MandateCustomerAcceptanceParams{
+				Online: &stripe.MandateCustomerAcceptanceOnlineParams{
+					IPAddress: "127.0.0.1",
+					UserAgent: "Mozilla/5.0",
+				},
+				Type: mandate.CustomerAcceptanceTypeOnline,
+			},
+		},
+		PaymentMethod: "pm_123",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, intent)
+}
+
+func TestPaymentIntentGet(t *testing.T) {
+	intent, err := Get("pi_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, intent)
+}
+
+func TestPaymentIntentGetForCharge(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/charges/ch_123", `{"id": "ch_123", "payment_intent": "pi_123"}`)
+	b.Respond("GET", "/charges/ch_456", `{"id": "ch_456", "payment_intent": null}`)
+	b.Respond("GET", "/payment_intents/pi_123", `{"id": "pi_123", "status": "succeeded"}`)
+	c := Client{B: b}
+
+	intent, err := c.GetForCharge("ch_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "pi_123", intent.ID)
+	assert.Equal(t, StatusSucceeded, intent.Status)
+
+	// Charges that weren't created by a payment intent have none
+	_, err = c.GetForCharge("ch_456", nil)
+	assert.NotNil(t, err)
+}
+
+func TestPaymentIntentGetForChargeStripeAccount(t *testing.T) {
+	var accounts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accounts = append(accounts, r.Header.Get("Stripe-Account"))
+		if r.URL.Path == "/v1/charges/ch_123" {
+			w.Write([]byte(`{"id": "ch_123", "payment_intent": "pi_123"}`))
+			return
+		}
+		w.Write([]byte(`{"id": "pi_123"}`))
+	}))
+	defer server.Close()
+
+	c := Client{B: &stripe.BackendConfiguration{URL: server.URL + "/v1", HTTPClient: http.DefaultClient}}
+	params := &stripe.PaymentIntentParams{}
+	params.SetStripeAccount("acct_123")
+
+	intent, err := c.GetForCharge("ch_123", params)
+	assert.Nil(t, err)
+	assert.Equal(t, "pi_123", intent.ID)
+
+	// Both the charge and the payment intent are retrieved from the
+	// connected account
+	assert.Equal(t, []string{"acct_123", "acct_123"}, accounts)
+}
+
+func TestPaymentIntentGetNextAction(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/payment_intents/pi_123", `{
+		"id": "pi_123",
+		"status": "requires_action",
+		"next_action": {
+			"type": "redirect_to_url",
+			"redirect_to_url": {"return_url": "https://example.com/return", "url": "https://hooks.stripe.com/3d_secure"}
+		}
+	}`)
+	b.Respond("GET", "/payment_intents/pi_456", `{
+		"id": "pi_456",
+		"status": "requires_action",
+		"next_action": {
+			"type": "display_bank_transfer_instructions",
+			"display_bank_transfer_instructions": {
+				"amount_remaining": 1000,
+				"currency": "eur",
+				"financial_addresses": [{"type": "iban", "iban": {"bic": "BIC123", "iban": "DE00123"}}],
+				"reference": "REF123"
+			}
+		}
+	}`)
+
+	intent, err := Client{B: b}.Get("pi_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, NextActionTypeRedirectToURL, intent.NextAction.Type)
+	assert.Equal(t, "https://hooks.stripe.com/3d_secure", intent.NextAction.RedirectToURL.URL)
+
+	intent, err = Client{B: b}.Get("pi_456", nil)
+	assert.Nil(t, err)
+	instructions := intent.NextAction.DisplayBankTransferInstructions
+	assert.Equal(t, NextActionTypeDisplayBankTransferInstructions, intent.NextAction.Type)
+	assert.Equal(t, int64(1000), instructions.AmountRemaining)
+	assert.Equal(t, "DE00123", instructions.FinancialAddresses[0].IBAN.IBAN)
+	assert.Equal(t, "REF123", instructions.Reference)
+}
+
+func TestPaymentIntentList(t *testing.T) {
+	i := List(&stripe.PaymentIntentListParams{Customer: "cus_123"})
+
+	// Verify that we can get at least one payment intent
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.PaymentIntent())
+}
+
+func TestPaymentIntentNew(t *testing.T) {
+	intent, err := New(&stripe.PaymentIntentParams{
+		Amount:             123,
+		CaptureMethod:      CaptureMethodManual,
+		Currency:           "usd",
+		PaymentMethodTypes: []string{"card"},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, intent)
+}
+
+func TestPaymentIntentNewMulticapture(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/payment_intents", `{"id": "pi_123"}`)
+
+	_, err := Client{B: b}.New(&stripe.PaymentIntentParams{
+		Amount:        1000,
+		CaptureMethod: CaptureMethodManual,
+		Currency:      "usd",
+		PaymentMethodOptions: &stripe.PaymentIntentPaymentMethodOptionsParams{
+			Card: &stripe.PaymentIntentPaymentMethodOptionsCardParams{
+				RequestMulticapture: CardRequestIfAvailable,
+				RequestOvercapture:  CardRequestNever,
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "if_available", values.Get("payment_method_options[card][request_multicapture]"))
+	assert.Equal(t, "never", values.Get("payment_method_options[card][request_overcapture]"))
+}
+
+func TestPaymentIntentNewPaymentMethodOptions(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/payment_intents", `{"id": "pi_123", "automatic_payment_methods": {"enabled": true}}`)
+
+	intent, err := Client{B: b}.New(&stripe.PaymentIntentParams{
+		Amount:   1000,
+		Currency: "eur",
+		AutomaticPaymentMethods: &stripe.PaymentIntentAutomaticPaymentMethodsParams{
+			Enabled: true,
+		},
+		PaymentMethodOptions: &stripe.PaymentIntentPaymentMethodOptionsParams{
+			ACSSDebit: &stripe.PaymentIntentPaymentMethodOptionsACSSDebitParams{
+				MandateOptions: &stripe.PaymentIntentPaymentMethodOptionsACSSDebitMandateOptionsParams{
+					PaymentSchedule: ACSSDebitPaymentScheduleSporadic,
+					TransactionType: ACSSDebitTransactionTypePersonal,
+				},
+				VerificationMethod: VerificationMethodMicrodeposits,
+			},
+			Card: &stripe.PaymentIntentPaymentMethodOptionsCardParams{
+				RequestThreeDSecure: CardRequestThreeDSecureAny,
+			},
+			SEPADebit: &stripe.PaymentIntentPaymentMethodOptionsSEPADebitParams{
+				MandateOptions: &stripe.PaymentIntentPaymentMethodOptionsSEPADebitMandateOptionsParams{
+					ReferencePrefix: "ACME",
+				},
+			},
+			USBankAccount: &stripe.PaymentIntentPaymentMethodOptionsUSBankAccountParams{
+				VerificationMethod: VerificationMethodAutomatic,
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.True(t, intent.AutomaticPaymentMethods.Enabled)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "true", values.Get("automatic_payment_methods[enabled]"))
+	assert.Equal(t, "sporadic", values.Get("payment_method_options[acss_debit][mandate_options][payment_schedule]"))
+	assert.Equal(t, "personal", values.Get("payment_method_options[acss_debit][mandate_options][transaction_type]"))
+	assert.Equal(t, "microdeposits", values.Get("payment_method_options[acss_debit][verification_method]"))
+	assert.Equal(t, "any", values.Get("payment_method_options[card][request_three_d_secure]"))
+	assert.Equal(t, "ACME", values.Get("payment_method_options[sepa_debit][mandate_options][reference_prefix]"))
+	assert.Equal(t, "automatic", values.Get("payment_method_options[us_bank_account][verification_method]"))
+}
+
+func TestPaymentIntentUpdate(t *testing.T) {
+	intent, err := Update("pi_123", &stripe.PaymentIntentParams{
+		Desc: "Order #123",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, intent)
+}
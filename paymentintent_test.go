@@ -0,0 +1,26 @@
+package stripe
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestPaymentIntentID(t *testing.T) {
+	charge := &Charge{ID: "ch_123", PaymentIntent: &PaymentIntent{ID: "pi_123"}}
+	assert.Equal(t, "pi_123", charge.PaymentIntentID())
+	assert.Equal(t, "", (&Charge{ID: "ch_456"}).PaymentIntentID())
+
+	// The payment intent of the object itself is preferred
+	refund := &Refund{Charge: &Charge{ID: "ch_456"}, PaymentIntent: &PaymentIntent{ID: "pi_123"}}
+	assert.Equal(t, "pi_123", refund.PaymentIntentID())
+
+	// But the one of the expanded charge is used otherwise
+	refund = &Refund{Charge: charge}
+	assert.Equal(t, "pi_123", refund.PaymentIntentID())
+	assert.Equal(t, "", (&Refund{}).PaymentIntentID())
+
+	dispute := &Dispute{Charge: charge}
+	assert.Equal(t, "pi_123", dispute.PaymentIntentID())
+	assert.Equal(t, "", (&Dispute{}).PaymentIntentID())
+}
@@ -0,0 +1,221 @@
+package stripe
+
+import "encoding/json"
+
+// PaymentLinkAfterCompletionType is the list of allowed values for what
+// happens after a payment link's checkout is completed. Allowed values are
+// "hosted_confirmation" and "redirect".
+type PaymentLinkAfterCompletionType string
+
+// PaymentLinkBillingAddressCollection is the list of allowed values for
+// whether a payment link collects the customer's billing address. Allowed
+// values are "auto" and "required".
+type PaymentLinkBillingAddressCollection string
+
+// PaymentLinkCustomerCreation is the list of allowed values for whether a
+// payment link creates a customer. Allowed values are "always" and
+// "if_required".
+type PaymentLinkCustomerCreation string
+
+// PaymentLinkAfterCompletionHostedConfirmationParams is the set of parameters
+// for the confirmation page shown after a payment link's checkout.
+type PaymentLinkAfterCompletionHostedConfirmationParams struct {
+	CustomMessage string `form:"custom_message"`
+}
+
+// PaymentLinkAfterCompletionRedirectParams is the set of parameters for the
+// redirect that happens after a payment link's checkout.
+type PaymentLinkAfterCompletionRedirectParams struct {
+	URL string `form:"url"`
+}
+
+// PaymentLinkAfterCompletionParams is the set of parameters describing what
+// happens after a payment link's checkout is completed.
+type PaymentLinkAfterCompletionParams struct {
+	HostedConfirmation *PaymentLinkAfterCompletionHostedConfirmationParams `form:"hosted_confirmation"`
+	Redirect           *PaymentLinkAfterCompletionRedirectParams           `form:"redirect"`
+	Type               PaymentLinkAfterCompletionType                      `form:"type"`
+}
+
+// PaymentLinkAutomaticTaxParams is the set of parameters for calculating tax
+// automatically with Stripe Tax.
+type PaymentLinkAutomaticTaxParams struct {
+	Enabled bool `form:"enabled"`
+}
+
+// PaymentLinkLineItemAdjustableQuantityParams is the set of parameters that
+// let the customer change the quantity of a line item during checkout.
+type PaymentLinkLineItemAdjustableQuantityParams struct {
+	Enabled bool   `form:"enabled"`
+	Maximum uint64 `form:"maximum"`
+	Minimum uint64 `form:"minimum"`
+}
+
+// PaymentLinkLineItemParams is the set of parameters that can be used for a
+// line item of a payment link. ID is only used when updating.
+type PaymentLinkLineItemParams struct {
+	AdjustableQuantity *PaymentLinkLineItemAdjustableQuantityParams `form:"adjustable_quantity"`
+	ID                 string                                       `form:"id"`
+	Price              string                                       `form:"price"`
+	Quantity           uint64                                       `form:"quantity"`
+}
+
+// PaymentLinkShippingOptionParams is the set of parameters for a shipping
+// option offered by a payment link.
+type PaymentLinkShippingOptionParams struct {
+	ShippingRate string `form:"shipping_rate"`
+}
+
+// PaymentLinkParams is the set of parameters that can be used when creating
+// or updating a payment link. A payment link can't be deleted, but it can be
+// deactivated by setting Inactive.
+// For more details see https://stripe.com/docs/api/payment_links/payment_links/create.
+type PaymentLinkParams struct {
+	Params                   `form:"*"`
+	Active                   bool                                `form:"active"`
+	AfterCompletion          *PaymentLinkAfterCompletionParams   `form:"after_completion"`
+	AllowPromotionCodes      bool                                `form:"allow_promotion_codes"`
+	ApplicationFeeAmount     int64                               `form:"application_fee_amount"`
+	ApplicationFeePercent    float64                             `form:"application_fee_percent"`
+	AutomaticTax             *PaymentLinkAutomaticTaxParams      `form:"automatic_tax"`
+	BillingAddressCollection PaymentLinkBillingAddressCollection `form:"billing_address_collection"`
+	Currency                 Currency                            `form:"currency"`
+	CustomerCreation         PaymentLinkCustomerCreation         `form:"customer_creation"`
+	Inactive                 bool                                `form:"active,invert"`
+	LineItems                []*PaymentLinkLineItemParams        `form:"line_items,indexed"`
+	OnBehalfOf               string                              `form:"on_behalf_of"`
+	PaymentMethodTypes       []string                            `form:"payment_method_types"`
+	ShippingOptions          []*PaymentLinkShippingOptionParams  `form:"shipping_options,indexed"`
+}
+
+// PaymentLinkListParams is the set of parameters that can be used when
+// listing payment links.
+// For more details see https://stripe.com/docs/api/payment_links/payment_links/list.
+type PaymentLinkListParams struct {
+	ListParams `form:"*"`
+	Active     *bool `form:"active"`
+}
+
+// PaymentLinkLineItemListParams is the set of parameters that can be used
+// when listing the line items of a payment link.
+// For more details see https://stripe.com/docs/api/payment_links/line_items.
+type PaymentLinkLineItemListParams struct {
+	ListParams `form:"*"`
+
+	// ID is the payment link ID to list line items for.
+	ID string `form:"-"` // Goes in the URL
+}
+
+// PaymentLinkAfterCompletionHostedConfirmation is the confirmation page shown
+// after a payment link's checkout.
+type PaymentLinkAfterCompletionHostedConfirmation struct {
+	CustomMessage string `json:"custom_message"`
+}
+
+// PaymentLinkAfterCompletionRedirect is the redirect that happens after a
+// payment link's checkout.
+type PaymentLinkAfterCompletionRedirect struct {
+	URL string `json:"url"`
+}
+
+// PaymentLinkAfterCompletion is what happens after a payment link's checkout
+// is completed.
+type PaymentLinkAfterCompletion struct {
+	HostedConfirmation *PaymentLinkAfterCompletionHostedConfirmation `json:"hosted_confirmation"`
+	Redirect           *PaymentLinkAfterCompletionRedirect           `json:"redirect"`
+	Type               PaymentLinkAfterCompletionType                `json:"type"`
+}
+
+// PaymentLinkAutomaticTax is whether tax is calculated automatically with
+// Stripe Tax.
+type PaymentLinkAutomaticTax struct {
+	Enabled bool `json:"enabled"`
+}
+
+// PaymentLinkLineItemAdjustableQuantity is whether and how much the customer
+// can change the quantity of a line item during checkout.
+type PaymentLinkLineItemAdjustableQuantity struct {
+	Enabled bool   `json:"enabled"`
+	Maximum uint64 `json:"maximum"`
+	Minimum uint64 `json:"minimum"`
+}
+
+// PaymentLinkLineItem is the resource representing a line item of a payment
+// link.
+// For more details see https://stripe.com/docs/api/payment_links/line_items.
+type PaymentLinkLineItem struct {
+	AdjustableQuantity *PaymentLinkLineItemAdjustableQuantity `json:"adjustable_quantity"`
+	AmountSubtotal     int64                                  `json:"amount_subtotal"`
+	AmountTotal        int64                                  `json:"amount_total"`
+	Currency           Currency                               `json:"currency"`
+	Desc               string                                 `json:"description"`
+	ID                 string                                 `json:"id"`
+	Price              *Price                                 `json:"price"`
+	Quantity           uint64                                 `json:"quantity"`
+}
+
+// PaymentLinkLineItemList is a list of payment link line items as retrieved
+// from a list endpoint.
+type PaymentLinkLineItemList struct {
+	APIResource
+	ListMeta
+	Values []*PaymentLinkLineItem `json:"data"`
+}
+
+// PaymentLinkShippingOption is a shipping option offered by a payment link.
+type PaymentLinkShippingOption struct {
+	ShippingAmount int64         `json:"shipping_amount"`
+	ShippingRate   *ShippingRate `json:"shipping_rate"`
+}
+
+// PaymentLink is the resource representing a Stripe payment link, a
+// shareable URL to a checkout page for a fixed set of line items.
+// For more details see https://stripe.com/docs/api/payment_links/payment_links.
+type PaymentLink struct {
+	APIResource
+	Active                   bool                                `json:"active"`
+	AfterCompletion          *PaymentLinkAfterCompletion         `json:"after_completion"`
+	AllowPromotionCodes      bool                                `json:"allow_promotion_codes"`
+	ApplicationFeeAmount     int64                               `json:"application_fee_amount"`
+	ApplicationFeePercent    float64                             `json:"application_fee_percent"`
+	AutomaticTax             *PaymentLinkAutomaticTax            `json:"automatic_tax"`
+	BillingAddressCollection PaymentLinkBillingAddressCollection `json:"billing_address_collection"`
+	Currency                 Currency                            `json:"currency"`
+	CustomerCreation         PaymentLinkCustomerCreation         `json:"customer_creation"`
+	ID                       string                              `json:"id"`
+	LineItems                *PaymentLinkLineItemList            `json:"line_items"`
+	Live                     bool                                `json:"livemode"`
+	Meta                     map[string]string                   `json:"metadata"`
+	OnBehalfOf               *Account                            `json:"on_behalf_of"`
+	PaymentMethodTypes       []string                            `json:"payment_method_types"`
+	ShippingOptions          []*PaymentLinkShippingOption        `json:"shipping_options"`
+	URL                      string                              `json:"url"`
+}
+
+// PaymentLinkList is a list of payment links as retrieved from a list
+// endpoint.
+type PaymentLinkList struct {
+	APIResource
+	ListMeta
+	Values []*PaymentLink `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a PaymentLink.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (p *PaymentLink) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		p.ID = id
+		return nil
+	}
+
+	type paymentLink PaymentLink
+	var pl paymentLink
+	err := json.Unmarshal(data, &pl)
+	if err != nil {
+		return err
+	}
+
+	*p = PaymentLink(pl)
+	return nil
+}
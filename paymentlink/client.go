@@ -0,0 +1,178 @@
+// Package paymentlink provides the /payment_links APIs
+package paymentlink
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	AfterCompletionTypeHostedConfirmation stripe.PaymentLinkAfterCompletionType = "hosted_confirmation"
+	AfterCompletionTypeRedirect           stripe.PaymentLinkAfterCompletionType = "redirect"
+
+	BillingAddressCollectionAuto     stripe.PaymentLinkBillingAddressCollection = "auto"
+	BillingAddressCollectionRequired stripe.PaymentLinkBillingAddressCollection = "required"
+
+	CustomerCreationAlways     stripe.PaymentLinkCustomerCreation = "always"
+	CustomerCreationIfRequired stripe.PaymentLinkCustomerCreation = "if_required"
+)
+
+// Client is used to invoke /payment_links APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new payment link.
+// For more details see https://stripe.com/docs/api/payment_links/payment_links/create.
+func New(params *stripe.PaymentLinkParams) (*stripe.PaymentLink, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.PaymentLinkParams) (*stripe.PaymentLink, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	paymentLink := &stripe.PaymentLink{}
+	err := c.B.Call("POST", "/payment_links", c.Key, body, commonParams, paymentLink)
+
+	return paymentLink, err
+}
+
+// Get returns the details of a payment link.
+// For more details see https://stripe.com/docs/api/payment_links/payment_links/retrieve.
+func Get(id string, params *stripe.PaymentLinkParams) (*stripe.PaymentLink, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.PaymentLinkParams) (*stripe.PaymentLink, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	paymentLink := &stripe.PaymentLink{}
+	err := c.B.Call("GET", fmt.Sprintf("/payment_links/%v", id), c.Key, body, commonParams, paymentLink)
+
+	return paymentLink, err
+}
+
+// Update updates a payment link's properties.
+// For more details see https://stripe.com/docs/api/payment_links/payment_links/update.
+func Update(id string, params *stripe.PaymentLinkParams) (*stripe.PaymentLink, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.PaymentLinkParams) (*stripe.PaymentLink, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	paymentLink := &stripe.PaymentLink{}
+	err := c.B.Call("POST", fmt.Sprintf("/payment_links/%v", id), c.Key, body, commonParams, paymentLink)
+
+	return paymentLink, err
+}
+
+// List returns a list of payment links.
+// For more details see https://stripe.com/docs/api/payment_links/payment_links/list.
+func List(params *stripe.PaymentLinkListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.PaymentLinkListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.PaymentLinkList{}
+		err := c.B.Call("GET", "/payment_links", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// ListLineItems returns a list of the line items of a payment link.
+// For more details see https://stripe.com/docs/api/payment_links/line_items.
+func ListLineItems(params *stripe.PaymentLinkLineItemListParams) *LineItemIter {
+	return getC().ListLineItems(params)
+}
+
+func (c Client) ListLineItems(params *stripe.PaymentLinkLineItemListParams) *LineItemIter {
+	body := &form.Values{}
+	var lp *stripe.ListParams = &params.ListParams
+	var p *stripe.Params = params.ToParams()
+	form.AppendTo(body, params)
+
+	return &LineItemIter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.PaymentLinkLineItemList{}
+		err := c.B.Call("GET", fmt.Sprintf("/payment_links/%v/line_items", params.ID), c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of PaymentLinks.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// PaymentLink returns the most recent PaymentLink
+// visited by a call to Next.
+func (i *Iter) PaymentLink() *stripe.PaymentLink {
+	return i.Current().(*stripe.PaymentLink)
+}
+
+// LineItemIter is an iterator for lists of PaymentLinkLineItems.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type LineItemIter struct {
+	*stripe.Iter
+}
+
+// PaymentLinkLineItem returns the most recent PaymentLinkLineItem
+// visited by a call to Next.
+func (i *LineItemIter) PaymentLinkLineItem() *stripe.PaymentLinkLineItem {
+	return i.Current().(*stripe.PaymentLinkLineItem)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,90 @@
+package paymentlink
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestPaymentLinkGet(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/payment_links/plink_123", `{"id": "plink_123", "object": "payment_link", "active": true, "url": "https://buy.stripe.com/test_123"}`)
+
+	paymentLink, err := Client{B: b}.Get("plink_123", nil)
+	assert.Nil(t, err)
+	assert.True(t, paymentLink.Active)
+	assert.Equal(t, "https://buy.stripe.com/test_123", paymentLink.URL)
+}
+
+func TestPaymentLinkList(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/payment_links", `{"data": [{"id": "plink_123"}]}`)
+
+	active := true
+	i := Client{B: b}.List(&stripe.PaymentLinkListParams{Active: &active})
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, "plink_123", i.PaymentLink().ID)
+	assert.Equal(t, "true", b.LastCall().Values().Get("active"))
+}
+
+func TestPaymentLinkListLineItems(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/payment_links/plink_123/line_items", `{"data": [{"id": "li_123", "quantity": 2, "price": "price_123"}]}`)
+
+	i := Client{B: b}.ListLineItems(&stripe.PaymentLinkLineItemListParams{ID: "plink_123"})
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, uint64(2), i.PaymentLinkLineItem().Quantity)
+	assert.Equal(t, "price_123", i.PaymentLinkLineItem().Price.ID)
+}
+
+func TestPaymentLinkNew(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/payment_links", `{"id": "plink_123", "after_completion": {"type": "redirect", "redirect": {"url": "https://example.com/thanks"}}}`)
+
+	paymentLink, err := Client{B: b}.New(&stripe.PaymentLinkParams{
+		AfterCompletion: &stripe.PaymentLinkAfterCompletionParams{
+			Redirect: &stripe.PaymentLinkAfterCompletionRedirectParams{
+				URL: "https://example.com/thanks",
+			},
+			Type: AfterCompletionTypeRedirect,
+		},
+		LineItems: []*stripe.PaymentLinkLineItemParams{
+			{
+				AdjustableQuantity: &stripe.PaymentLinkLineItemAdjustableQuantityParams{
+					Enabled: true,
+					Maximum: 10,
+				},
+				Price:    "price_123",
+				Quantity: 1,
+			},
+		},
+		ShippingOptions: []*stripe.PaymentLinkShippingOptionParams{
+			{ShippingRate: "shr_123"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, AfterCompletionTypeRedirect, paymentLink.AfterCompletion.Type)
+	assert.Equal(t, "https://example.com/thanks", paymentLink.AfterCompletion.Redirect.URL)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "redirect", values.Get("after_completion[type]"))
+	assert.Equal(t, "https://example.com/thanks", values.Get("after_completion[redirect][url]"))
+	assert.Equal(t, "price_123", values.Get("line_items[0][price]"))
+	assert.Equal(t, "10", values.Get("line_items[0][adjustable_quantity][maximum]"))
+	assert.Equal(t, "shr_123", values.Get("shipping_options[0][shipping_rate]"))
+}
+
+func TestPaymentLinkUpdate(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/payment_links/plink_123", `{"id": "plink_123", "active": false}`)
+
+	paymentLink, err := Client{B: b}.Update("plink_123", &stripe.PaymentLinkParams{Inactive: true})
+	assert.Nil(t, err)
+	assert.False(t, paymentLink.Active)
+	assert.Equal(t, "false", b.LastCall().Values().Get("active"))
+}
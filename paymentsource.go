@@ -98,6 +98,7 @@ const (
 // The Type should indicate which object is fleshed out (eg. BitcoinReceiver or Card)
 // For more details see https://stripe.com/docs/api#retrieve_charge
 type PaymentSource struct {
+	APIResource
 	BankAccount     *BankAccount      `json:"-"`
 	BitcoinReceiver *BitcoinReceiver  `json:"-"`
 	Card            *Card             `json:"-"`
@@ -109,6 +110,7 @@ type PaymentSource struct {
 
 // SourceList is a list object for cards.
 type SourceList struct {
+	APIResource
 	ListMeta
 	Values []*PaymentSource `json:"data"`
 }
@@ -124,28 +126,32 @@ type SourceListParams struct {
 // This custom unmarshaling is needed because the specific
 // type of payment instrument it refers to is specified in the JSON
 func (s *PaymentSource) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		s.ID = id
+		return nil
+	}
+
 	type source PaymentSource
 	var ss source
 	err := json.Unmarshal(data, &ss)
-	if err == nil {
-		*s = PaymentSource(ss)
-
-		switch s.Type {
-		case PaymentSourceBankAccount:
-			json.Unmarshal(data, &s.BankAccount)
-		case PaymentSourceBitcoinReceiver:
-			json.Unmarshal(data, &s.BitcoinReceiver)
-		case PaymentSourceCard:
-			json.Unmarshal(data, &s.Card)
-		case PaymentSourceObject:
-			json.Unmarshal(data, &s.SourceObject)
-		}
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		s.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
+	}
+
+	*s = PaymentSource(ss)
+
+	switch s.Type {
+	case PaymentSourceBankAccount:
+		err = json.Unmarshal(data, &s.BankAccount)
+	case PaymentSourceBitcoinReceiver:
+		err = json.Unmarshal(data, &s.BitcoinReceiver)
+	case PaymentSourceCard:
+		err = json.Unmarshal(data, &s.Card)
+	case PaymentSourceObject:
+		err = json.Unmarshal(data, &s.SourceObject)
 	}
 
-	return nil
+	return err
 }
 
 // MarshalJSON handles serialization of a PaymentSource.
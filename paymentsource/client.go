@@ -182,5 +182,5 @@ func (i *Iter) PaymentSource() *stripe.PaymentSource {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
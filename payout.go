@@ -11,12 +11,19 @@ type PayoutDestinationType string
 // "account_frozen", "could_not_process", "bank_account_restricted", "invalid_currency".
 type PayoutFailureCode string
 
+// PayoutReconciliationStatus is the list of allowed values for the payout's
+// reconciliation status, which tells whether the balance transactions paid out
+// can be listed yet. Allowed values are "completed", "in_progress",
+// "not_applicable".
+type PayoutReconciliationStatus string
+
 // PayoutSourceType is the list of allowed values for the payout's source_type field.
 // Allowed values are "alipay_account", bank_account", "bitcoin_receiver", "card".
 type PayoutSourceType string
 
 // PayoutStatus is the list of allowed values for the payout's status.
-// Allowed values are "paid", "pending", "in_transit",  "failed", "canceled".
+// Allowed values are "paid", "pending", "in_transit",  "failed", "canceled",
+// "reversed".
 type PayoutStatus string
 
 // PayoutType is the list of allowed values for the payout's type.
@@ -81,29 +88,34 @@ type PayoutListParams struct {
 // Payout is the resource representing a Stripe payout.
 // For more details see https://stripe.com/docs/api#payouts.
 type Payout struct {
-	Amount                    int64             `json:"amount"`
-	ArrivalDate               int64             `json:"arrival_date"`
-	BalanceTransaction        *Transaction      `json:"balance_transaction"`
-	Bank                      *BankAccount      `json:"bank_account"`
-	Card                      *Card             `json:"card"`
-	Created                   int64             `json:"created"`
-	Currency                  Currency          `json:"currency"`
-	Destination               PayoutDestination `json:"destination"`
-	FailCode                  PayoutFailureCode `json:"failure_code"`
-	FailMessage               string            `json:"failure_message"`
-	FailureBalanceTransaction *Transaction      `json:"failure_balance_transaction"`
-	ID                        string            `json:"id"`
-	Live                      bool              `json:"livemode"`
-	Meta                      map[string]string `json:"metadata"`
-	Method                    PayoutMethodType  `json:"method"`
-	SourceType                PayoutSourceType  `json:"source_type"`
-	StatementDescriptor       string            `json:"statement_descriptor"`
-	Status                    PayoutStatus      `json:"status"`
-	Type                      PayoutType        `json:"type"`
+	APIResource
+	Amount                    int64                      `json:"amount"`
+	ArrivalDate               Timestamp                  `json:"arrival_date"`
+	BalanceTransaction        *Transaction               `json:"balance_transaction"`
+	Bank                      *BankAccount               `json:"bank_account"`
+	Card                      *Card                      `json:"card"`
+	Created                   Timestamp                  `json:"created"`
+	Currency                  Currency                   `json:"currency"`
+	Destination               PayoutDestination          `json:"destination"`
+	FailCode                  PayoutFailureCode          `json:"failure_code"`
+	FailMessage               string                     `json:"failure_message"`
+	FailureBalanceTransaction *Transaction               `json:"failure_balance_transaction"`
+	ID                        string                     `json:"id"`
+	Live                      bool                       `json:"livemode"`
+	Meta                      map[string]string          `json:"metadata"`
+	Method                    PayoutMethodType           `json:"method"`
+	OriginalPayout            *Payout                    `json:"original_payout"`
+	ReconciliationStatus      PayoutReconciliationStatus `json:"reconciliation_status"`
+	ReversedBy                *Payout                    `json:"reversed_by"`
+	SourceType                PayoutSourceType           `json:"source_type"`
+	StatementDescriptor       string                     `json:"statement_descriptor"`
+	Status                    PayoutStatus               `json:"status"`
+	Type                      PayoutType                 `json:"type"`
 }
 
 // PayoutList is a list of payouts as retrieved from a list endpoint.
 type PayoutList struct {
+	APIResource
 	ListMeta
 	Values []*Payout `json:"data"`
 }
@@ -112,16 +124,19 @@ type PayoutList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (t *Payout) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
 	type payout Payout
 	var tb payout
 	err := json.Unmarshal(data, &tb)
-	if err == nil {
-		*t = Payout(tb)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		t.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*t = Payout(tb)
 	return nil
 }
 
@@ -129,24 +144,28 @@ func (t *Payout) UnmarshalJSON(data []byte) error {
 // This custom unmarshaling is needed because the specific
 // type of destination it refers to is specified in the JSON
 func (d *PayoutDestination) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		d.ID = id
+		return nil
+	}
+
 	type dest PayoutDestination
 	var dd dest
 	err := json.Unmarshal(data, &dd)
-	if err == nil {
-		*d = PayoutDestination(dd)
-
-		switch d.Type {
-		case PayoutDestinationBankAccount:
-			json.Unmarshal(data, &d.BankAccount)
-		case PayoutDestinationCard:
-			json.Unmarshal(data, &d.Card)
-		}
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		d.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
-	return nil
+	*d = PayoutDestination(dd)
+
+	switch d.Type {
+	case PayoutDestinationBankAccount:
+		err = json.Unmarshal(data, &d.BankAccount)
+	case PayoutDestinationCard:
+		err = json.Unmarshal(data, &d.Card)
+	}
+
+	return err
 }
 
 // MarshalJSON handles serialization of a PayoutDestination.
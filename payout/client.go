@@ -2,6 +2,7 @@
 package payout
 
 import (
+	"context"
 	"fmt"
 
 	stripe "github.com/stripe/stripe-go"
@@ -13,6 +14,7 @@ const (
 	Failed   stripe.PayoutStatus = "failed"
 	Paid     stripe.PayoutStatus = "paid"
 	Pending  stripe.PayoutStatus = "pending"
+	Reversed stripe.PayoutStatus = "reversed"
 	Transit  stripe.PayoutStatus = "in_transit"
 
 	Bank stripe.PayoutType = "bank_account"
@@ -33,8 +35,15 @@ const (
 	InvalidAccountNumber stripe.PayoutFailureCode = "invalid_account_number"
 	InvalidCurrency      stripe.PayoutFailureCode = "invalid_currency"
 	NoAccount            stripe.PayoutFailureCode = "no_account"
+
+	ReconciliationStatusCompleted     stripe.PayoutReconciliationStatus = "completed"
+	ReconciliationStatusInProgress    stripe.PayoutReconciliationStatus = "in_progress"
+	ReconciliationStatusNotApplicable stripe.PayoutReconciliationStatus = "not_applicable"
 )
 
+// terminalStatuses are the statuses in which a payout has been processed.
+var terminalStatuses = []string{string(Canceled), string(Failed), string(Paid), string(Reversed)}
+
 // Client is used to invoke /payouts APIs.
 type Client struct {
 	B   stripe.Backend
@@ -123,6 +132,49 @@ func (c Client) Cancel(id string, params *stripe.PayoutParams) (*stripe.Payout,
 	return payout, err
 }
 
+// Reverse reverses a paid payout, creating a new payout that sends the funds
+// back to the balance. The new payout is returned and can be found with the
+// ReversedBy field of the original one.
+// For more details see https://stripe.com/docs/api#reverse_payout.
+func Reverse(id string, params *stripe.PayoutParams) (*stripe.Payout, error) {
+	return getC().Reverse(id, params)
+}
+
+func (c Client) Reverse(id string, params *stripe.PayoutParams) (*stripe.Payout, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	payout := &stripe.Payout{}
+	err := c.B.Call("POST", fmt.Sprintf("/payouts/%v/reverse", id), c.Key, body, commonParams, payout)
+
+	return payout, err
+}
+
+// Wait polls a payout until it's paid, failed, canceled or reversed and returns it. A nil policy
+// means stripe.DefaultPollPolicy. See stripe.Poll for details.
+func Wait(ctx context.Context, id string, params *stripe.PayoutParams, policy *stripe.PollPolicy) (*stripe.Payout, error) {
+	return getC().Wait(ctx, id, params, policy)
+}
+
+func (c Client) Wait(ctx context.Context, id string, params *stripe.PayoutParams, policy *stripe.PollPolicy) (*stripe.Payout, error) {
+	obj, err := stripe.Poll(ctx, func() (interface{}, string, error) {
+		payout, err := c.Get(id, params)
+		if err != nil {
+			return nil, "", err
+		}
+		return payout, string(payout.Status), nil
+	}, terminalStatuses, policy)
+
+	payout, _ := obj.(*stripe.Payout)
+	return payout, err
+}
+
 // List returns a list of payouts.
 // For more details see https://stripe.com/docs/api#list_payouts.
 func List(params *stripe.PayoutListParams) *Iter {
@@ -168,5 +220,5 @@ func (i *Iter) Payout() *stripe.Payout {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
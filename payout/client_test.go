@@ -38,6 +38,12 @@ func TestPayoutNew(t *testing.T) {
 	assert.NotNil(t, payout)
 }
 
+func TestPayoutReverse(t *testing.T) {
+	payout, err := Reverse("po_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, payout)
+}
+
 func TestPayoutUpdate(t *testing.T) {
 	payout, err := Update("tr_123", &stripe.PayoutParams{
 		Params: stripe.Params{
@@ -0,0 +1,150 @@
+package stripe
+
+import "encoding/json"
+
+// PersonRelationshipParams is the set of parameters describing how a person
+// relates to the account.
+type PersonRelationshipParams struct {
+	Director         *bool   `form:"director"`
+	Executive        *bool   `form:"executive"`
+	Owner            *bool   `form:"owner"`
+	PercentOwnership float64 `form:"percent_ownership"`
+	Representative   *bool   `form:"representative"`
+	Title            string  `form:"title"`
+}
+
+// PersonVerificationDocumentParams is the set of parameters referencing the
+// uploaded files of an identity document. Front and Back are the IDs of files
+// uploaded with the "identity_document" purpose.
+type PersonVerificationDocumentParams struct {
+	Back  string `form:"back"`
+	Front string `form:"front"`
+}
+
+// PersonVerificationParams is the set of parameters used to verify the
+// identity of a person.
+type PersonVerificationParams struct {
+	Document *PersonVerificationDocumentParams `form:"document"`
+}
+
+// PersonParams is the set of parameters that can be used when creating or
+// updating a person.
+// For more details see https://stripe.com/docs/api#create_person and https://stripe.com/docs/api#update_person.
+type PersonParams struct {
+	Params       `form:"*"`
+	Account      string                    `form:"-"` // Included in URL
+	Address      *AddressParams            `form:"address"`
+	DOB          *DOB                      `form:"dob"`
+	Email        string                    `form:"email"`
+	FirstName    string                    `form:"first_name"`
+	IDNumber     string                    `form:"id_number"`
+	LastName     string                    `form:"last_name"`
+	Phone        string                    `form:"phone"`
+	Relationship *PersonRelationshipParams `form:"relationship"`
+	SSNLast4     string                    `form:"ssn_last_4"`
+	Verification *PersonVerificationParams `form:"verification"`
+}
+
+// PersonRelationshipListParams is the set of parameters used to filter
+// persons by their relationship to the account.
+type PersonRelationshipListParams struct {
+	Director       *bool `form:"director"`
+	Executive      *bool `form:"executive"`
+	Owner          *bool `form:"owner"`
+	Representative *bool `form:"representative"`
+}
+
+// PersonListParams is the set of parameters that can be used when listing
+// persons.
+// For more details see https://stripe.com/docs/api#list_persons.
+type PersonListParams struct {
+	ListParams   `form:"*"`
+	Account      string                        `form:"-"` // Included in URL
+	Relationship *PersonRelationshipListParams `form:"relationship"`
+}
+
+// PersonRelationship describes how a person relates to the account.
+type PersonRelationship struct {
+	Director         bool    `json:"director"`
+	Executive        bool    `json:"executive"`
+	Owner            bool    `json:"owner"`
+	PercentOwnership float64 `json:"percent_ownership"`
+	Representative   bool    `json:"representative"`
+	Title            string  `json:"title"`
+}
+
+// PersonRequirements describes the information that a person needs to
+// provide, as names of fields like "dob.day".
+type PersonRequirements struct {
+	CurrentlyDue        []string `json:"currently_due"`
+	EventuallyDue       []string `json:"eventually_due"`
+	PastDue             []string `json:"past_due"`
+	PendingVerification []string `json:"pending_verification"`
+}
+
+// PersonVerificationDocument is the identity document provided for a person.
+type PersonVerificationDocument struct {
+	Back        *File                           `json:"back"`
+	Details     string                          `json:"details"`
+	DetailsCode IdentityVerificationDetailsCode `json:"details_code"`
+	Front       *File                           `json:"front"`
+}
+
+// PersonVerification is the state of the verification of the identity of a
+// person.
+type PersonVerification struct {
+	Details     string                          `json:"details"`
+	DetailsCode IdentityVerificationDetailsCode `json:"details_code"`
+	Document    *PersonVerificationDocument     `json:"document"`
+	Status      IdentityVerificationStatus      `json:"status"`
+}
+
+// Person is the resource representing a person associated with a connected
+// account, like its representative or one of its owners.
+// For more details see https://stripe.com/docs/api#persons.
+type Person struct {
+	APIResource
+	Account          string              `json:"account"`
+	Address          *Address            `json:"address"`
+	Created          Timestamp           `json:"created"`
+	Deleted          bool                `json:"deleted"`
+	DOB              *DOB                `json:"dob"`
+	Email            string              `json:"email"`
+	FirstName        string              `json:"first_name"`
+	ID               string              `json:"id"`
+	IDNumberProvided bool                `json:"id_number_provided"`
+	LastName         string              `json:"last_name"`
+	Meta             map[string]string   `json:"metadata"`
+	Phone            string              `json:"phone"`
+	Relationship     *PersonRelationship `json:"relationship"`
+	Requirements     *PersonRequirements `json:"requirements"`
+	SSNLast4Provided bool                `json:"ssn_last_4_provided"`
+	Verification     *PersonVerification `json:"verification"`
+}
+
+// PersonList is a list of persons as retrieved from a list endpoint.
+type PersonList struct {
+	APIResource
+	ListMeta
+	Values []*Person `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a Person.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (p *Person) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		p.ID = id
+		return nil
+	}
+
+	type person Person
+	var pp person
+	err := json.Unmarshal(data, &pp)
+	if err != nil {
+		return err
+	}
+
+	*p = Person(pp)
+	return nil
+}
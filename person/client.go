@@ -0,0 +1,152 @@
+// Package person provides the /accounts/persons APIs
+package person
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /accounts/persons APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new person.
+// For more details see https://stripe.com/docs/api#create_person.
+func New(params *stripe.PersonParams) (*stripe.Person, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.PersonParams) (*stripe.Person, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Account == "" {
+		return nil, fmt.Errorf("params.Account must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	person := &stripe.Person{}
+	err := c.B.Call("POST", fmt.Sprintf("/accounts/%v/persons", params.Account), c.Key, body, &params.Params, person)
+
+	return person, err
+}
+
+// Get returns the details of a person.
+// For more details see https://stripe.com/docs/api#retrieve_person.
+func Get(id string, params *stripe.PersonParams) (*stripe.Person, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.PersonParams) (*stripe.Person, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Account == "" {
+		return nil, fmt.Errorf("params.Account must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	person := &stripe.Person{}
+	err := c.B.Call("GET", fmt.Sprintf("/accounts/%v/persons/%v", params.Account, id), c.Key, body, &params.Params, person)
+
+	return person, err
+}
+
+// Update updates a person's properties.
+// For more details see https://stripe.com/docs/api#update_person.
+func Update(id string, params *stripe.PersonParams) (*stripe.Person, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.PersonParams) (*stripe.Person, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Account == "" {
+		return nil, fmt.Errorf("params.Account must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	person := &stripe.Person{}
+	err := c.B.Call("POST", fmt.Sprintf("/accounts/%v/persons/%v", params.Account, id), c.Key, body, &params.Params, person)
+
+	return person, err
+}
+
+// Del removes a person.
+// For more details see https://stripe.com/docs/api#delete_person.
+func Del(id string, params *stripe.PersonParams) (*stripe.Person, error) {
+	return getC().Del(id, params)
+}
+
+func (c Client) Del(id string, params *stripe.PersonParams) (*stripe.Person, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Account == "" {
+		return nil, fmt.Errorf("params.Account must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	person := &stripe.Person{}
+	err := c.B.Call("DELETE", fmt.Sprintf("/accounts/%v/persons/%v", params.Account, id), c.Key, body, &params.Params, person)
+
+	return person, err
+}
+
+// List returns a list of persons.
+// For more details see https://stripe.com/docs/api#list_persons.
+func List(params *stripe.PersonListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.PersonListParams) *Iter {
+	body := &form.Values{}
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+	p = params.ToParams()
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.PersonList{}
+		err := c.B.Call("GET", fmt.Sprintf("/accounts/%v/persons", params.Account), c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of Persons.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Person returns the most recent Person
+// visited by a call to Next.
+func (i *Iter) Person() *stripe.Person {
+	return i.Current().(*stripe.Person)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
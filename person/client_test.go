@@ -0,0 +1,70 @@
+package person
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestPersonDel(t *testing.T) {
+	person, err := Del("person_123", &stripe.PersonParams{Account: "acct_123"})
+	assert.Nil(t, err)
+	assert.NotNil(t, person)
+}
+
+func TestPersonGet(t *testing.T) {
+	person, err := Get("person_123", &stripe.PersonParams{Account: "acct_123"})
+	assert.Nil(t, err)
+	assert.NotNil(t, person)
+}
+
+func TestPersonGetNoAccount(t *testing.T) {
+	_, err := Get("person_123", &stripe.PersonParams{})
+	assert.NotNil(t, err)
+}
+
+func TestPersonList(t *testing.T) {
+	representative := true
+	i := List(&stripe.PersonListParams{
+		Account: "acct_123",
+		Relationship: &stripe.PersonRelationshipListParams{
+			Representative: &representative,
+		},
+	})
+
+	// Verify that we can get at least one person
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.Person())
+}
+
+func TestPersonNew(t *testing.T) {
+	owner := true
+	person, err := New(&stripe.PersonParams{
+		Account:   "acct_123",
+		FirstName: "Jenny",
+		LastName:  "Rosen",
+		Relationship: &stripe.PersonRelationshipParams{
+			Owner:            &owner,
+			PercentOwnership: 51.5,
+		},
+		Verification: &stripe.PersonVerificationParams{
+			Document: &stripe.PersonVerificationDocumentParams{
+				Front: "file_123",
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, person)
+}
+
+func TestPersonUpdate(t *testing.T) {
+	person, err := Update("person_123", &stripe.PersonParams{
+		Account: "acct_123",
+		Email:   "jenny@example.com",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, person)
+}
@@ -0,0 +1,31 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestPersonUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"id": "person_123",
+		"account": "acct_123",
+		"relationship": {"owner": true, "percent_ownership": 51.5},
+		"verification": {
+			"status": "pending",
+			"document": {"front": "file_123", "back": null}
+		}
+	}`)
+
+	var person Person
+	err := json.Unmarshal(data, &person)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "acct_123", person.Account)
+	assert.True(t, person.Relationship.Owner)
+	assert.Equal(t, 51.5, person.Relationship.PercentOwnership)
+	assert.Equal(t, IdentityVerificationPending, person.Verification.Status)
+	assert.Equal(t, "file_123", person.Verification.Document.Front.ID)
+	assert.Nil(t, person.Verification.Document.Back)
+}
@@ -1,28 +1,69 @@
 package stripe
 
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/stripe/stripe-go/form"
+)
+
+// PlanBillingScheme is the list of allowed values for a plan's billing
+// scheme. Allowed values are "per_unit" and "tiered".
+type PlanBillingScheme string
+
 // PlanInterval is the list of allowed values for a plan's interval.
 // Allowed values are "day", "week", "month", "year".
 type PlanInterval string
 
+// PlanTiersMode is the list of allowed values for a tiered plan's tiers
+// mode. Allowed values are "graduated" and "volume".
+type PlanTiersMode string
+
+// PlanTransformUsageRound is the list of allowed values for how the
+// transformed usage of a plan is rounded. Allowed values are "up" and
+// "down".
+type PlanTransformUsageRound string
+
+// PlanTier is a single tier of a tiered plan. UpTo is zero for the last,
+// unbounded tier.
+type PlanTier struct {
+	FlatAmount uint64 `json:"flat_amount"`
+	UnitAmount uint64 `json:"unit_amount"`
+	UpTo       uint64 `json:"up_to"`
+}
+
+// PlanTransformUsage represents how the usage reported for a plan is
+// transformed before it's billed.
+type PlanTransformUsage struct {
+	DivideBy uint64                  `json:"divide_by"`
+	Round    PlanTransformUsageRound `json:"round"`
+}
+
 // Plan is the resource representing a Stripe plan.
 // For more details see https://stripe.com/docs/api#plans.
 type Plan struct {
-	Amount        uint64            `json:"amount"`
-	Created       int64             `json:"created"`
-	Currency      Currency          `json:"currency"`
-	Deleted       bool              `json:"deleted"`
-	ID            string            `json:"id"`
-	Interval      PlanInterval      `json:"interval"`
-	IntervalCount uint64            `json:"interval_count"`
-	Live          bool              `json:"livemode"`
-	Meta          map[string]string `json:"metadata"`
-	Name          string            `json:"name"`
-	Statement     string            `json:"statement_descriptor"`
-	TrialPeriod   uint64            `json:"trial_period_days"`
+	APIResource
+	Amount         uint64              `json:"amount"`
+	BillingScheme  PlanBillingScheme   `json:"billing_scheme"`
+	Created        Timestamp           `json:"created"`
+	Currency       Currency            `json:"currency"`
+	Deleted        bool                `json:"deleted"`
+	ID             string              `json:"id"`
+	Interval       PlanInterval        `json:"interval"`
+	IntervalCount  uint64              `json:"interval_count"`
+	Live           bool                `json:"livemode"`
+	Meta           map[string]string   `json:"metadata"`
+	Name           string              `json:"name"`
+	Statement      string              `json:"statement_descriptor"`
+	Tiers          []*PlanTier         `json:"tiers"`
+	TiersMode      PlanTiersMode       `json:"tiers_mode"`
+	TransformUsage *PlanTransformUsage `json:"transform_usage"`
+	TrialPeriod    uint64              `json:"trial_period_days"`
 }
 
 // PlanList is a list of plans as returned from a list endpoint.
 type PlanList struct {
+	APIResource
 	ListMeta
 	Values []*Plan `json:"data"`
 }
@@ -35,16 +76,68 @@ type PlanListParams struct {
 	CreatedRange *RangeQueryParams `form:"created"`
 }
 
+// PlanTierParams is the set of parameters for a single tier of a tiered
+// plan. The last tier must set UpToInf.
+type PlanTierParams struct {
+	FlatAmount     uint64 `form:"flat_amount"`
+	UnitAmount     uint64 `form:"unit_amount"`
+	UnitAmountZero bool   `form:"unit_amount,zero"`
+	UpTo           uint64 `form:"up_to"`
+	UpToInf        bool   `form:"-"` // See PlanParams' custom AppendTo
+}
+
+// PlanTransformUsageParams is the set of parameters for transforming the
+// usage reported for a plan before it's billed.
+type PlanTransformUsageParams struct {
+	DivideBy uint64                  `form:"divide_by"`
+	Round    PlanTransformUsageRound `form:"round"`
+}
+
 // PlanParams is the set of parameters that can be used when creating or updating a plan.
+// Tiered plans set BillingScheme, Tiers, and TiersMode instead of Amount.
 // For more details see https://stripe.com/docs/api#create_plan and https://stripe.com/docs/api#update_plan.
 type PlanParams struct {
-	Params        `form:"*"`
-	Amount        uint64       `form:"amount"`
-	Currency      Currency     `form:"currency"`
-	ID            string       `form:"id"`
-	Interval      PlanInterval `form:"interval"`
-	IntervalCount uint64       `form:"interval_count"`
-	Name          string       `form:"name"`
-	Statement     string       `form:"statement_descriptor"`
-	TrialPeriod   uint64       `form:"trial_period_days"`
+	Params         `form:"*"`
+	Amount         uint64                    `form:"amount"`
+	BillingScheme  PlanBillingScheme         `form:"billing_scheme"`
+	Currency       Currency                  `form:"currency"`
+	ID             string                    `form:"id"`
+	Interval       PlanInterval              `form:"interval"`
+	IntervalCount  uint64                    `form:"interval_count"`
+	Name           string                    `form:"name"`
+	Statement      string                    `form:"statement_descriptor"`
+	Tiers          []*PlanTierParams         `form:"tiers,indexed"`
+	TiersMode      PlanTiersMode             `form:"tiers_mode"`
+	TransformUsage *PlanTransformUsageParams `form:"transform_usage"`
+	TrialPeriod    uint64                    `form:"trial_period_days"`
+}
+
+// AppendTo implements custom encoding logic for PlanParams so that the last
+// tier of a tiered plan can be sent as unbounded.
+func (p *PlanParams) AppendTo(body *form.Values, keyParts []string) {
+	for i, tier := range p.Tiers {
+		if tier != nil && tier.UpToInf {
+			body.Add(form.FormatKey(append(keyParts, "tiers", strconv.Itoa(i), "up_to")), "inf")
+		}
+	}
+}
+
+// UnmarshalJSON handles deserialization of a Plan.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (p *Plan) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		p.ID = id
+		return nil
+	}
+
+	type plan Plan
+	var pp plan
+	err := json.Unmarshal(data, &pp)
+	if err != nil {
+		return err
+	}
+
+	*p = Plan(pp)
+	return nil
 }
@@ -14,6 +14,15 @@ const (
 	Week  stripe.PlanInterval = "week"
 	Month stripe.PlanInterval = "month"
 	Year  stripe.PlanInterval = "year"
+
+	BillingSchemePerUnit stripe.PlanBillingScheme = "per_unit"
+	BillingSchemeTiered  stripe.PlanBillingScheme = "tiered"
+
+	TiersModeGraduated stripe.PlanTiersMode = "graduated"
+	TiersModeVolume    stripe.PlanTiersMode = "volume"
+
+	TransformUsageRoundDown stripe.PlanTransformUsageRound = "down"
+	TransformUsageRoundUp   stripe.PlanTransformUsageRound = "up"
 )
 
 // Client is used to invoke /plans APIs.
@@ -149,5 +158,5 @@ func (i *Iter) Plan() *stripe.Plan {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
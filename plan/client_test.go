@@ -41,6 +41,23 @@ func TestPlanNew(t *testing.T) {
 	assert.NotNil(t, plan)
 }
 
+func TestPlanNew_Tiered(t *testing.T) {
+	plan, err := New(&stripe.PlanParams{
+		BillingScheme: BillingSchemeTiered,
+		Currency:      "usd",
+		ID:            "sapphire-metered",
+		Interval:      "month",
+		Name:          "Sapphire Metered",
+		Tiers: []*stripe.PlanTierParams{
+			{UnitAmount: 500, UpTo: 10},
+			{UnitAmount: 400, UpToInf: true},
+		},
+		TiersMode: TiersModeGraduated,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, plan)
+}
+
 func TestPlanUpdate(t *testing.T) {
 	plan, err := Update("gold", &stripe.PlanParams{
 		Name: "Updated Name",
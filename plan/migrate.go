@@ -0,0 +1,83 @@
+package plan
+
+import (
+	stripe "github.com/stripe/stripe-go"
+)
+
+// PriceParamsFromPlanParams converts the parameters used to create a plan
+// into parameters that create an equivalent recurring price.
+//
+// Plans carry their own name and statement descriptor while prices belong to
+// a product, so those are used to create a product inline. Prices are always
+// assigned a generated ID, so params.ID isn't carried over.
+func PriceParamsFromPlanParams(params *stripe.PlanParams) *stripe.PriceParams {
+	if params == nil {
+		return nil
+	}
+
+	priceParams := &stripe.PriceParams{
+		Currency: params.Currency,
+		Nickname: params.Name,
+		ProductData: &stripe.PriceProductDataParams{
+			Name:      params.Name,
+			Statement: params.Statement,
+		},
+		Recurring: &stripe.PriceRecurringParams{
+			Interval:        stripe.PriceRecurringInterval(params.Interval),
+			IntervalCount:   params.IntervalCount,
+			TrialPeriodDays: params.TrialPeriod,
+		},
+		UnitAmount: params.Amount,
+	}
+	priceParams.Meta = params.Meta
+	priceParams.StripeAccount = params.StripeAccount
+
+	return priceParams
+}
+
+// PriceFromPlan converts a plan into its equivalent price. Every plan can be
+// used as a price with the same ID, so the result may be used anywhere that a
+// price is expected.
+func PriceFromPlan(p *stripe.Plan) *stripe.Price {
+	if p == nil {
+		return nil
+	}
+
+	return &stripe.Price{
+		Active:   true,
+		Created:  p.Created,
+		Currency: p.Currency,
+		Deleted:  p.Deleted,
+		ID:       p.ID,
+		Live:     p.Live,
+		Meta:     p.Meta,
+		Nickname: p.Name,
+		Recurring: &stripe.PriceRecurring{
+			Interval:        stripe.PriceRecurringInterval(p.Interval),
+			IntervalCount:   p.IntervalCount,
+			TrialPeriodDays: p.TrialPeriod,
+		},
+		Type:       "recurring",
+		UnitAmount: p.Amount,
+	}
+}
+
+// SubUsesPlans reports whether any part of a subscription still references a
+// plan rather than a price, meaning that it needs to be migrated.
+func SubUsesPlans(s *stripe.Sub) bool {
+	if s == nil {
+		return false
+	}
+
+	if s.Items == nil || len(s.Items.Values) == 0 {
+		return s.Plan != nil
+	}
+
+	for _, item := range s.Items.Values {
+		if item.Plan != nil && item.Price == nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,69 @@
+package plan
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+)
+
+func TestPriceFromPlan(t *testing.T) {
+	assert.Nil(t, PriceFromPlan(nil))
+
+	price := PriceFromPlan(&stripe.Plan{
+		Amount:        1000,
+		Currency:      "usd",
+		ID:            "gold",
+		Interval:      Month,
+		IntervalCount: 3,
+		Name:          "Gold",
+		TrialPeriod:   14,
+	})
+	assert.Equal(t, "gold", price.ID)
+	assert.Equal(t, uint64(1000), price.UnitAmount)
+	assert.Equal(t, stripe.Currency("usd"), price.Currency)
+	assert.Equal(t, "Gold", price.Nickname)
+	assert.Equal(t, stripe.PriceRecurringInterval("month"), price.Recurring.Interval)
+	assert.Equal(t, uint64(3), price.Recurring.IntervalCount)
+	assert.Equal(t, uint64(14), price.Recurring.TrialPeriodDays)
+}
+
+func TestPriceParamsFromPlanParams(t *testing.T) {
+	assert.Nil(t, PriceParamsFromPlanParams(nil))
+
+	planParams := &stripe.PlanParams{
+		Amount:    1000,
+		Currency:  "usd",
+		ID:        "gold",
+		Interval:  Year,
+		Name:      "Gold",
+		Statement: "GOLD PLAN",
+	}
+	planParams.AddMeta("foo", "bar")
+
+	params := PriceParamsFromPlanParams(planParams)
+	assert.Equal(t, uint64(1000), params.UnitAmount)
+	assert.Equal(t, "Gold", params.ProductData.Name)
+	assert.Equal(t, "GOLD PLAN", params.ProductData.Statement)
+	assert.Equal(t, stripe.PriceRecurringInterval("year"), params.Recurring.Interval)
+	assert.Equal(t, "bar", params.Meta["foo"])
+}
+
+func TestSubUsesPlans(t *testing.T) {
+	assert.False(t, SubUsesPlans(nil))
+	assert.False(t, SubUsesPlans(&stripe.Sub{}))
+	assert.True(t, SubUsesPlans(&stripe.Sub{Plan: &stripe.Plan{ID: "gold"}}))
+
+	assert.True(t, SubUsesPlans(&stripe.Sub{
+		Items: &stripe.SubItemList{Values: []*stripe.SubItem{
+			{Plan: &stripe.Plan{ID: "gold"}, Price: &stripe.Price{ID: "gold"}},
+			{Plan: &stripe.Plan{ID: "silver"}},
+		}},
+	}))
+
+	assert.False(t, SubUsesPlans(&stripe.Sub{
+		Items: &stripe.SubItemList{Values: []*stripe.SubItem{
+			{Price: &stripe.Price{ID: "price_123"}},
+		}},
+	}))
+}
@@ -1,6 +1,7 @@
 package stripe
 
 import (
+	"encoding/json"
 	"strconv"
 	"testing"
 
@@ -63,9 +64,69 @@ func TestPlanParams_AppendTo(t *testing.T) {
 	}
 }
 
+func TestPlanParams_AppendTo_Tiers(t *testing.T) {
+	params := &PlanParams{
+		BillingScheme: "tiered",
+		Tiers: []*PlanTierParams{
+			{UnitAmountZero: true, UpTo: 10},
+			{FlatAmount: 100, UnitAmount: 400, UpToInf: true},
+		},
+		TiersMode:      "graduated",
+		TransformUsage: &PlanTransformUsageParams{DivideBy: 100, Round: "up"},
+	}
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	assert.Equal(t, []string{"tiered"}, body.Get("billing_scheme"))
+	assert.Equal(t, []string{"0"}, body.Get("tiers[0][unit_amount]"))
+	assert.Equal(t, []string{"10"}, body.Get("tiers[0][up_to]"))
+	assert.Equal(t, []string{"inf"}, body.Get("tiers[1][up_to]"))
+	assert.Equal(t, []string{"100"}, body.Get("tiers[1][flat_amount]"))
+	assert.Equal(t, []string{"graduated"}, body.Get("tiers_mode"))
+	assert.Equal(t, []string{"100"}, body.Get("transform_usage[divide_by]"))
+	assert.Equal(t, []string{"up"}, body.Get("transform_usage[round]"))
+}
+
 func TestPlanParams_AppendTo_Empty(t *testing.T) {
 	body := &form.Values{}
 	params := &PlanParams{}
 	form.AppendTo(body, params)
 	assert.True(t, body.Empty())
 }
+
+func TestPlanUnmarshal(t *testing.T) {
+	{
+		var p Plan
+		err := json.Unmarshal([]byte(`"gold"`), &p)
+		assert.NoError(t, err)
+		assert.Equal(t, "gold", p.ID)
+	}
+
+	{
+		var p Plan
+		err := json.Unmarshal([]byte(`{"id":"gold","amount":2000}`), &p)
+		assert.NoError(t, err)
+		assert.Equal(t, "gold", p.ID)
+		assert.Equal(t, uint64(2000), p.Amount)
+	}
+
+	{
+		var p Plan
+		err := json.Unmarshal([]byte(`{
+			"id": "metered",
+			"billing_scheme": "tiered",
+			"tiers": [
+				{"flat_amount": null, "unit_amount": 500, "up_to": 10},
+				{"flat_amount": 100, "unit_amount": 400, "up_to": null}
+			],
+			"tiers_mode": "graduated",
+			"transform_usage": {"divide_by": 100, "round": "up"}
+		}`), &p)
+		assert.NoError(t, err)
+		assert.Equal(t, PlanBillingScheme("tiered"), p.BillingScheme)
+		assert.Equal(t, 2, len(p.Tiers))
+		assert.Equal(t, uint64(10), p.Tiers[0].UpTo)
+		assert.Equal(t, uint64(0), p.Tiers[1].UpTo)
+		assert.Equal(t, PlanTiersMode("graduated"), p.TiersMode)
+		assert.Equal(t, uint64(100), p.TransformUsage.DivideBy)
+	}
+}
@@ -0,0 +1,96 @@
+package stripe
+
+import (
+	"context"
+	"time"
+)
+
+// PollFunc retrieves an object that is processed asynchronously, like a
+// report run or a payout, and returns it along with its current status.
+type PollFunc func() (obj interface{}, status string, err error)
+
+// PollPolicy controls how often Poll retrieves an object.
+type PollPolicy struct {
+	// InitialInterval is how long to wait before retrieving the object
+	// again after the first time. The wait doubles after every retrieval.
+	// Zero means the InitialInterval of DefaultPollPolicy.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the wait between two retrievals. Zero means the
+	// MaxInterval of DefaultPollPolicy.
+	MaxInterval time.Duration
+}
+
+// minPollInterval is the shortest wait between two retrievals, so that a
+// policy can never make Poll hit the API in a tight loop.
+const minPollInterval = 100 * time.Millisecond
+
+// DefaultPollPolicy is the policy used by Poll when none is given.
+var DefaultPollPolicy = &PollPolicy{
+	InitialInterval: time.Second,
+	MaxInterval:     30 * time.Second,
+}
+
+// Poll retrieves an object with get until its status is one of terminal,
+// waiting longer and longer between retrievals as described by policy, and
+// returns it. Objects processed asynchronously are returned in a pending
+// status by the request that creates them, even though it succeeded, and
+// must be polled to learn their outcome.
+//
+// Errors returned by get stop the polling and are returned along with the
+// last object retrieved, if any. So does ctx being done, which is the way to
+// give up waiting.
+func Poll(ctx context.Context, get PollFunc, terminal []string, policy *PollPolicy) (interface{}, error) {
+	if policy == nil {
+		policy = DefaultPollPolicy
+	}
+
+	interval := policy.InitialInterval
+	if interval <= 0 {
+		interval = DefaultPollPolicy.InitialInterval
+	}
+	if interval < minPollInterval {
+		interval = minPollInterval
+	}
+
+	maxInterval := policy.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultPollPolicy.MaxInterval
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+
+	var last interface{}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return last, err
+		}
+
+		obj, status, err := get()
+		if err != nil {
+			return last, err
+		}
+		last = obj
+
+		for _, s := range terminal {
+			if status == s {
+				return obj, nil
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package stripe_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+)
+
+var testPollPolicy = &stripe.PollPolicy{InitialInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+func TestPoll(t *testing.T) {
+	statuses := []string{"pending", "pending", "succeeded"}
+	calls := 0
+
+	obj, err := stripe.Poll(context.Background(), func() (interface{}, string, error) {
+		status := statuses[calls]
+		calls++
+		return &stripe.ReportRun{ID: "frr_123", Status: stripe.ReportRunStatus(status)}, status, nil
+	}, []string{"failed", "succeeded"}, testPollPolicy)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, stripe.ReportRunStatus("succeeded"), obj.(*stripe.ReportRun).Status)
+}
+
+func TestPollContextDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	obj, err := stripe.Poll(ctx, func() (interface{}, string, error) {
+		return &stripe.ReportRun{ID: "frr_123"}, "pending", nil
+	}, []string{"succeeded"}, testPollPolicy)
+
+	// The last object retrieved is returned along with the error
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, "frr_123", obj.(*stripe.ReportRun).ID)
+}
+
+func TestPollError(t *testing.T) {
+	calls := 0
+	getErr := errors.New("connection reset")
+
+	_, err := stripe.Poll(context.Background(), func() (interface{}, string, error) {
+		calls++
+		if calls == 2 {
+			return nil, "", getErr
+		}
+		return &stripe.ReportRun{}, "pending", nil
+	}, []string{"succeeded"}, testPollPolicy)
+
+	assert.Equal(t, getErr, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestPollZeroPolicy(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// A zero interval falls back to the default one instead of polling in a
+	// tight loop
+	calls := 0
+	_, err := stripe.Poll(ctx, func() (interface{}, string, error) {
+		calls++
+		return &stripe.ReportRun{}, "pending", nil
+	}, []string{"succeeded"}, &stripe.PollPolicy{})
+
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 1, calls)
+}
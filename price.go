@@ -0,0 +1,183 @@
+package stripe
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/stripe/stripe-go/form"
+)
+
+// PriceBillingScheme is the list of allowed values for a price's billing
+// scheme. Allowed values are "per_unit" and "tiered".
+type PriceBillingScheme string
+
+// PriceRecurringInterval is the list of allowed values for a price's recurring
+// interval. Allowed values are "day", "week", "month", "year".
+type PriceRecurringInterval string
+
+// PriceTiersMode is the list of allowed values for a tiered price's tiers
+// mode. Allowed values are "graduated" and "volume".
+type PriceTiersMode string
+
+// PriceTransformQuantityRound is the list of allowed values for how a
+// transformed quantity is rounded. Allowed values are "up" and "down".
+type PriceTransformQuantityRound string
+
+// PriceType is the list of allowed values for a price's type. Allowed values
+// are "one_time" and "recurring".
+type PriceType string
+
+// PriceProductDataParams is the set of parameters that can be used to create
+// a product inline with a price.
+type PriceProductDataParams struct {
+	Active    bool              `form:"active"`
+	ID        string            `form:"id"`
+	Meta      map[string]string `form:"metadata"`
+	Name      string            `form:"name"`
+	Statement string            `form:"statement_descriptor"`
+}
+
+// PriceRecurringParams is the set of parameters for the recurring components
+// of a price.
+type PriceRecurringParams struct {
+	Interval        PriceRecurringInterval `form:"interval"`
+	IntervalCount   uint64                 `form:"interval_count"`
+	TrialPeriodDays uint64                 `form:"trial_period_days"`
+}
+
+// PriceTierParams is the set of parameters for a single tier of a tiered
+// price. The last tier must set UpToInf.
+type PriceTierParams struct {
+	FlatAmount     uint64 `form:"flat_amount"`
+	UnitAmount     uint64 `form:"unit_amount"`
+	UnitAmountZero bool   `form:"unit_amount,zero"`
+	UpTo           uint64 `form:"up_to"`
+	UpToInf        bool   `form:"-"` // See PriceParams' custom AppendTo
+}
+
+// PriceTransformQuantityParams is the set of parameters for transforming the
+// quantity reported for a price before it's billed.
+type PriceTransformQuantityParams struct {
+	DivideBy uint64                      `form:"divide_by"`
+	Round    PriceTransformQuantityRound `form:"round"`
+}
+
+// PriceParams is the set of parameters that can be used when creating or
+// updating a price. Prices can't be deleted, but they can be archived by
+// setting Inactive.
+// For more details see https://stripe.com/docs/api#create_price and https://stripe.com/docs/api#update_price.
+type PriceParams struct {
+	Params            `form:"*"`
+	Active            bool                          `form:"active"`
+	BillingScheme     PriceBillingScheme            `form:"billing_scheme"`
+	Currency          Currency                      `form:"currency"`
+	Inactive          bool                          `form:"active,invert"`
+	LookupKey         string                        `form:"lookup_key"`
+	Nickname          string                        `form:"nickname"`
+	Product           string                        `form:"product"`
+	ProductData       *PriceProductDataParams       `form:"product_data"`
+	Recurring         *PriceRecurringParams         `form:"recurring"`
+	Tiers             []*PriceTierParams            `form:"tiers,indexed"`
+	TiersMode         PriceTiersMode                `form:"tiers_mode"`
+	TransferLookupKey bool                          `form:"transfer_lookup_key"`
+	TransformQuantity *PriceTransformQuantityParams `form:"transform_quantity"`
+	UnitAmount        uint64                        `form:"unit_amount"`
+}
+
+// AppendTo implements custom encoding logic for PriceParams so that the last
+// tier of a tiered price can be sent as unbounded.
+func (p *PriceParams) AppendTo(body *form.Values, keyParts []string) {
+	for i, tier := range p.Tiers {
+		if tier != nil && tier.UpToInf {
+			body.Add(form.FormatKey(append(keyParts, "tiers", strconv.Itoa(i), "up_to")), "inf")
+		}
+	}
+}
+
+// PriceListParams is the set of parameters that can be used when listing
+// prices.
+// For more details see https://stripe.com/docs/api#list_prices.
+type PriceListParams struct {
+	ListParams   `form:"*"`
+	Active       bool              `form:"active"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Currency     Currency          `form:"currency"`
+	Inactive     bool              `form:"active,invert"`
+	LookupKeys   []string          `form:"lookup_keys"`
+	Product      string            `form:"product"`
+	Type         PriceType         `form:"type"`
+}
+
+// PriceRecurring represents the recurring components of a price.
+type PriceRecurring struct {
+	Interval        PriceRecurringInterval `json:"interval"`
+	IntervalCount   uint64                 `json:"interval_count"`
+	TrialPeriodDays uint64                 `json:"trial_period_days"`
+}
+
+// PriceTier is a single tier of a tiered price. UpTo is zero for the last,
+// unbounded tier.
+type PriceTier struct {
+	FlatAmount uint64 `json:"flat_amount"`
+	UnitAmount uint64 `json:"unit_amount"`
+	UpTo       uint64 `json:"up_to"`
+}
+
+// PriceTransformQuantity represents how the quantity reported for a price is
+// transformed before it's billed.
+type PriceTransformQuantity struct {
+	DivideBy uint64                      `json:"divide_by"`
+	Round    PriceTransformQuantityRound `json:"round"`
+}
+
+// Price is the resource representing a Stripe price. Prices supersede plans,
+// and every plan can also be referenced as a price with the same ID.
+// For more details see https://stripe.com/docs/api#prices.
+type Price struct {
+	APIResource
+	Active            bool                    `json:"active"`
+	BillingScheme     PriceBillingScheme      `json:"billing_scheme"`
+	Created           Timestamp               `json:"created"`
+	Currency          Currency                `json:"currency"`
+	Deleted           bool                    `json:"deleted"`
+	ID                string                  `json:"id"`
+	Live              bool                    `json:"livemode"`
+	LookupKey         string                  `json:"lookup_key"`
+	Meta              map[string]string       `json:"metadata"`
+	Nickname          string                  `json:"nickname"`
+	Product           *Product                `json:"product"`
+	Recurring         *PriceRecurring         `json:"recurring"`
+	Tiers             []*PriceTier            `json:"tiers"`
+	TiersMode         PriceTiersMode          `json:"tiers_mode"`
+	TransformQuantity *PriceTransformQuantity `json:"transform_quantity"`
+	Type              PriceType               `json:"type"`
+	UnitAmount        uint64                  `json:"unit_amount"`
+}
+
+// PriceList is a list of prices as returned from a list endpoint.
+type PriceList struct {
+	APIResource
+	ListMeta
+	Values []*Price `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a Price.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (p *Price) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		p.ID = id
+		return nil
+	}
+
+	type price Price
+	var pp price
+	err := json.Unmarshal(data, &pp)
+	if err != nil {
+		return err
+	}
+
+	*p = Price(pp)
+	return nil
+}
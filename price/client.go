@@ -0,0 +1,148 @@
+// Package price provides the /prices APIs
+package price
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	Day   stripe.PriceRecurringInterval = "day"
+	Week  stripe.PriceRecurringInterval = "week"
+	Month stripe.PriceRecurringInterval = "month"
+	Year  stripe.PriceRecurringInterval = "year"
+
+	PerUnit stripe.PriceBillingScheme = "per_unit"
+	Tiered  stripe.PriceBillingScheme = "tiered"
+
+	Graduated stripe.PriceTiersMode = "graduated"
+	Volume    stripe.PriceTiersMode = "volume"
+
+	RoundDown stripe.PriceTransformQuantityRound = "down"
+	RoundUp   stripe.PriceTransformQuantityRound = "up"
+
+	OneTime   stripe.PriceType = "one_time"
+	Recurring stripe.PriceType = "recurring"
+)
+
+// Client is used to invoke /prices APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new price.
+// For more details see https://stripe.com/docs/api#create_price.
+func New(params *stripe.PriceParams) (*stripe.Price, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.PriceParams) (*stripe.Price, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	price := &stripe.Price{}
+	err := c.B.Call("POST", "/prices", c.Key, body, commonParams, price)
+
+	return price, err
+}
+
+// Get returns the details of a price.
+// For more details see https://stripe.com/docs/api#retrieve_price.
+func Get(id string, params *stripe.PriceParams) (*stripe.Price, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.PriceParams) (*stripe.Price, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	price := &stripe.Price{}
+	err := c.B.Call("GET", fmt.Sprintf("/prices/%v", id), c.Key, body, commonParams, price)
+
+	return price, err
+}
+
+// Update updates a price's properties.
+// For more details see https://stripe.com/docs/api#update_price.
+func Update(id string, params *stripe.PriceParams) (*stripe.Price, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.PriceParams) (*stripe.Price, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	price := &stripe.Price{}
+	err := c.B.Call("POST", fmt.Sprintf("/prices/%v", id), c.Key, body, commonParams, price)
+
+	return price, err
+}
+
+// List returns a list of prices.
+// For more details see https://stripe.com/docs/api#list_prices.
+func List(params *stripe.PriceListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.PriceListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.PriceList{}
+		err := c.B.Call("GET", "/prices", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of Prices.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Price returns the most recent Price
+// visited by a call to Next.
+func (i *Iter) Price() *stripe.Price {
+	return i.Current().(*stripe.Price)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
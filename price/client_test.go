@@ -0,0 +1,66 @@
+package price
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestPriceGet(t *testing.T) {
+	price, err := Get("price_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, price)
+}
+
+func TestPriceList(t *testing.T) {
+	i := List(&stripe.PriceListParams{
+		LookupKeys: []string{"standard_monthly"},
+	})
+
+	// Verify that we can get at least one price
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.Price())
+}
+
+func TestPriceNew(t *testing.T) {
+	price, err := New(&stripe.PriceParams{
+		Currency:  "usd",
+		LookupKey: "standard_monthly",
+		Product:   "prod_123",
+		Recurring: &stripe.PriceRecurringParams{
+			Interval: Month,
+		},
+		UnitAmount: 1000,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, price)
+}
+
+func TestPriceNew_Tiered(t *testing.T) {
+	price, err := New(&stripe.PriceParams{
+		BillingScheme: Tiered,
+		Currency:      "usd",
+		Product:       "prod_123",
+		Recurring: &stripe.PriceRecurringParams{
+			Interval: Month,
+		},
+		Tiers: []*stripe.PriceTierParams{
+			{UnitAmount: 500, UpTo: 10},
+			{UnitAmount: 400, UpToInf: true},
+		},
+		TiersMode: Graduated,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, price)
+}
+
+func TestPriceUpdate(t *testing.T) {
+	price, err := Update("price_123", &stripe.PriceParams{
+		Nickname: "Standard",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, price)
+}
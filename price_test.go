@@ -0,0 +1,33 @@
+package stripe
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/form"
+)
+
+func TestPriceParams_AppendTo(t *testing.T) {
+	params := &PriceParams{
+		Tiers: []*PriceTierParams{
+			{UnitAmount: 500, UpTo: 10},
+			{FlatAmount: 100, UnitAmount: 400, UpToInf: true},
+		},
+		TransformQuantity: &PriceTransformQuantityParams{DivideBy: 100, Round: "up"},
+	}
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	t.Logf("body = %+v", body)
+	assert.Equal(t, []string{"10"}, body.Get("tiers[0][up_to]"))
+	assert.Equal(t, []string{"inf"}, body.Get("tiers[1][up_to]"))
+	assert.Equal(t, []string{"100"}, body.Get("tiers[1][flat_amount]"))
+	assert.Equal(t, []string{"100"}, body.Get("transform_quantity[divide_by]"))
+}
+
+func TestPriceListParams_AppendTo(t *testing.T) {
+	params := &PriceListParams{LookupKeys: []string{"basic", "premium"}}
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	t.Logf("body = %+v", body)
+	assert.Equal(t, []string{"basic", "premium"}, body.Get("lookup_keys[]"))
+}
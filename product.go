@@ -11,48 +11,75 @@ type PackageDimensions struct {
 	Width  float64 `json:"width" form:"width"`
 }
 
+// ProductMarketingFeatureParams is the set of parameters for a marketing
+// feature of a product.
+type ProductMarketingFeatureParams struct {
+	Name string `form:"name"`
+}
+
 // ProductParams is the set of parameters that can be used
 // when creating or updating a product.
 // For more details, see https://stripe.com/docs/api#create_product
 // and https://stripe.com/docs/api#update_product.
 type ProductParams struct {
-	Params            `form:"*"`
-	Active            *bool              `form:"active"`
-	Attrs             []string           `form:"attributes"`
-	Caption           string             `form:"caption"`
-	DeactivateOn      []string           `form:"deactivate_on"`
-	Desc              string             `form:"description"`
-	ID                string             `form:"id"`
-	Images            []string           `form:"images"`
+	Params       `form:"*"`
+	Active       *bool    `form:"active"`
+	Attrs        []string `form:"attributes"`
+	Caption      string   `form:"caption"`
+	DeactivateOn []string `form:"deactivate_on"`
+	DefaultPrice string   `form:"default_price"`
+	Desc         string   `form:"description"`
+	ID           string   `form:"id"`
+	Images       []string `form:"images"`
+
+	// MarketingFeatures replaces the marketing features of the product. Use
+	// MarketingFeaturesEmpty to remove all of them.
+	MarketingFeatures      []*ProductMarketingFeatureParams `form:"marketing_features,indexed"`
+	MarketingFeaturesEmpty bool                             `form:"marketing_features,empty"`
+
 	Name              string             `form:"name"`
 	PackageDimensions *PackageDimensions `form:"package_dimensions"`
 	Shippable         *bool              `form:"shippable"`
+	TaxCode           string             `form:"tax_code"`
+	UnitLabel         string             `form:"unit_label"`
 	URL               string             `form:"url"`
 }
 
+// ProductMarketingFeature is a feature of a product that's displayed to
+// customers, for example in a pricing table.
+type ProductMarketingFeature struct {
+	Name string `json:"name"`
+}
+
 // Product is the resource representing a Stripe product.
 // For more details see https://stripe.com/docs/api#products.
 type Product struct {
-	Active            bool               `json:"active"`
-	Attrs             []string           `json:"attributes"`
-	Caption           string             `json:"caption"`
-	Created           int64              `json:"created"`
-	DeactivateOn      []string           `json:"deactivate_on"`
-	Desc              string             `json:"description"`
-	ID                string             `json:"id"`
-	Images            []string           `json:"images"`
-	Live              bool               `json:"livemode"`
-	Meta              map[string]string  `json:"metadata"`
-	Name              string             `json:"name"`
-	PackageDimensions *PackageDimensions `json:"package_dimensions"`
-	Shippable         bool               `json:"shippable"`
-	Skus              *SKUList           `json:"skus"`
-	URL               string             `json:"url"`
-	Updated           int64              `json:"updated"`
+	APIResource
+	Active            bool                       `json:"active"`
+	Attrs             []string                   `json:"attributes"`
+	Caption           string                     `json:"caption"`
+	Created           Timestamp                  `json:"created"`
+	DeactivateOn      []string                   `json:"deactivate_on"`
+	DefaultPrice      *Price                     `json:"default_price"`
+	Desc              string                     `json:"description"`
+	ID                string                     `json:"id"`
+	Images            []string                   `json:"images"`
+	Live              bool                       `json:"livemode"`
+	MarketingFeatures []*ProductMarketingFeature `json:"marketing_features"`
+	Meta              map[string]string          `json:"metadata"`
+	Name              string                     `json:"name"`
+	PackageDimensions *PackageDimensions         `json:"package_dimensions"`
+	Shippable         bool                       `json:"shippable"`
+	Skus              *SKUList                   `json:"skus"`
+	TaxCode           string                     `json:"tax_code"`
+	UnitLabel         string                     `json:"unit_label"`
+	URL               string                     `json:"url"`
+	Updated           Timestamp                  `json:"updated"`
 }
 
 // ProductList is a list of products as retrieved from a list endpoint.
 type ProductList struct {
+	APIResource
 	ListMeta
 	Values []*Product `json:"data"`
 }
@@ -61,25 +88,87 @@ type ProductList struct {
 // listing products. For more details, see:
 // https://stripe.com/docs/api#list_products.
 type ProductListParams struct {
+	ListParams   `form:"*"`
+	Active       *bool             `form:"active"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	IDs          []string          `form:"ids"`
+	Shippable    *bool             `form:"shippable"`
+	URL          string            `form:"url"`
+}
+
+// ProductSearchParams is the set of parameters that can be used when
+// searching products. Page is the NextPage of a previous search result.
+// For more details see https://stripe.com/docs/api#search_products.
+type ProductSearchParams struct {
+	Params `form:"*"`
+	Limit  int    `form:"limit"`
+	Page   string `form:"page"`
+	Query  string `form:"query"`
+}
+
+// ProductSearchResult is a page of products as returned from a search.
+type ProductSearchResult struct {
+	APIResource
+	More     bool       `json:"has_more"`
+	NextPage string     `json:"next_page"`
+	URL      string     `json:"url"`
+	Values   []*Product `json:"data"`
+}
+
+// ProductFeatureListParams is the set of parameters that can be used when
+// listing the features attached to a product.
+// For more details see https://stripe.com/docs/api#list_product_features.
+type ProductFeatureListParams struct {
 	ListParams `form:"*"`
-	Active     *bool    `form:"active"`
-	IDs        []string `form:"ids"`
-	Shippable  *bool    `form:"shippable"`
-	URL        string   `form:"url"`
+	Product    string `form:"-"` // Included in URL
+}
+
+// EntitlementFeature is a feature that customers can be entitled to.
+type EntitlementFeature struct {
+	APIResource
+	Active    bool              `json:"active"`
+	ID        string            `json:"id"`
+	Live      bool              `json:"livemode"`
+	LookupKey string            `json:"lookup_key"`
+	Meta      map[string]string `json:"metadata"`
+	Name      string            `json:"name"`
+}
+
+// ProductFeature is the resource representing a feature attached to a
+// product. Customers subscribed to the product are entitled to the feature.
+// For more details see https://stripe.com/docs/api#product_features.
+type ProductFeature struct {
+	APIResource
+	EntitlementFeature *EntitlementFeature `json:"entitlement_feature"`
+	ID                 string              `json:"id"`
+	Live               bool                `json:"livemode"`
+}
+
+// ProductFeatureList is a list of product features as retrieved from a list
+// endpoint.
+type ProductFeatureList struct {
+	APIResource
+	ListMeta
+	Values []*ProductFeature `json:"data"`
 }
 
 // UnmarshalJSON handles deserialization of a Product.
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (p *Product) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		p.ID = id
+		return nil
+	}
+
 	type product Product
 	var pr product
 	err := json.Unmarshal(data, &pr)
-	if err == nil {
-		*p = Product(pr)
-	} else {
-		p.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*p = Product(pr)
 	return nil
 }
@@ -1,6 +1,8 @@
 package product
 
 import (
+	"fmt"
+
 	stripe "github.com/stripe/stripe-go"
 	"github.com/stripe/stripe-go/form"
 )
@@ -149,6 +151,70 @@ func (c Client) Del(id string, params *stripe.ProductParams) (*stripe.Product, e
 	return p, err
 }
 
+// Search returns a page of the products matching a search query. To get the
+// next page, search again with params.Page set to the NextPage of the result.
+// For more details see https://stripe.com/docs/api#search_products.
+func Search(params *stripe.ProductSearchParams) (*stripe.ProductSearchResult, error) {
+	return getC().Search(params)
+}
+
+func (c Client) Search(params *stripe.ProductSearchParams) (*stripe.ProductSearchResult, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	result := &stripe.ProductSearchResult{}
+	err := c.B.Call("GET", "/products/search", c.Key, body, commonParams, result)
+
+	return result, err
+}
+
+// ListFeatures returns a list of the features attached to a product.
+// For more details see https://stripe.com/docs/api#list_product_features.
+func ListFeatures(params *stripe.ProductFeatureListParams) *FeatureIter {
+	return getC().ListFeatures(params)
+}
+
+func (c Client) ListFeatures(params *stripe.ProductFeatureListParams) *FeatureIter {
+	body := &form.Values{}
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+	p = params.ToParams()
+
+	return &FeatureIter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.ProductFeatureList{}
+		err := c.B.Call("GET", fmt.Sprintf("/products/%v/features", params.Product), c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// FeatureIter is an iterator for lists of ProductFeatures.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type FeatureIter struct {
+	*stripe.Iter
+}
+
+// ProductFeature returns the most recent ProductFeature
+// visited by a call to Next.
+func (i *FeatureIter) ProductFeature() *stripe.ProductFeature {
+	return i.Current().(*stripe.ProductFeature)
+}
+
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -29,6 +29,15 @@ func TestProductList(t *testing.T) {
 	assert.NotNil(t, i.Product())
 }
 
+func TestProductListFeatures(t *testing.T) {
+	i := ListFeatures(&stripe.ProductFeatureListParams{Product: "prod_123"})
+
+	// Verify that we can get at least one feature
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.ProductFeature())
+}
+
 func TestProductNew(t *testing.T) {
 	active := true
 	shippable := true
@@ -52,6 +61,14 @@ func TestProductNew(t *testing.T) {
 	assert.NotNil(t, product)
 }
 
+func TestProductSearch(t *testing.T) {
+	result, err := Search(&stripe.ProductSearchParams{
+		Query: "active:'true' AND metadata['tier']:'gold'",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, result)
+}
+
 func TestProductUpdate(t *testing.T) {
 	product, err := Update("prod_123", &stripe.ProductParams{
 		Name: "Updated Name",
@@ -0,0 +1,64 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PromotionCode is the resource representing a Stripe promotion code, a
+// customer-facing code that applies a coupon.
+// For more details see https://stripe.com/docs/api#promotion_codes.
+type PromotionCode struct {
+	APIResource
+	Active         bool              `json:"active"`
+	Code           string            `json:"code"`
+	Coupon         *Coupon           `json:"coupon"`
+	Created        Timestamp         `json:"created"`
+	Customer       *Customer         `json:"customer"`
+	ExpiresAt      Timestamp         `json:"expires_at"`
+	ID             string            `json:"id"`
+	Live           bool              `json:"livemode"`
+	MaxRedemptions uint64            `json:"max_redemptions"`
+	Meta           map[string]string `json:"metadata"`
+	TimesRedeemed  uint64            `json:"times_redeemed"`
+}
+
+// Discount returns the amount, in the smallest unit of currency, that the
+// promotion code takes off amount. See Coupon.Discount. It returns an error if
+// the promotion code is inactive or has expired.
+func (p *PromotionCode) Discount(amount uint64, currency Currency) (uint64, error) {
+	if !p.Active {
+		return 0, fmt.Errorf("promotion code %v isn't active", p.Code)
+	}
+
+	if !p.ExpiresAt.IsZero() && !p.ExpiresAt.After(time.Now()) {
+		return 0, fmt.Errorf("promotion code %v has expired", p.Code)
+	}
+
+	if p.Coupon == nil {
+		return 0, fmt.Errorf("promotion code %v has no coupon", p.Code)
+	}
+
+	return p.Coupon.Discount(amount, currency)
+}
+
+// UnmarshalJSON handles deserialization of a PromotionCode.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (p *PromotionCode) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		p.ID = id
+		return nil
+	}
+
+	type promotionCode PromotionCode
+	var pp promotionCode
+	err := json.Unmarshal(data, &pp)
+	if err != nil {
+		return err
+	}
+
+	*p = PromotionCode(pp)
+	return nil
+}
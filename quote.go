@@ -0,0 +1,171 @@
+package stripe
+
+import "encoding/json"
+
+// QuoteCollectionMethod is the list of allowed values for how a quote's
+// invoice is collected. Allowed values are "charge_automatically" and
+// "send_invoice".
+type QuoteCollectionMethod string
+
+// QuoteStatus is the list of allowed values for the quote's status. Allowed
+// values are "accepted", "canceled", "draft", and "open".
+type QuoteStatus string
+
+// QuoteLineItemParams is the set of parameters that can be used for a line
+// item of a quote.
+type QuoteLineItemParams struct {
+	ID           string `form:"id"`
+	Price        string `form:"price"`
+	Quantity     uint64 `form:"quantity"`
+	QuantityZero bool   `form:"quantity,zero"`
+}
+
+// QuoteParams is the set of parameters that can be used when creating or
+// updating a quote.
+// For more details see https://stripe.com/docs/api#create_quote and https://stripe.com/docs/api#update_quote.
+type QuoteParams struct {
+	Params           `form:"*"`
+	CollectionMethod QuoteCollectionMethod  `form:"collection_method"`
+	Customer         string                 `form:"customer"`
+	DaysUntilDue     uint64                 `form:"days_until_due"`
+	Desc             string                 `form:"description"`
+	ExpiresAt        int64                  `form:"expires_at"`
+	Footer           string                 `form:"footer"`
+	Header           string                 `form:"header"`
+	LineItems        []*QuoteLineItemParams `form:"line_items,indexed"`
+
+	// DefaultTaxRates replaces the tax rates applied to the quote. Use
+	// DefaultTaxRatesEmpty to remove all of them.
+	DefaultTaxRates      []string `form:"default_tax_rates"`
+	DefaultTaxRatesEmpty bool     `form:"default_tax_rates,empty"`
+}
+
+// QuoteFinalizeParams is the set of parameters that can be used when
+// finalizing a quote.
+// For more details see https://stripe.com/docs/api#finalize_quote.
+type QuoteFinalizeParams struct {
+	Params    `form:"*"`
+	ExpiresAt int64 `form:"expires_at"`
+}
+
+// QuoteAcceptParams is the set of parameters that can be used when accepting
+// a quote.
+// For more details see https://stripe.com/docs/api#accept_quote.
+type QuoteAcceptParams struct {
+	Params `form:"*"`
+}
+
+// QuoteCancelParams is the set of parameters that can be used when canceling
+// a quote.
+// For more details see https://stripe.com/docs/api#cancel_quote.
+type QuoteCancelParams struct {
+	Params `form:"*"`
+}
+
+// QuotePDFParams is the set of parameters that can be used when downloading
+// the PDF of a quote.
+// For more details see https://stripe.com/docs/api#pdf_quote.
+type QuotePDFParams struct {
+	Params `form:"*"`
+}
+
+// QuoteListParams is the set of parameters that can be used when listing
+// quotes.
+// For more details see https://stripe.com/docs/api#list_quotes.
+type QuoteListParams struct {
+	ListParams `form:"*"`
+	Customer   string      `form:"customer"`
+	Status     QuoteStatus `form:"status"`
+}
+
+// QuoteLineItemListParams is the set of parameters that can be used when
+// listing the line items of a quote.
+// For more details see https://stripe.com/docs/api#list_quote_line_items.
+type QuoteLineItemListParams struct {
+	ListParams `form:"*"`
+
+	// ID is the quote ID to list line items for.
+	ID string `form:"-"` // Goes in the URL
+}
+
+// QuoteLineItem is the resource representing a Stripe quote line item.
+// For more details see https://stripe.com/docs/api#quote_line_item_object.
+type QuoteLineItem struct {
+	APIResource
+	AmountSubtotal int64    `json:"amount_subtotal"`
+	AmountTotal    int64    `json:"amount_total"`
+	Currency       Currency `json:"currency"`
+	Desc           string   `json:"description"`
+	ID             string   `json:"id"`
+	Price          *Price   `json:"price"`
+	Quantity       uint64   `json:"quantity"`
+}
+
+// QuoteLineItemList is a list of quote line items as retrieved from a list
+// endpoint.
+type QuoteLineItemList struct {
+	APIResource
+	ListMeta
+	Values []*QuoteLineItem `json:"data"`
+}
+
+// QuoteStatusTransitions contains the times at which a quote changed status.
+type QuoteStatusTransitions struct {
+	AcceptedAt  Timestamp `json:"accepted_at"`
+	CanceledAt  Timestamp `json:"canceled_at"`
+	FinalizedAt Timestamp `json:"finalized_at"`
+}
+
+// Quote is the resource representing a Stripe quote.
+// For more details see https://stripe.com/docs/api#quotes.
+type Quote struct {
+	APIResource
+	AmountSubtotal    int64                   `json:"amount_subtotal"`
+	AmountTotal       int64                   `json:"amount_total"`
+	CollectionMethod  QuoteCollectionMethod   `json:"collection_method"`
+	Created           Timestamp               `json:"created"`
+	Currency          Currency                `json:"currency"`
+	Customer          *Customer               `json:"customer"`
+	DaysUntilDue      uint64                  `json:"days_until_due"`
+	DefaultTaxRates   []*TaxRate              `json:"default_tax_rates"`
+	Desc              string                  `json:"description"`
+	ExpiresAt         Timestamp               `json:"expires_at"`
+	Footer            string                  `json:"footer"`
+	Header            string                  `json:"header"`
+	ID                string                  `json:"id"`
+	Invoice           *Invoice                `json:"invoice"`
+	LineItems         *QuoteLineItemList      `json:"line_items"`
+	Live              bool                    `json:"livemode"`
+	Meta              map[string]string       `json:"metadata"`
+	Number            string                  `json:"number"`
+	Status            QuoteStatus             `json:"status"`
+	StatusTransitions *QuoteStatusTransitions `json:"status_transitions"`
+	Sub               *Sub                    `json:"subscription"`
+}
+
+// QuoteList is a list of quotes as retrieved from a list endpoint.
+type QuoteList struct {
+	APIResource
+	ListMeta
+	Values []*Quote `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a Quote.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (q *Quote) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		q.ID = id
+		return nil
+	}
+
+	type quote Quote
+	var qq quote
+	err := json.Unmarshal(data, &qq)
+	if err != nil {
+		return err
+	}
+
+	*q = Quote(qq)
+	return nil
+}
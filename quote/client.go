@@ -0,0 +1,296 @@
+// Package quote provides the /quotes APIs
+package quote
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	CollectionMethodChargeAutomatically stripe.QuoteCollectionMethod = "charge_automatically"
+	CollectionMethodSendInvoice         stripe.QuoteCollectionMethod = "send_invoice"
+
+	StatusAccepted stripe.QuoteStatus = "accepted"
+	StatusCanceled stripe.QuoteStatus = "canceled"
+	StatusDraft    stripe.QuoteStatus = "draft"
+	StatusOpen     stripe.QuoteStatus = "open"
+)
+
+// Client is used to invoke /quotes APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+
+	// FilesB is the backend used to download quote PDFs, which are served
+	// from files.stripe.com rather than from the API. The global files
+	// backend is used if it's nil.
+	FilesB stripe.Backend
+}
+
+// New POSTs a new quote.
+// For more details see https://stripe.com/docs/api#create_quote.
+func New(params *stripe.QuoteParams) (*stripe.Quote, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.QuoteParams) (*stripe.Quote, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	quote := &stripe.Quote{}
+	err := c.B.Call("POST", "/quotes", c.Key, body, commonParams, quote)
+
+	return quote, err
+}
+
+// Get returns the details of a quote.
+// For more details see https://stripe.com/docs/api#retrieve_quote.
+func Get(id string, params *stripe.QuoteParams) (*stripe.Quote, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.QuoteParams) (*stripe.Quote, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	quote := &stripe.Quote{}
+	err := c.B.Call("GET", fmt.Sprintf("/quotes/%v", id), c.Key, body, commonParams, quote)
+
+	return quote, err
+}
+
+// Update updates a quote's properties.
+// For more details see https://stripe.com/docs/api#update_quote.
+func Update(id string, params *stripe.QuoteParams) (*stripe.Quote, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.QuoteParams) (*stripe.Quote, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	quote := &stripe.Quote{}
+	err := c.B.Call("POST", fmt.Sprintf("/quotes/%v", id), c.Key, body, commonParams, quote)
+
+	return quote, err
+}
+
+// Finalize finalizes a draft quote so that it can be sent to the customer.
+// For more details see https://stripe.com/docs/api#finalize_quote.
+func Finalize(id string, params *stripe.QuoteFinalizeParams) (*stripe.Quote, error) {
+	return getC().Finalize(id, params)
+}
+
+func (c Client) Finalize(id string, params *stripe.QuoteFinalizeParams) (*stripe.Quote, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	quote := &stripe.Quote{}
+	err := c.B.Call("POST", fmt.Sprintf("/quotes/%v/finalize", id), c.Key, body, commonParams, quote)
+
+	return quote, err
+}
+
+// Accept accepts a quote, which creates an invoice or a subscription for it.
+// For more details see https://stripe.com/docs/api#accept_quote.
+func Accept(id string, params *stripe.QuoteAcceptParams) (*stripe.Quote, error) {
+	return getC().Accept(id, params)
+}
+
+func (c Client) Accept(id string, params *stripe.QuoteAcceptParams) (*stripe.Quote, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	quote := &stripe.Quote{}
+	err := c.B.Call("POST", fmt.Sprintf("/quotes/%v/accept", id), c.Key, body, commonParams, quote)
+
+	return quote, err
+}
+
+// Cancel cancels a quote.
+// For more details see https://stripe.com/docs/api#cancel_quote.
+func Cancel(id string, params *stripe.QuoteCancelParams) (*stripe.Quote, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.QuoteCancelParams) (*stripe.Quote, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	quote := &stripe.Quote{}
+	err := c.B.Call("POST", fmt.Sprintf("/quotes/%v/cancel", id), c.Key, body, commonParams, quote)
+
+	return quote, err
+}
+
+// List returns a list of quotes.
+// For more details see https://stripe.com/docs/api#list_quotes.
+func List(params *stripe.QuoteListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.QuoteListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.QuoteList{}
+		err := c.B.Call("GET", "/quotes", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// ListLineItems returns a list of the line items of a quote.
+// For more details see https://stripe.com/docs/api#list_quote_line_items.
+func ListLineItems(params *stripe.QuoteLineItemListParams) *LineItemIter {
+	return getC().ListLineItems(params)
+}
+
+func (c Client) ListLineItems(params *stripe.QuoteLineItemListParams) *LineItemIter {
+	return c.listLineItems(params, "line_items")
+}
+
+// ListComputedUpfrontLineItems returns a list of the line items that will be
+// billed upfront when the quote is accepted, like the first period of a
+// subscription.
+// For more details see https://stripe.com/docs/api#list_quote_computed_upfront_line_items.
+func ListComputedUpfrontLineItems(params *stripe.QuoteLineItemListParams) *LineItemIter {
+	return getC().ListComputedUpfrontLineItems(params)
+}
+
+func (c Client) ListComputedUpfrontLineItems(params *stripe.QuoteLineItemListParams) *LineItemIter {
+	return c.listLineItems(params, "computed_upfront_line_items")
+}
+
+func (c Client) listLineItems(params *stripe.QuoteLineItemListParams, suffix string) *LineItemIter {
+	body := &form.Values{}
+	var lp *stripe.ListParams = &params.ListParams
+	var p *stripe.Params = params.ToParams()
+	form.AppendTo(body, params)
+
+	return &LineItemIter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.QuoteLineItemList{}
+		err := c.B.Call("GET", fmt.Sprintf("/quotes/%v/%v", params.ID, suffix), c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// PDF downloads the PDF of a finalized quote. The caller is responsible for
+// closing the returned body.
+// For more details see https://stripe.com/docs/api#pdf_quote.
+func PDF(id string, params *stripe.QuotePDFParams) (io.ReadCloser, error) {
+	return getC().PDF(id, params)
+}
+
+func (c Client) PDF(id string, params *stripe.QuotePDFParams) (io.ReadCloser, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	b := c.FilesB
+	if b == nil {
+		b = stripe.GetBackend(stripe.FilesBackend)
+	}
+
+	streaming, ok := b.(stripe.StreamingBackend)
+	if !ok {
+		return nil, errors.New("the files backend doesn't support downloading files")
+	}
+
+	return streaming.CallStreaming("GET", fmt.Sprintf("/quotes/%v/pdf", id), c.Key, body, commonParams)
+}
+
+// Iter is an iterator for lists of Quotes.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Quote returns the most recent Quote
+// visited by a call to Next.
+func (i *Iter) Quote() *stripe.Quote {
+	return i.Current().(*stripe.Quote)
+}
+
+// LineItemIter is an iterator for lists of QuoteLineItems.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type LineItemIter struct {
+	*stripe.Iter
+}
+
+// QuoteLineItem returns the most recent QuoteLineItem
+// visited by a call to Next.
+func (i *LineItemIter) QuoteLineItem() *stripe.QuoteLineItem {
+	return i.Current().(*stripe.QuoteLineItem)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey(), stripe.GetBackend(stripe.FilesBackend)}
+}
@@ -0,0 +1,117 @@
+package quote
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+// pdfBackend is a files backend that serves a fixed document.
+type pdfBackend struct {
+	path string
+}
+
+func (b *pdfBackend) Call(method, path, key string, body *form.Values, params *stripe.Params, v interface{}) error {
+	return nil
+}
+
+func (b *pdfBackend) CallMultipart(method, path, key, boundary string, body io.Reader, params *stripe.Params, v interface{}) error {
+	return nil
+}
+
+func (b *pdfBackend) CallStreaming(method, path, key string, body *form.Values, params *stripe.Params) (io.ReadCloser, error) {
+	b.path = path
+	return ioutil.NopCloser(strings.NewReader("%PDF-1.4")), nil
+}
+
+func TestQuoteAccept(t *testing.T) {
+	quote, err := Accept("qt_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, quote)
+}
+
+func TestQuoteCancel(t *testing.T) {
+	quote, err := Cancel("qt_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, quote)
+}
+
+func TestQuoteFinalize(t *testing.T) {
+	quote, err := Finalize("qt_123", &stripe.QuoteFinalizeParams{
+		ExpiresAt: 1546300800,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, quote)
+}
+
+func TestQuoteGet(t *testing.T) {
+	quote, err := Get("qt_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, quote)
+}
+
+func TestQuoteList(t *testing.T) {
+	i := List(&stripe.QuoteListParams{Status: StatusOpen})
+
+	// Verify that we can get at least one quote
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.Quote())
+}
+
+func TestQuoteListComputedUpfrontLineItems(t *testing.T) {
+	i := ListComputedUpfrontLineItems(&stripe.QuoteLineItemListParams{ID: "qt_123"})
+
+	// Verify that we can get at least one line item
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.QuoteLineItem())
+}
+
+func TestQuoteListLineItems(t *testing.T) {
+	i := ListLineItems(&stripe.QuoteLineItemListParams{ID: "qt_123"})
+
+	// Verify that we can get at least one line item
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.QuoteLineItem())
+}
+
+func TestQuoteNew(t *testing.T) {
+	quote, err := New(&stripe.QuoteParams{
+		Customer: "cus_123",
+		LineItems: []*stripe.QuoteLineItemParams{
+			{Price: "price_123", Quantity: 2},
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, quote)
+}
+
+func TestQuotePDF(t *testing.T) {
+	b := &pdfBackend{}
+	c := Client{FilesB: b}
+
+	pdf, err := c.PDF("qt_123", nil)
+	assert.Nil(t, err)
+	defer pdf.Close()
+
+	data, err := ioutil.ReadAll(pdf)
+	assert.Nil(t, err)
+	assert.Equal(t, "%PDF-1.4", string(data))
+	assert.Equal(t, "/quotes/qt_123/pdf", b.path)
+}
+
+func TestQuoteUpdate(t *testing.T) {
+	quote, err := Update("qt_123", &stripe.QuoteParams{
+		Desc: "Annual plan",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, quote)
+}
@@ -0,0 +1,95 @@
+// Package earlyfraudwarning provides the /radar/early_fraud_warnings APIs
+package earlyfraudwarning
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	FraudTypeCardNeverReceived         stripe.RadarEarlyFraudWarningFraudType = "card_never_received"
+	FraudTypeFraudulentCardApplication stripe.RadarEarlyFraudWarningFraudType = "fraudulent_card_application"
+	FraudTypeMadeWithCounterfeitCard   stripe.RadarEarlyFraudWarningFraudType = "made_with_counterfeit_card"
+	FraudTypeMadeWithLostCard          stripe.RadarEarlyFraudWarningFraudType = "made_with_lost_card"
+	FraudTypeMadeWithStolenCard        stripe.RadarEarlyFraudWarningFraudType = "made_with_stolen_card"
+	FraudTypeMisc                      stripe.RadarEarlyFraudWarningFraudType = "misc"
+	FraudTypeUnauthorizedUseOfCard     stripe.RadarEarlyFraudWarningFraudType = "unauthorized_use_of_card"
+)
+
+// Client is used to invoke /radar/early_fraud_warnings APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of an early fraud warning.
+// For more details see https://stripe.com/docs/api#retrieve_early_fraud_warning.
+func Get(id string, params *stripe.RadarEarlyFraudWarningParams) (*stripe.RadarEarlyFraudWarning, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.RadarEarlyFraudWarningParams) (*stripe.RadarEarlyFraudWarning, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	radarEarlyFraudWarning := &stripe.RadarEarlyFraudWarning{}
+	err := c.B.Call("GET", fmt.Sprintf("/radar/early_fraud_warnings/%v", id), c.Key, body, commonParams, radarEarlyFraudWarning)
+
+	return radarEarlyFraudWarning, err
+}
+
+// List returns a list of early fraud warnings.
+// For more details see https://stripe.com/docs/api#list_early_fraud_warnings.
+func List(params *stripe.RadarEarlyFraudWarningListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.RadarEarlyFraudWarningListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.RadarEarlyFraudWarningList{}
+		err := c.B.Call("GET", "/radar/early_fraud_warnings", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of RadarEarlyFraudWarnings.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// RadarEarlyFraudWarning returns the most recent RadarEarlyFraudWarning
+// visited by a call to Next.
+func (i *Iter) RadarEarlyFraudWarning() *stripe.RadarEarlyFraudWarning {
+	return i.Current().(*stripe.RadarEarlyFraudWarning)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,24 @@
+package earlyfraudwarning
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestRadarEarlyFraudWarningGet(t *testing.T) {
+	warning, err := Get("issfr_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, warning)
+}
+
+func TestRadarEarlyFraudWarningList(t *testing.T) {
+	i := List(&stripe.RadarEarlyFraudWarningListParams{Charge: "ch_123"})
+
+	// Verify that we can get at least one early fraud warning
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.RadarEarlyFraudWarning())
+}
@@ -0,0 +1,161 @@
+// Package valuelist provides the /radar/value_lists APIs
+package valuelist
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	ItemTypeCardBin             stripe.RadarValueListItemType = "card_bin"
+	ItemTypeCardFingerprint     stripe.RadarValueListItemType = "card_fingerprint"
+	ItemTypeCaseSensitiveString stripe.RadarValueListItemType = "case_sensitive_string"
+	ItemTypeCountry             stripe.RadarValueListItemType = "country"
+	ItemTypeEmail               stripe.RadarValueListItemType = "email"
+	ItemTypeIPAddress           stripe.RadarValueListItemType = "ip_address"
+	ItemTypeString              stripe.RadarValueListItemType = "string"
+)
+
+// Client is used to invoke /radar/value_lists APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new value list.
+// For more details see https://stripe.com/docs/api#create_radar_value_list.
+func New(params *stripe.RadarValueListParams) (*stripe.RadarValueList, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.RadarValueListParams) (*stripe.RadarValueList, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	radarValueList := &stripe.RadarValueList{}
+	err := c.B.Call("POST", "/radar/value_lists", c.Key, body, commonParams, radarValueList)
+
+	return radarValueList, err
+}
+
+// Get returns the details of a value list.
+// For more details see https://stripe.com/docs/api#retrieve_radar_value_list.
+func Get(id string, params *stripe.RadarValueListParams) (*stripe.RadarValueList, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.RadarValueListParams) (*stripe.RadarValueList, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	radarValueList := &stripe.RadarValueList{}
+	err := c.B.Call("GET", fmt.Sprintf("/radar/value_lists/%v", id), c.Key, body, commonParams, radarValueList)
+
+	return radarValueList, err
+}
+
+// Update updates a value list's properties.
+// For more details see https://stripe.com/docs/api#update_radar_value_list.
+func Update(id string, params *stripe.RadarValueListParams) (*stripe.RadarValueList, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.RadarValueListParams) (*stripe.RadarValueList, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	radarValueList := &stripe.RadarValueList{}
+	err := c.B.Call("POST", fmt.Sprintf("/radar/value_lists/%v", id), c.Key, body, commonParams, radarValueList)
+
+	return radarValueList, err
+}
+
+// Del removes a value list.
+// For more details see https://stripe.com/docs/api#delete_radar_value_list.
+func Del(id string, params *stripe.RadarValueListParams) (*stripe.RadarValueList, error) {
+	return getC().Del(id, params)
+}
+
+func (c Client) Del(id string, params *stripe.RadarValueListParams) (*stripe.RadarValueList, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	radarValueList := &stripe.RadarValueList{}
+	err := c.B.Call("DELETE", fmt.Sprintf("/radar/value_lists/%v", id), c.Key, body, commonParams, radarValueList)
+
+	return radarValueList, err
+}
+
+// List returns a list of value lists.
+// For more details see https://stripe.com/docs/api#list_radar_value_lists.
+func List(params *stripe.RadarValueListListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.RadarValueListListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.RadarValueListList{}
+		err := c.B.Call("GET", "/radar/value_lists", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of RadarValueLists.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// RadarValueList returns the most recent RadarValueList
+// visited by a call to Next.
+func (i *Iter) RadarValueList() *stripe.RadarValueList {
+	return i.Current().(*stripe.RadarValueList)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
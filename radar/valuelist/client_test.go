@@ -0,0 +1,48 @@
+package valuelist
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestRadarValueListDel(t *testing.T) {
+	valueList, err := Del("rsl_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, valueList)
+}
+
+func TestRadarValueListGet(t *testing.T) {
+	valueList, err := Get("rsl_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, valueList)
+}
+
+func TestRadarValueListList(t *testing.T) {
+	i := List(&stripe.RadarValueListListParams{Alias: "blocked_emails"})
+
+	// Verify that we can get at least one value list
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.RadarValueList())
+}
+
+func TestRadarValueListNew(t *testing.T) {
+	valueList, err := New(&stripe.RadarValueListParams{
+		Alias:    "blocked_emails",
+		ItemType: ItemTypeEmail,
+		Name:     "Blocked emails",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, valueList)
+}
+
+func TestRadarValueListUpdate(t *testing.T) {
+	valueList, err := Update("rsl_123", &stripe.RadarValueListParams{
+		Name: "Blocked customer emails",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, valueList)
+}
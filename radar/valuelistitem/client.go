@@ -0,0 +1,130 @@
+// Package valuelistitem provides the /radar/value_list_items APIs
+package valuelistitem
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /radar/value_list_items APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new value list item.
+// For more details see https://stripe.com/docs/api#create_radar_value_list_item.
+func New(params *stripe.RadarValueListItemParams) (*stripe.RadarValueListItem, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.RadarValueListItemParams) (*stripe.RadarValueListItem, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	radarValueListItem := &stripe.RadarValueListItem{}
+	err := c.B.Call("POST", "/radar/value_list_items", c.Key, body, commonParams, radarValueListItem)
+
+	return radarValueListItem, err
+}
+
+// Get returns the details of a value list item.
+// For more details see https://stripe.com/docs/api#retrieve_radar_value_list_item.
+func Get(id string, params *stripe.RadarValueListItemParams) (*stripe.RadarValueListItem, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.RadarValueListItemParams) (*stripe.RadarValueListItem, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	radarValueListItem := &stripe.RadarValueListItem{}
+	err := c.B.Call("GET", fmt.Sprintf("/radar/value_list_items/%v", id), c.Key, body, commonParams, radarValueListItem)
+
+	return radarValueListItem, err
+}
+
+// Del removes a value list item.
+// For more details see https://stripe.com/docs/api#delete_radar_value_list_item.
+func Del(id string, params *stripe.RadarValueListItemParams) (*stripe.RadarValueListItem, error) {
+	return getC().Del(id, params)
+}
+
+func (c Client) Del(id string, params *stripe.RadarValueListItemParams) (*stripe.RadarValueListItem, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	radarValueListItem := &stripe.RadarValueListItem{}
+	err := c.B.Call("DELETE", fmt.Sprintf("/radar/value_list_items/%v", id), c.Key, body, commonParams, radarValueListItem)
+
+	return radarValueListItem, err
+}
+
+// List returns a list of the items of a value list. params.ValueList must be
+// set.
+// For more details see https://stripe.com/docs/api#list_radar_value_list_items.
+func List(params *stripe.RadarValueListItemListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.RadarValueListItemListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.RadarValueListItemList{}
+		err := c.B.Call("GET", "/radar/value_list_items", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of RadarValueListItems.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// RadarValueListItem returns the most recent RadarValueListItem
+// visited by a call to Next.
+func (i *Iter) RadarValueListItem() *stripe.RadarValueListItem {
+	return i.Current().(*stripe.RadarValueListItem)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
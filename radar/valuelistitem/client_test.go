@@ -0,0 +1,39 @@
+package valuelistitem
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestRadarValueListItemDel(t *testing.T) {
+	item, err := Del("rsli_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, item)
+}
+
+func TestRadarValueListItemGet(t *testing.T) {
+	item, err := Get("rsli_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, item)
+}
+
+func TestRadarValueListItemList(t *testing.T) {
+	i := List(&stripe.RadarValueListItemListParams{ValueList: "rsl_123"})
+
+	// Verify that we can get at least one value list item
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.RadarValueListItem())
+}
+
+func TestRadarValueListItemNew(t *testing.T) {
+	item, err := New(&stripe.RadarValueListItemParams{
+		Value:     "fraudster@example.com",
+		ValueList: "rsl_123",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, item)
+}
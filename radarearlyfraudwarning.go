@@ -0,0 +1,68 @@
+package stripe
+
+import "encoding/json"
+
+// RadarEarlyFraudWarningFraudType is the list of allowed values for the type
+// of fraud reported by an early fraud warning. Allowed values are
+// "card_never_received", "fraudulent_card_application",
+// "made_with_counterfeit_card", "made_with_lost_card",
+// "made_with_stolen_card", "misc", and "unauthorized_use_of_card".
+type RadarEarlyFraudWarningFraudType string
+
+// RadarEarlyFraudWarningParams is the set of parameters that can be used
+// when retrieving an early fraud warning.
+// For more details see https://stripe.com/docs/api#retrieve_early_fraud_warning.
+type RadarEarlyFraudWarningParams struct {
+	Params `form:"*"`
+}
+
+// RadarEarlyFraudWarningListParams is the set of parameters that can be used
+// when listing early fraud warnings.
+// For more details see https://stripe.com/docs/api#list_early_fraud_warnings.
+type RadarEarlyFraudWarningListParams struct {
+	ListParams   `form:"*"`
+	Charge       string            `form:"charge"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+}
+
+// RadarEarlyFraudWarning is the resource representing a Stripe early fraud
+// warning, which card issuers send when they believe a charge is fraudulent.
+// For more details see https://stripe.com/docs/api#early_fraud_warnings.
+type RadarEarlyFraudWarning struct {
+	APIResource
+	Actionable bool                            `json:"actionable"`
+	Charge     *Charge                         `json:"charge"`
+	Created    Timestamp                       `json:"created"`
+	FraudType  RadarEarlyFraudWarningFraudType `json:"fraud_type"`
+	ID         string                          `json:"id"`
+	Live       bool                            `json:"livemode"`
+}
+
+// RadarEarlyFraudWarningList is a list of early fraud warnings as retrieved
+// from a list endpoint.
+type RadarEarlyFraudWarningList struct {
+	APIResource
+	ListMeta
+	Values []*RadarEarlyFraudWarning `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a RadarEarlyFraudWarning.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (r *RadarEarlyFraudWarning) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		r.ID = id
+		return nil
+	}
+
+	type radarEarlyFraudWarning RadarEarlyFraudWarning
+	var rr radarEarlyFraudWarning
+	err := json.Unmarshal(data, &rr)
+	if err != nil {
+		return err
+	}
+
+	*r = RadarEarlyFraudWarning(rr)
+	return nil
+}
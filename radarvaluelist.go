@@ -0,0 +1,75 @@
+package stripe
+
+import "encoding/json"
+
+// RadarValueListItemType is the list of allowed values for the type of the
+// items of a radar value list. Allowed values are "card_bin",
+// "card_fingerprint", "case_sensitive_string", "country", "email",
+// "ip_address", and "string".
+type RadarValueListItemType string
+
+// RadarValueListParams is the set of parameters that can be used when
+// creating or updating a radar value list.
+// For more details see https://stripe.com/docs/api#create_radar_value_list and https://stripe.com/docs/api#update_radar_value_list.
+type RadarValueListParams struct {
+	Params   `form:"*"`
+	Alias    string                 `form:"alias"`
+	ItemType RadarValueListItemType `form:"item_type"`
+	Name     string                 `form:"name"`
+}
+
+// RadarValueListListParams is the set of parameters that can be used when
+// listing radar value lists.
+// For more details see https://stripe.com/docs/api#list_radar_value_lists.
+type RadarValueListListParams struct {
+	ListParams   `form:"*"`
+	Alias        string            `form:"alias"`
+	Contains     string            `form:"contains"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+}
+
+// RadarValueList is the resource representing a Stripe radar value list,
+// a list of values that Radar rules can use to block or allow payments.
+// For more details see https://stripe.com/docs/api#radar_value_lists.
+type RadarValueList struct {
+	APIResource
+	Alias     string                  `json:"alias"`
+	Created   Timestamp               `json:"created"`
+	CreatedBy string                  `json:"created_by"`
+	Deleted   bool                    `json:"deleted"`
+	ID        string                  `json:"id"`
+	ItemType  RadarValueListItemType  `json:"item_type"`
+	ListItems *RadarValueListItemList `json:"list_items"`
+	Live      bool                    `json:"livemode"`
+	Meta      map[string]string       `json:"metadata"`
+	Name      string                  `json:"name"`
+}
+
+// RadarValueListList is a list of radar value lists as retrieved from a list
+// endpoint.
+type RadarValueListList struct {
+	APIResource
+	ListMeta
+	Values []*RadarValueList `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a RadarValueList.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (r *RadarValueList) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		r.ID = id
+		return nil
+	}
+
+	type radarValueList RadarValueList
+	var rr radarValueList
+	err := json.Unmarshal(data, &rr)
+	if err != nil {
+		return err
+	}
+
+	*r = RadarValueList(rr)
+	return nil
+}
@@ -0,0 +1,65 @@
+package stripe
+
+import "encoding/json"
+
+// RadarValueListItemParams is the set of parameters that can be used when
+// creating a radar value list item.
+// For more details see https://stripe.com/docs/api#create_radar_value_list_item.
+type RadarValueListItemParams struct {
+	Params    `form:"*"`
+	Value     string `form:"value"`
+	ValueList string `form:"value_list"`
+}
+
+// RadarValueListItemListParams is the set of parameters that can be used
+// when listing radar value list items. ValueList is required.
+// For more details see https://stripe.com/docs/api#list_radar_value_list_items.
+type RadarValueListItemListParams struct {
+	ListParams   `form:"*"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Value        string            `form:"value"`
+	ValueList    string            `form:"value_list"`
+}
+
+// RadarValueListItem is the resource representing a single value of a
+// Stripe radar value list.
+// For more details see https://stripe.com/docs/api#radar_value_list_items.
+type RadarValueListItem struct {
+	APIResource
+	Created   Timestamp `json:"created"`
+	CreatedBy string    `json:"created_by"`
+	Deleted   bool      `json:"deleted"`
+	ID        string    `json:"id"`
+	Live      bool      `json:"livemode"`
+	Value     string    `json:"value"`
+	ValueList string    `json:"value_list"`
+}
+
+// RadarValueListItemList is a list of radar value list items as retrieved
+// from a list endpoint.
+type RadarValueListItemList struct {
+	APIResource
+	ListMeta
+	Values []*RadarValueListItem `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a RadarValueListItem.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (r *RadarValueListItem) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		r.ID = id
+		return nil
+	}
+
+	type radarValueListItem RadarValueListItem
+	var rr radarValueListItem
+	err := json.Unmarshal(data, &rr)
+	if err != nil {
+		return err
+	}
+
+	*r = RadarValueListItem(rr)
+	return nil
+}
@@ -0,0 +1,112 @@
+package stripe
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// rateLimitBackoff is how long a rate limited request waits before its
+	// first retry when the response doesn't say. The wait doubles after
+	// every subsequent attempt.
+	rateLimitBackoff = 500 * time.Millisecond
+
+	// maxRateLimitBackoff caps the wait between two attempts when the
+	// response doesn't say how long to wait.
+	maxRateLimitBackoff = 8 * time.Second
+)
+
+// ConcurrencyLimiter limits the number of requests in flight, like the
+// requests of a batch job, to stay clear of the rate limits of the API. A
+// request holds its slot until its response has been read. The same limiter
+// can be shared by several backends so that their requests are limited
+// together.
+type ConcurrencyLimiter struct {
+	slots chan struct{}
+}
+
+// NewConcurrencyLimiter returns a ConcurrencyLimiter allowing at most n
+// requests in flight. n is raised to 1 if it's lower, since a limiter without
+// slots would block every request forever.
+func NewConcurrencyLimiter(n int) *ConcurrencyLimiter {
+	if n < 1 {
+		n = 1
+	}
+	return &ConcurrencyLimiter{slots: make(chan struct{}, n)}
+}
+
+// acquire waits for a slot to be free, or for ctx to be done.
+func (l *ConcurrencyLimiter) acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release frees the slot taken by a request.
+func (l *ConcurrencyLimiter) release() {
+	<-l.slots
+}
+
+// parseRetryAfter returns the wait asked for by the Retry-After header of
+// res, or 0 if there's none. Only the number of seconds form is used by
+// Stripe.
+func parseRetryAfter(res *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(res.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// waitRateLimit waits before a rate limited request is retried. It returns
+// false at once if the wait would end past deadline, the time by which the
+// request has to be done if it isn't zero, and as soon as ctx is done, so
+// that a request that can't be retried in time stops waiting.
+func waitRateLimit(ctx context.Context, wait time.Duration, deadline time.Time) bool {
+	if !deadline.IsZero() && time.Now().Add(wait).After(deadline) {
+		return false
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// rateLimitWait returns how long to wait before retrying a request that
+// failed with err, or false if err isn't a rate limiting error or the request
+// has been attempted too many times.
+func (s *BackendConfiguration) rateLimitWait(err error, attempt int) (time.Duration, bool) {
+	if attempt >= s.MaxRateLimitRetries {
+		return 0, false
+	}
+
+	stripeErr, ok := err.(*Error)
+	if !ok {
+		return 0, false
+	}
+	rateLimitErr, ok := stripeErr.Err.(*RateLimitError)
+	if !ok {
+		return 0, false
+	}
+
+	if rateLimitErr.RetryAfter > 0 {
+		return rateLimitErr.RetryAfter, true
+	}
+
+	wait := rateLimitBackoff << uint(attempt)
+	if wait > maxRateLimitBackoff || wait <= 0 {
+		wait = maxRateLimitBackoff
+	}
+	return wait, true
+}
@@ -0,0 +1,165 @@
+package stripe_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const rateLimitedBody = `{"error":{"type":"invalid_request_error","code":"rate_limit","message":"Too many requests"}}`
+
+func TestRateLimitError(t *testing.T) {
+	c := &stripe.BackendConfiguration{URL: stripe.APIURL}
+	res := &http.Response{
+		Header:     http.Header{"Retry-After": []string{"2"}},
+		StatusCode: http.StatusTooManyRequests,
+	}
+
+	err := c.ResponseToError(res, []byte(rateLimitedBody))
+	stripeErr := err.(*stripe.Error)
+	assert.Equal(t, stripe.RateLimit, stripeErr.Code)
+
+	rateLimitErr, ok := stripeErr.Err.(*stripe.RateLimitError)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, rateLimitErr.RetryAfter)
+}
+
+func TestRateLimitRetries(t *testing.T) {
+	var requests int32
+	var bodies []string
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		mu.Lock()
+		bodies = append(bodies, r.PostForm.Encode())
+		mu.Unlock()
+
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(rateLimitedBody))
+			return
+		}
+		w.Write([]byte(`{"id":"ch_123"}`))
+	}))
+	defer server.Close()
+
+	c := &stripe.BackendConfiguration{URL: server.URL + "/v1", MaxRateLimitRetries: 1}
+
+	body := &form.Values{}
+	body.Add("amount", "123")
+	charge := &stripe.Charge{}
+	err := c.Call("POST", "/charges", "", body, nil, charge)
+	assert.NoError(t, err)
+	assert.Equal(t, "ch_123", charge.ID)
+
+	// The body is sent again with the retry
+	assert.Equal(t, []string{"amount=123", "amount=123"}, bodies)
+
+	// Retries are disabled by default
+	atomic.StoreInt32(&requests, 0)
+	c.MaxRateLimitRetries = 0
+	err = c.Call("POST", "/charges", "", body, nil, &stripe.Charge{})
+	_, ok := err.(*stripe.Error).Err.(*stripe.RateLimitError)
+	assert.True(t, ok)
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.Write([]byte(`{"id":"ch_123"}`))
+	}))
+	defer server.Close()
+
+	c := &stripe.BackendConfiguration{URL: server.URL + "/v1", Limiter: stripe.NewConcurrencyLimiter(2)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := c.Call("GET", "/charges/ch_123", "", nil, nil, &stripe.Charge{})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, atomic.LoadInt32(&maxInFlight) <= 2)
+}
+
+func TestRateLimitRetriesStopAtTimeout(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(rateLimitedBody))
+	}))
+	defer server.Close()
+
+	c := &stripe.BackendConfiguration{
+		URL:                 server.URL + "/v1",
+		MaxRateLimitRetries: 1,
+		Timeout:             50 * time.Millisecond,
+	}
+
+	start := time.Now()
+	err := c.Call("GET", "/charges/ch_123", "", nil, nil, &stripe.Charge{})
+	assert.True(t, time.Since(start) < 10*time.Second)
+	_, ok := err.(*stripe.Error).Err.(*stripe.RateLimitError)
+	assert.True(t, ok)
+
+	// A wait longer than Timeout isn't even started
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestRateLimitRetriesShareTimeout(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(rateLimitedBody))
+	}))
+	defer server.Close()
+
+	c := &stripe.BackendConfiguration{
+		URL:                 server.URL + "/v1",
+		MaxRateLimitRetries: 5,
+		Timeout:             1500 * time.Millisecond,
+	}
+
+	// Only one retry fits in Timeout, however many are allowed
+	err := c.Call("GET", "/charges/ch_123", "", nil, nil, &stripe.Charge{})
+	_, ok := err.(*stripe.Error).Err.(*stripe.RateLimitError)
+	assert.True(t, ok)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestNewConcurrencyLimiterWithoutSlots(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"ch_123"}`))
+	}))
+	defer server.Close()
+
+	// A limiter asked for no slots still lets one request through at a time
+	c := &stripe.BackendConfiguration{URL: server.URL + "/v1", Limiter: stripe.NewConcurrencyLimiter(0)}
+	err := c.Call("GET", "/charges/ch_123", "", nil, nil, &stripe.Charge{})
+	assert.NoError(t, err)
+}
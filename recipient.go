@@ -42,16 +42,19 @@ func (p *RecipientParams) AppendTo(body *form.Values, keyParts []string) {
 // RecipientListParams is the set of parameters that can be used when listing recipients.
 // For more details see https://stripe.com/docs/api#list_recipients.
 type RecipientListParams struct {
-	ListParams `form:"*"`
-	Verified   bool `form:"verified"`
+	ListParams   `form:"*"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Verified     bool              `form:"verified"`
 }
 
 // Recipient is the resource representing a Stripe recipient.
 // For more details see https://stripe.com/docs/api#recipients.
 type Recipient struct {
+	APIResource
 	Bank        *BankAccount      `json:"active_account"`
 	Cards       *CardList         `json:"cards"`
-	Created     int64             `json:"created"`
+	Created     Timestamp         `json:"created"`
 	DefaultCard *Card             `json:"default_card"`
 	Deleted     bool              `json:"deleted"`
 	Desc        string            `json:"description"`
@@ -66,6 +69,7 @@ type Recipient struct {
 
 // RecipientList is a list of recipients as retrieved from a list endpoint.
 type RecipientList struct {
+	APIResource
 	ListMeta
 	Values []*Recipient `json:"data"`
 }
@@ -74,15 +78,18 @@ type RecipientList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (r *Recipient) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		r.ID = id
+		return nil
+	}
+
 	type recipient Recipient
 	var rr recipient
 	err := json.Unmarshal(data, &rr)
-	if err == nil {
-		*r = Recipient(rr)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		r.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*r = Recipient(rr)
 	return nil
 }
@@ -131,5 +131,5 @@ func (i *Iter) Recipient() *stripe.Recipient {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -57,14 +57,15 @@ type RecipientTransferDestination struct {
 // RecipientTransfer is the resource representing a Stripe recipient_transfer.
 // For more details see https://stripe.com/docs/api#recipient_transfers.
 type RecipientTransfer struct {
+	APIResource
 	Amount             int64                        `json:"amount"`
 	AmountReversed     int64                        `json:"amount_reversed"`
 	BalanceTransaction *Transaction                 `json:"balance_transaction"`
 	Bank               *BankAccount                 `json:"bank_account"`
 	Card               *Card                        `json:"card"`
-	Created            int64                        `json:"created"`
+	Created            Timestamp                    `json:"created"`
 	Currency           Currency                     `json:"currency"`
-	Date               int64                        `json:"date"`
+	Date               Timestamp                    `json:"date"`
 	Desc               string                       `json:"description"`
 	Dest               RecipientTransferDestination `json:"destination"`
 	FailCode           RecipientTransferFailCode    `json:"failure_code"`
@@ -87,16 +88,19 @@ type RecipientTransfer struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (t *RecipientTransfer) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
 	type transfer RecipientTransfer
 	var tb transfer
 	err := json.Unmarshal(data, &tb)
-	if err == nil {
-		*t = RecipientTransfer(tb)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		t.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*t = RecipientTransfer(tb)
 	return nil
 }
 
@@ -104,24 +108,28 @@ func (t *RecipientTransfer) UnmarshalJSON(data []byte) error {
 // This custom unmarshaling is needed because the specific
 // type of destination it refers to is specified in the JSON
 func (d *RecipientTransferDestination) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		d.ID = id
+		return nil
+	}
+
 	type dest RecipientTransferDestination
 	var dd dest
 	err := json.Unmarshal(data, &dd)
-	if err == nil {
-		*d = RecipientTransferDestination(dd)
-
-		switch d.Type {
-		case RecipientTransferDestinationBankAccount:
-			json.Unmarshal(data, &d.BankAccount)
-		case RecipientTransferDestinationCard:
-			json.Unmarshal(data, &d.Card)
-		}
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		d.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
-	return nil
+	*d = RecipientTransferDestination(dd)
+
+	switch d.Type {
+	case RecipientTransferDestinationBankAccount:
+		err = json.Unmarshal(data, &d.BankAccount)
+	case RecipientTransferDestinationCard:
+		err = json.Unmarshal(data, &d.Card)
+	}
+
+	return err
 }
 
 // MarshalJSON handles serialization of a RecipientTransferDestination.
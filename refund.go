@@ -2,49 +2,82 @@ package stripe
 
 import "encoding/json"
 
+// RefundFailureReason is the reason a refund failed.
+type RefundFailureReason string
+
 // RefundReason is, if set, the reason the refund is being made--allowed values
 // are "fraudulent", "duplicate", and "requested_by_customer".
 type RefundReason string
 
 // RefundStatus is the status of the refund.
 // For credit card refunds, this will always be "succeeded".
-// For other types of refunds, it can be "pending", "succeeded", "failed", or "cancelled".
+// For other types of refunds, it can be "pending", "requires_action",
+// "succeeded", "failed", or "canceled".
 type RefundStatus string
 
 // RefundParams is the set of parameters that can be used when refunding a charge.
 // For more details see https://stripe.com/docs/api#refund.
 type RefundParams struct {
-	Params   `form:"*"`
-	Amount   uint64       `form:"amount"`
-	Charge   string       `form:"charge"`
-	Fee      bool         `form:"refund_application_fee"`
-	Reason   RefundReason `form:"reason"`
-	Transfer bool         `form:"reverse_transfer"`
+	Params            `form:"*"`
+	Amount            uint64       `form:"amount"`
+	Charge            string       `form:"charge"`
+	Fee               bool         `form:"refund_application_fee"`
+	InstructionsEmail string       `form:"instructions_email"`
+	PaymentIntent     string       `form:"payment_intent"`
+	Reason            RefundReason `form:"reason"`
+	Transfer          bool         `form:"reverse_transfer"`
 }
 
 // RefundListParams is the set of parameters that can be used when listing refunds.
 // For more details see https://stripe.com/docs/api#list_refunds.
 type RefundListParams struct {
-	ListParams `form:"*"`
+	ListParams    `form:"*"`
+	Charge        string            `form:"charge"`
+	Created       int64             `form:"created"`
+	CreatedRange  *RangeQueryParams `form:"created"`
+	PaymentIntent string            `form:"payment_intent"`
+}
+
+// RefundDestinationDetailsCard is the card-specific information about where a
+// refund was sent.
+type RefundDestinationDetailsCard struct {
+	Reference       string `json:"reference"`
+	ReferenceStatus string `json:"reference_status"`
+	ReferenceType   string `json:"reference_type"`
+	Type            string `json:"type"`
+}
+
+// RefundDestinationDetails describes where a refund was sent. Type indicates
+// which of the other fields, if any, is filled in.
+type RefundDestinationDetails struct {
+	Card *RefundDestinationDetailsCard `json:"card"`
+	Type string                        `json:"type"`
 }
 
 // Refund is the resource representing a Stripe refund.
 // For more details see https://stripe.com/docs/api#refunds.
 type Refund struct {
-	Amount        uint64            `json:"amount"`
-	Charge        *Charge           `json:"charge"`
-	Created       int64             `json:"created"`
-	Currency      Currency          `json:"currency"`
-	ID            string            `json:"id"`
-	Meta          map[string]string `json:"metadata"`
-	Reason        RefundReason      `json:"reason"`
-	ReceiptNumber string            `json:"receipt_number"`
-	Status        RefundStatus      `json:"status"`
-	Tx            *Transaction      `json:"balance_transaction"`
+	APIResource
+	Amount             uint64                    `json:"amount"`
+	Charge             *Charge                   `json:"charge"`
+	Created            Timestamp                 `json:"created"`
+	Currency           Currency                  `json:"currency"`
+	DestinationDetails *RefundDestinationDetails `json:"destination_details"`
+	FailureReason      RefundFailureReason       `json:"failure_reason"`
+	FailureTx          *Transaction              `json:"failure_balance_transaction"`
+	ID                 string                    `json:"id"`
+	InstructionsEmail  string                    `json:"instructions_email"`
+	Meta               map[string]string         `json:"metadata"`
+	PaymentIntent      *PaymentIntent            `json:"payment_intent"`
+	Reason             RefundReason              `json:"reason"`
+	ReceiptNumber      string                    `json:"receipt_number"`
+	Status             RefundStatus              `json:"status"`
+	Tx                 *Transaction              `json:"balance_transaction"`
 }
 
 // RefundList is a list object for refunds.
 type RefundList struct {
+	APIResource
 	ListMeta
 	Values []*Refund `json:"data"`
 }
@@ -53,15 +86,18 @@ type RefundList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (r *Refund) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		r.ID = id
+		return nil
+	}
+
 	type refund Refund
 	var rr refund
 	err := json.Unmarshal(data, &rr)
-	if err == nil {
-		*r = Refund(rr)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		r.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*r = Refund(rr)
 	return nil
 }
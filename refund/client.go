@@ -7,9 +7,20 @@ import (
 )
 
 const (
-	RefundFraudulent          stripe.RefundReason = "fraudulent"
-	RefundDuplicate           stripe.RefundReason = "duplicate"
-	RefundRequestedByCustomer stripe.RefundReason = "requested_by_customer"
+	RefundFraudulent              stripe.RefundReason = "fraudulent"
+	RefundDuplicate               stripe.RefundReason = "duplicate"
+	RefundExpiredUncapturedCharge stripe.RefundReason = "expired_uncaptured_charge"
+	RefundRequestedByCustomer     stripe.RefundReason = "requested_by_customer"
+
+	StatusCanceled       stripe.RefundStatus = "canceled"
+	StatusFailed         stripe.RefundStatus = "failed"
+	StatusPending        stripe.RefundStatus = "pending"
+	StatusRequiresAction stripe.RefundStatus = "requires_action"
+	StatusSucceeded      stripe.RefundStatus = "succeeded"
+
+	FailureReasonExpiredOrCanceledCard stripe.RefundFailureReason = "expired_or_canceled_card"
+	FailureReasonLostOrStolenCard      stripe.RefundFailureReason = "lost_or_stolen_card"
+	FailureReasonUnknown               stripe.RefundFailureReason = "unknown"
 )
 
 // Client is used to invoke /refunds APIs.
@@ -72,6 +83,29 @@ func (c Client) Update(id string, params *stripe.RefundParams) (*stripe.Refund,
 	return refund, err
 }
 
+// Cancel cancels a refund that's waiting on an action, like a customer
+// balance refund that requires the customer to provide their bank details.
+// For more details see https://stripe.com/docs/api/refunds/cancel.
+func Cancel(id string, params *stripe.RefundParams) (*stripe.Refund, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.RefundParams) (*stripe.Refund, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	refund := &stripe.Refund{}
+	err := c.B.Call("POST", "/refunds/"+id+"/cancel", c.Key, body, commonParams, refund)
+
+	return refund, err
+}
+
 // List returns a list of refunds.
 // For more details see https://stripe.com/docs/api#list_refunds.
 func List(params *stripe.RefundListParams) *Iter {
@@ -114,5 +148,5 @@ func (i *Iter) Refund() *stripe.Refund {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -6,8 +6,23 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
+func TestRefundCancel(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/refunds/re_123/cancel", `{
+		"id": "re_123",
+		"status": "canceled",
+		"destination_details": {"type": "customer_cash_balance"}
+	}`)
+
+	refund, err := Client{B: b}.Cancel("re_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, StatusCanceled, refund.Status)
+	assert.Equal(t, "customer_cash_balance", refund.DestinationDetails.Type)
+}
+
 func TestRefundGet(t *testing.T) {
 	refund, err := Get("gold", nil)
 	assert.Nil(t, err)
@@ -23,6 +38,22 @@ func TestRefundList(t *testing.T) {
 	assert.NotNil(t, i.Refund())
 }
 
+func TestRefundFailure(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/refunds/re_123", `{
+		"id": "re_123",
+		"status": "failed",
+		"failure_reason": "lost_or_stolen_card",
+		"failure_balance_transaction": "txn_123"
+	}`)
+
+	refund, err := Client{B: b}.Get("re_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, StatusFailed, refund.Status)
+	assert.Equal(t, FailureReasonLostOrStolenCard, refund.FailureReason)
+	assert.Equal(t, "txn_123", refund.FailureTx.ID)
+}
+
 func TestRefundNew(t *testing.T) {
 	refund, err := New(&stripe.RefundParams{
 		Charge: "ch_123",
@@ -31,6 +62,23 @@ func TestRefundNew(t *testing.T) {
 	assert.NotNil(t, refund)
 }
 
+func TestRefundNewInstructionsEmail(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/refunds", `{"id": "re_123"}`)
+
+	refund, err := Client{B: b}.New(&stripe.RefundParams{
+		Charge:            "ch_123",
+		InstructionsEmail: "jenny@example.com",
+		Reason:            RefundRequestedByCustomer,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, refund)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "jenny@example.com", values.Get("instructions_email"))
+	assert.Equal(t, string(RefundRequestedByCustomer), values.Get("reason"))
+}
+
 func TestRefundUpdate(t *testing.T) {
 	refund, err := Update("gold", &stripe.RefundParams{
 		Params: stripe.Params{
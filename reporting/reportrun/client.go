@@ -0,0 +1,137 @@
+// Package reportrun provides the /reporting/report_runs APIs
+package reportrun
+
+import (
+	"context"
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	StatusFailed    stripe.ReportRunStatus = "failed"
+	StatusPending   stripe.ReportRunStatus = "pending"
+	StatusSucceeded stripe.ReportRunStatus = "succeeded"
+)
+
+// terminalStatuses are the statuses in which a report run has been processed.
+var terminalStatuses = []string{string(StatusFailed), string(StatusSucceeded)}
+
+// Client is used to invoke /reporting/report_runs APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new report run. The report is generated asynchronously; wait
+// for the run to complete with Wait.
+// For more details see https://stripe.com/docs/api#create_reporting_report_run.
+func New(params *stripe.ReportRunParams) (*stripe.ReportRun, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.ReportRunParams) (*stripe.ReportRun, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	reportRun := &stripe.ReportRun{}
+	err := c.B.Call("POST", "/reporting/report_runs", c.Key, body, commonParams, reportRun)
+
+	return reportRun, err
+}
+
+// Get returns the details of a report run.
+// For more details see https://stripe.com/docs/api#retrieve_reporting_report_run.
+func Get(id string, params *stripe.ReportRunParams) (*stripe.ReportRun, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.ReportRunParams) (*stripe.ReportRun, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	reportRun := &stripe.ReportRun{}
+	err := c.B.Call("GET", fmt.Sprintf("/reporting/report_runs/%v", id), c.Key, body, commonParams, reportRun)
+
+	return reportRun, err
+}
+
+// Wait polls a report run until it's not pending anymore and returns it. A nil policy
+// means stripe.DefaultPollPolicy. See stripe.Poll for details.
+func Wait(ctx context.Context, id string, params *stripe.ReportRunParams, policy *stripe.PollPolicy) (*stripe.ReportRun, error) {
+	return getC().Wait(ctx, id, params, policy)
+}
+
+func (c Client) Wait(ctx context.Context, id string, params *stripe.ReportRunParams, policy *stripe.PollPolicy) (*stripe.ReportRun, error) {
+	obj, err := stripe.Poll(ctx, func() (interface{}, string, error) {
+		run, err := c.Get(id, params)
+		if err != nil {
+			return nil, "", err
+		}
+		return run, string(run.Status), nil
+	}, terminalStatuses, policy)
+
+	run, _ := obj.(*stripe.ReportRun)
+	return run, err
+}
+
+// List returns a list of report runs.
+// For more details see https://stripe.com/docs/api#list_reporting_report_runs.
+func List(params *stripe.ReportRunListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.ReportRunListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.ReportRunList{}
+		err := c.B.Call("GET", "/reporting/report_runs", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of ReportRuns.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// ReportRun returns the most recent ReportRun
+// visited by a call to Next.
+func (i *Iter) ReportRun() *stripe.ReportRun {
+	return i.Current().(*stripe.ReportRun)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
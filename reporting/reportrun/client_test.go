@@ -0,0 +1,50 @@
+package reportrun
+
+import (
+	"context"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestReportRunGet(t *testing.T) {
+	reportRun, err := Get("frr_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, reportRun)
+}
+
+func TestReportRunList(t *testing.T) {
+	i := List(&stripe.ReportRunListParams{})
+
+	// Verify that we can get at least one report run
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.ReportRun())
+}
+
+func TestReportRunNew(t *testing.T) {
+	reportRun, err := New(&stripe.ReportRunParams{
+		Parameters: &stripe.ReportRunParametersParams{
+			Columns:       []string{"category", "net"},
+			IntervalEnd:   1525132800,
+			IntervalStart: 1522540800,
+		},
+		ReportType: "balance.summary.1",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, reportRun)
+}
+
+func TestReportRunWait(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/reporting/report_runs/frr_123", `{"id": "frr_123", "status": "succeeded"}`)
+
+	c := Client{B: b}
+	reportRun, err := c.Wait(context.Background(), "frr_123", nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, StatusSucceeded, reportRun.Status)
+	assert.Equal(t, 1, len(b.Calls()))
+}
@@ -0,0 +1,85 @@
+// Package reporttype provides the /reporting/report_types APIs
+package reporttype
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /reporting/report_types APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of a report type.
+// For more details see https://stripe.com/docs/api#retrieve_reporting_report_type.
+func Get(id string, params *stripe.ReportTypeParams) (*stripe.ReportType, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.ReportTypeParams) (*stripe.ReportType, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	reportType := &stripe.ReportType{}
+	err := c.B.Call("GET", fmt.Sprintf("/reporting/report_types/%v", id), c.Key, body, commonParams, reportType)
+
+	return reportType, err
+}
+
+// List returns a list of report types.
+// For more details see https://stripe.com/docs/api#list_reporting_report_types.
+func List(params *stripe.ReportTypeListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.ReportTypeListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.ReportTypeList{}
+		err := c.B.Call("GET", "/reporting/report_types", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of ReportTypes.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// ReportType returns the most recent ReportType
+// visited by a call to Next.
+func (i *Iter) ReportType() *stripe.ReportType {
+	return i.Current().(*stripe.ReportType)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,24 @@
+package reporttype
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestReportTypeGet(t *testing.T) {
+	reportType, err := Get("balance.summary.1", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, reportType)
+}
+
+func TestReportTypeList(t *testing.T) {
+	i := List(&stripe.ReportTypeListParams{})
+
+	// Verify that we can get at least one report type
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.ReportType())
+}
@@ -0,0 +1,93 @@
+package stripe
+
+import "encoding/json"
+
+// ReportRunStatus is the list of allowed values for the status of a report
+// run. Allowed values are "failed", "pending", and "succeeded".
+type ReportRunStatus string
+
+// ReportRunParametersParams is the set of parameters that a report is run
+// with. Which of them are needed depends on the report type.
+type ReportRunParametersParams struct {
+	Columns           []string `form:"columns"`
+	ConnectedAccount  string   `form:"connected_account"`
+	Currency          Currency `form:"currency"`
+	IntervalEnd       int64    `form:"interval_end"`
+	IntervalStart     int64    `form:"interval_start"`
+	Payout            string   `form:"payout"`
+	ReportingCategory string   `form:"reporting_category"`
+	Timezone          string   `form:"timezone"`
+}
+
+// ReportRunParams is the set of parameters that can be used when creating or
+// retrieving a report run.
+// For more details see https://stripe.com/docs/api#create_reporting_report_run.
+type ReportRunParams struct {
+	Params     `form:"*"`
+	Parameters *ReportRunParametersParams `form:"parameters"`
+	ReportType string                     `form:"report_type"`
+}
+
+// ReportRunListParams is the set of parameters that can be used when listing
+// report runs.
+// For more details see https://stripe.com/docs/api#list_reporting_report_runs.
+type ReportRunListParams struct {
+	ListParams   `form:"*"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+}
+
+// ReportRunParameters are the parameters that a report was run with.
+type ReportRunParameters struct {
+	Columns           []string  `json:"columns"`
+	ConnectedAccount  string    `json:"connected_account"`
+	Currency          Currency  `json:"currency"`
+	IntervalEnd       Timestamp `json:"interval_end"`
+	IntervalStart     Timestamp `json:"interval_start"`
+	Payout            string    `json:"payout"`
+	ReportingCategory string    `json:"reporting_category"`
+	Timezone          string    `json:"timezone"`
+}
+
+// ReportRun is the resource representing a Stripe report run. Once the run
+// has succeeded, Result holds the file with the report's contents.
+// For more details see https://stripe.com/docs/api#reporting_report_runs.
+type ReportRun struct {
+	APIResource
+	Created     Timestamp            `json:"created"`
+	Error       string               `json:"error"`
+	ID          string               `json:"id"`
+	Live        bool                 `json:"livemode"`
+	Parameters  *ReportRunParameters `json:"parameters"`
+	ReportType  string               `json:"report_type"`
+	Result      *FileUpload          `json:"result"`
+	Status      ReportRunStatus      `json:"status"`
+	SucceededAt Timestamp            `json:"succeeded_at"`
+}
+
+// ReportRunList is a list of report runs as retrieved from a list endpoint.
+type ReportRunList struct {
+	APIResource
+	ListMeta
+	Values []*ReportRun `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a ReportRun.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (r *ReportRun) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		r.ID = id
+		return nil
+	}
+
+	type reportRun ReportRun
+	var rr reportRun
+	err := json.Unmarshal(data, &rr)
+	if err != nil {
+		return err
+	}
+
+	*r = ReportRun(rr)
+	return nil
+}
@@ -0,0 +1,37 @@
+package stripe
+
+// ReportTypeParams is the set of parameters that can be used when retrieving
+// a report type.
+// For more details see https://stripe.com/docs/api#retrieve_reporting_report_type.
+type ReportTypeParams struct {
+	Params `form:"*"`
+}
+
+// ReportTypeListParams is the set of parameters that can be used when
+// listing report types.
+// For more details see https://stripe.com/docs/api#list_reporting_report_types.
+type ReportTypeListParams struct {
+	ListParams `form:"*"`
+}
+
+// ReportType is the resource representing a Stripe report type. Reports of a
+// type can only cover the range between DataAvailableStart and
+// DataAvailableEnd.
+// For more details see https://stripe.com/docs/api#reporting_report_types.
+type ReportType struct {
+	APIResource
+	DataAvailableEnd   Timestamp `json:"data_available_end"`
+	DataAvailableStart Timestamp `json:"data_available_start"`
+	DefaultColumns     []string  `json:"default_columns"`
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	Updated            Timestamp `json:"updated"`
+	Version            int64     `json:"version"`
+}
+
+// ReportTypeList is a list of report types as retrieved from a list endpoint.
+type ReportTypeList struct {
+	APIResource
+	ListMeta
+	Values []*ReportType `json:"data"`
+}
@@ -0,0 +1,791 @@
+package stripe
+
+// Resource is the interface implemented by every object returned by the
+// API, so that code which stores or logs objects can handle any of them the
+// same way.
+type Resource interface {
+	// GetCreated returns the time at which the object was created, or 0 if
+	// the object doesn't carry a creation time.
+	GetCreated() Timestamp
+
+	// GetID returns the ID of the object.
+	GetID() string
+
+	// GetObject returns the type of the object, like "charge". It's the
+	// value of the object's "object" attribute in the API.
+	GetObject() string
+}
+
+// GetCreated is the Resource.GetCreated implementation for Account.
+func (a *Account) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for Account.
+func (a *Account) GetID() string { return a.ID }
+
+// GetObject is the Resource.GetObject implementation for Account.
+func (a *Account) GetObject() string { return "account" }
+
+// GetCreated is the Resource.GetCreated implementation for ApplePayDomain.
+func (a *ApplePayDomain) GetCreated() Timestamp { return a.Created }
+
+// GetID is the Resource.GetID implementation for ApplePayDomain.
+func (a *ApplePayDomain) GetID() string { return a.ID }
+
+// GetObject is the Resource.GetObject implementation for ApplePayDomain.
+func (a *ApplePayDomain) GetObject() string { return "apple_pay_domain" }
+
+// GetCreated is the Resource.GetCreated implementation for Application.
+func (a *Application) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for Application.
+func (a *Application) GetID() string { return a.ID }
+
+// GetObject is the Resource.GetObject implementation for Application.
+func (a *Application) GetObject() string { return "application" }
+
+// GetCreated is the Resource.GetCreated implementation for BankAccount.
+func (b *BankAccount) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for BankAccount.
+func (b *BankAccount) GetID() string { return b.ID }
+
+// GetObject is the Resource.GetObject implementation for BankAccount.
+func (b *BankAccount) GetObject() string { return "bank_account" }
+
+// GetCreated is the Resource.GetCreated implementation for BillingPortalSession.
+func (s *BillingPortalSession) GetCreated() Timestamp { return s.Created }
+
+// GetID is the Resource.GetID implementation for BillingPortalSession.
+func (s *BillingPortalSession) GetID() string { return s.ID }
+
+// GetObject is the Resource.GetObject implementation for BillingPortalSession.
+func (s *BillingPortalSession) GetObject() string { return "billing_portal.session" }
+
+// GetCreated is the Resource.GetCreated implementation for BitcoinReceiver.
+func (b *BitcoinReceiver) GetCreated() Timestamp { return b.Created }
+
+// GetID is the Resource.GetID implementation for BitcoinReceiver.
+func (b *BitcoinReceiver) GetID() string { return b.ID }
+
+// GetObject is the Resource.GetObject implementation for BitcoinReceiver.
+func (b *BitcoinReceiver) GetObject() string { return "bitcoin_receiver" }
+
+// GetCreated is the Resource.GetCreated implementation for BitcoinTransaction.
+func (b *BitcoinTransaction) GetCreated() Timestamp { return b.Created }
+
+// GetID is the Resource.GetID implementation for BitcoinTransaction.
+func (b *BitcoinTransaction) GetID() string { return b.ID }
+
+// GetObject is the Resource.GetObject implementation for BitcoinTransaction.
+func (b *BitcoinTransaction) GetObject() string { return "bitcoin_transaction" }
+
+// GetCreated is the Resource.GetCreated implementation for Capability.
+func (c *Capability) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for Capability.
+func (c *Capability) GetID() string { return c.ID }
+
+// GetObject is the Resource.GetObject implementation for Capability.
+func (c *Capability) GetObject() string { return "capability" }
+
+// GetCreated is the Resource.GetCreated implementation for Card.
+func (c *Card) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for Card.
+func (c *Card) GetID() string { return c.ID }
+
+// GetObject is the Resource.GetObject implementation for Card.
+func (c *Card) GetObject() string { return "card" }
+
+// GetCreated is the Resource.GetCreated implementation for Charge.
+func (c *Charge) GetCreated() Timestamp { return c.Created }
+
+// GetID is the Resource.GetID implementation for Charge.
+func (c *Charge) GetID() string { return c.ID }
+
+// GetObject is the Resource.GetObject implementation for Charge.
+func (c *Charge) GetObject() string { return "charge" }
+
+// GetCreated is the Resource.GetCreated implementation for ClimateOrder.
+func (o *ClimateOrder) GetCreated() Timestamp { return o.Created }
+
+// GetID is the Resource.GetID implementation for ClimateOrder.
+func (o *ClimateOrder) GetID() string { return o.ID }
+
+// GetObject is the Resource.GetObject implementation for ClimateOrder.
+func (o *ClimateOrder) GetObject() string { return "climate.order" }
+
+// GetCreated is the Resource.GetCreated implementation for ClimateProduct.
+func (p *ClimateProduct) GetCreated() Timestamp { return p.Created }
+
+// GetID is the Resource.GetID implementation for ClimateProduct.
+func (p *ClimateProduct) GetID() string { return p.ID }
+
+// GetObject is the Resource.GetObject implementation for ClimateProduct.
+func (p *ClimateProduct) GetObject() string { return "climate.product" }
+
+// GetCreated is the Resource.GetCreated implementation for ClimateSupplier.
+func (s *ClimateSupplier) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for ClimateSupplier.
+func (s *ClimateSupplier) GetID() string { return s.ID }
+
+// GetObject is the Resource.GetObject implementation for ClimateSupplier.
+func (s *ClimateSupplier) GetObject() string { return "climate.supplier" }
+
+// GetCreated is the Resource.GetCreated implementation for CountrySpec.
+func (c *CountrySpec) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for CountrySpec.
+func (c *CountrySpec) GetID() string { return c.ID }
+
+// GetObject is the Resource.GetObject implementation for CountrySpec.
+func (c *CountrySpec) GetObject() string { return "country_spec" }
+
+// GetCreated is the Resource.GetCreated implementation for Coupon.
+func (c *Coupon) GetCreated() Timestamp { return c.Created }
+
+// GetID is the Resource.GetID implementation for Coupon.
+func (c *Coupon) GetID() string { return c.ID }
+
+// GetObject is the Resource.GetObject implementation for Coupon.
+func (c *Coupon) GetObject() string { return "coupon" }
+
+// GetCreated is the Resource.GetCreated implementation for CreditNote.
+func (c *CreditNote) GetCreated() Timestamp { return c.Created }
+
+// GetID is the Resource.GetID implementation for CreditNote.
+func (c *CreditNote) GetID() string { return c.ID }
+
+// GetObject is the Resource.GetObject implementation for CreditNote.
+func (c *CreditNote) GetObject() string { return "credit_note" }
+
+// GetCreated is the Resource.GetCreated implementation for CreditNoteLineItem.
+func (c *CreditNoteLineItem) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for CreditNoteLineItem.
+func (c *CreditNoteLineItem) GetID() string { return c.ID }
+
+// GetObject is the Resource.GetObject implementation for CreditNoteLineItem.
+func (c *CreditNoteLineItem) GetObject() string { return "credit_note_line_item" }
+
+// GetCreated is the Resource.GetCreated implementation for Customer.
+func (c *Customer) GetCreated() Timestamp { return c.Created }
+
+// GetID is the Resource.GetID implementation for Customer.
+func (c *Customer) GetID() string { return c.ID }
+
+// GetObject is the Resource.GetObject implementation for Customer.
+func (c *Customer) GetObject() string { return "customer" }
+
+// GetCreated is the Resource.GetCreated implementation for CustomerBalanceTransaction.
+func (c *CustomerBalanceTransaction) GetCreated() Timestamp { return c.Created }
+
+// GetID is the Resource.GetID implementation for CustomerBalanceTransaction.
+func (c *CustomerBalanceTransaction) GetID() string { return c.ID }
+
+// GetObject is the Resource.GetObject implementation for CustomerBalanceTransaction.
+func (c *CustomerBalanceTransaction) GetObject() string { return "customer_balance_transaction" }
+
+// GetCreated is the Resource.GetCreated implementation for Discount.
+func (d *Discount) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for Discount.
+func (d *Discount) GetID() string { return d.ID }
+
+// GetObject is the Resource.GetObject implementation for Discount.
+func (d *Discount) GetObject() string { return "discount" }
+
+// GetCreated is the Resource.GetCreated implementation for Dispute.
+func (d *Dispute) GetCreated() Timestamp { return d.Created }
+
+// GetID is the Resource.GetID implementation for Dispute.
+func (d *Dispute) GetID() string { return d.ID }
+
+// GetObject is the Resource.GetObject implementation for Dispute.
+func (d *Dispute) GetObject() string { return "dispute" }
+
+// GetCreated is the Resource.GetCreated implementation for EntitlementFeature.
+func (f *EntitlementFeature) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for EntitlementFeature.
+func (f *EntitlementFeature) GetID() string { return f.ID }
+
+// GetObject is the Resource.GetObject implementation for EntitlementFeature.
+func (f *EntitlementFeature) GetObject() string { return "entitlements.feature" }
+
+// GetCreated is the Resource.GetCreated implementation for EphemeralKey.
+func (e *EphemeralKey) GetCreated() Timestamp { return e.Created }
+
+// GetID is the Resource.GetID implementation for EphemeralKey.
+func (e *EphemeralKey) GetID() string { return e.ID }
+
+// GetObject is the Resource.GetObject implementation for EphemeralKey.
+func (e *EphemeralKey) GetObject() string { return "ephemeral_key" }
+
+// GetCreated is the Resource.GetCreated implementation for Event.
+func (e *Event) GetCreated() Timestamp { return e.Created }
+
+// GetID is the Resource.GetID implementation for Event.
+func (e *Event) GetID() string { return e.ID }
+
+// GetObject is the Resource.GetObject implementation for Event.
+func (e *Event) GetObject() string { return "event" }
+
+// GetCreated is the Resource.GetCreated implementation for ExchangeRate.
+func (e *ExchangeRate) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for ExchangeRate.
+func (e *ExchangeRate) GetID() string { return e.ID }
+
+// GetObject is the Resource.GetObject implementation for ExchangeRate.
+func (e *ExchangeRate) GetObject() string { return "exchange_rate" }
+
+// GetCreated is the Resource.GetCreated implementation for Fee.
+func (f *Fee) GetCreated() Timestamp { return f.Created }
+
+// GetID is the Resource.GetID implementation for Fee.
+func (f *Fee) GetID() string { return f.ID }
+
+// GetObject is the Resource.GetObject implementation for Fee.
+func (f *Fee) GetObject() string { return "application_fee" }
+
+// GetCreated is the Resource.GetCreated implementation for FeeRefund.
+func (f *FeeRefund) GetCreated() Timestamp { return f.Created }
+
+// GetID is the Resource.GetID implementation for FeeRefund.
+func (f *FeeRefund) GetID() string { return f.ID }
+
+// GetObject is the Resource.GetObject implementation for FeeRefund.
+func (f *FeeRefund) GetObject() string { return "fee_refund" }
+
+// GetCreated is the Resource.GetCreated implementation for File.
+func (f *File) GetCreated() Timestamp { return f.Created }
+
+// GetID is the Resource.GetID implementation for File.
+func (f *File) GetID() string { return f.ID }
+
+// GetObject is the Resource.GetObject implementation for File.
+func (f *File) GetObject() string { return "file" }
+
+// GetCreated is the Resource.GetCreated implementation for FileLink.
+func (l *FileLink) GetCreated() Timestamp { return l.Created }
+
+// GetID is the Resource.GetID implementation for FileLink.
+func (l *FileLink) GetID() string { return l.ID }
+
+// GetObject is the Resource.GetObject implementation for FileLink.
+func (l *FileLink) GetObject() string { return "file_link" }
+
+// GetCreated is the Resource.GetCreated implementation for FileUpload.
+func (f *FileUpload) GetCreated() Timestamp { return f.Created }
+
+// GetID is the Resource.GetID implementation for FileUpload.
+func (f *FileUpload) GetID() string { return f.ID }
+
+// GetObject is the Resource.GetObject implementation for FileUpload.
+func (f *FileUpload) GetObject() string { return "file_upload" }
+
+// GetCreated is the Resource.GetCreated implementation for IdentityVerificationReport.
+func (i *IdentityVerificationReport) GetCreated() Timestamp { return i.Created }
+
+// GetID is the Resource.GetID implementation for IdentityVerificationReport.
+func (i *IdentityVerificationReport) GetID() string { return i.ID }
+
+// GetObject is the Resource.GetObject implementation for IdentityVerificationReport.
+func (i *IdentityVerificationReport) GetObject() string { return "identity.verification_report" }
+
+// GetCreated is the Resource.GetCreated implementation for IdentityVerificationSession.
+func (i *IdentityVerificationSession) GetCreated() Timestamp { return i.Created }
+
+// GetID is the Resource.GetID implementation for IdentityVerificationSession.
+func (i *IdentityVerificationSession) GetID() string { return i.ID }
+
+// GetObject is the Resource.GetObject implementation for IdentityVerificationSession.
+func (i *IdentityVerificationSession) GetObject() string { return "identity.verification_session" }
+
+// GetCreated is the Resource.GetCreated implementation for Invoice.
+func (i *Invoice) GetCreated() Timestamp { return i.Date }
+
+// GetID is the Resource.GetID implementation for Invoice.
+func (i *Invoice) GetID() string { return i.ID }
+
+// GetObject is the Resource.GetObject implementation for Invoice.
+func (i *Invoice) GetObject() string { return "invoice" }
+
+// GetCreated is the Resource.GetCreated implementation for InvoiceItem.
+func (i *InvoiceItem) GetCreated() Timestamp { return i.Date }
+
+// GetID is the Resource.GetID implementation for InvoiceItem.
+func (i *InvoiceItem) GetID() string { return i.ID }
+
+// GetObject is the Resource.GetObject implementation for InvoiceItem.
+func (i *InvoiceItem) GetObject() string { return "invoiceitem" }
+
+// GetCreated is the Resource.GetCreated implementation for InvoiceLine.
+func (i *InvoiceLine) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for InvoiceLine.
+func (i *InvoiceLine) GetID() string { return i.ID }
+
+// GetObject is the Resource.GetObject implementation for InvoiceLine.
+func (i *InvoiceLine) GetObject() string { return "line_item" }
+
+// GetCreated is the Resource.GetCreated implementation for IssuingAuthorization.
+func (i *IssuingAuthorization) GetCreated() Timestamp { return i.Created }
+
+// GetID is the Resource.GetID implementation for IssuingAuthorization.
+func (i *IssuingAuthorization) GetID() string { return i.ID }
+
+// GetObject is the Resource.GetObject implementation for IssuingAuthorization.
+func (i *IssuingAuthorization) GetObject() string { return "issuing.authorization" }
+
+// GetCreated is the Resource.GetCreated implementation for IssuingCard.
+func (i *IssuingCard) GetCreated() Timestamp { return i.Created }
+
+// GetID is the Resource.GetID implementation for IssuingCard.
+func (i *IssuingCard) GetID() string { return i.ID }
+
+// GetObject is the Resource.GetObject implementation for IssuingCard.
+func (i *IssuingCard) GetObject() string { return "issuing.card" }
+
+// GetCreated is the Resource.GetCreated implementation for IssuingCardholder.
+func (i *IssuingCardholder) GetCreated() Timestamp { return i.Created }
+
+// GetID is the Resource.GetID implementation for IssuingCardholder.
+func (i *IssuingCardholder) GetID() string { return i.ID }
+
+// GetObject is the Resource.GetObject implementation for IssuingCardholder.
+func (i *IssuingCardholder) GetObject() string { return "issuing.cardholder" }
+
+// GetCreated is the Resource.GetCreated implementation for IssuingDispute.
+func (i *IssuingDispute) GetCreated() Timestamp { return i.Created }
+
+// GetID is the Resource.GetID implementation for IssuingDispute.
+func (i *IssuingDispute) GetID() string { return i.ID }
+
+// GetObject is the Resource.GetObject implementation for IssuingDispute.
+func (i *IssuingDispute) GetObject() string { return "issuing.dispute" }
+
+// GetCreated is the Resource.GetCreated implementation for IssuingTransaction.
+func (i *IssuingTransaction) GetCreated() Timestamp { return i.Created }
+
+// GetID is the Resource.GetID implementation for IssuingTransaction.
+func (i *IssuingTransaction) GetID() string { return i.ID }
+
+// GetObject is the Resource.GetObject implementation for IssuingTransaction.
+func (i *IssuingTransaction) GetObject() string { return "issuing.transaction" }
+
+// GetCreated is the Resource.GetCreated implementation for Mandate.
+func (m *Mandate) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for Mandate.
+func (m *Mandate) GetID() string { return m.ID }
+
+// GetObject is the Resource.GetObject implementation for Mandate.
+func (m *Mandate) GetObject() string { return "mandate" }
+
+// GetCreated is the Resource.GetCreated implementation for Order.
+func (o *Order) GetCreated() Timestamp { return o.Created }
+
+// GetID is the Resource.GetID implementation for Order.
+func (o *Order) GetID() string { return o.ID }
+
+// GetObject is the Resource.GetObject implementation for Order.
+func (o *Order) GetObject() string { return "order" }
+
+// GetCreated is the Resource.GetCreated implementation for OrderReturn.
+func (o *OrderReturn) GetCreated() Timestamp { return o.Created }
+
+// GetID is the Resource.GetID implementation for OrderReturn.
+func (o *OrderReturn) GetID() string { return o.ID }
+
+// GetObject is the Resource.GetObject implementation for OrderReturn.
+func (o *OrderReturn) GetObject() string { return "order_return" }
+
+// GetCreated is the Resource.GetCreated implementation for PaymentIntent.
+func (p *PaymentIntent) GetCreated() Timestamp { return p.Created }
+
+// GetID is the Resource.GetID implementation for PaymentIntent.
+func (p *PaymentIntent) GetID() string { return p.ID }
+
+// GetObject is the Resource.GetObject implementation for PaymentIntent.
+func (p *PaymentIntent) GetObject() string { return "payment_intent" }
+
+// GetCreated is the Resource.GetCreated implementation for PaymentLink.
+func (p *PaymentLink) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for PaymentLink.
+func (p *PaymentLink) GetID() string { return p.ID }
+
+// GetObject is the Resource.GetObject implementation for PaymentLink.
+func (p *PaymentLink) GetObject() string { return "payment_link" }
+
+// GetCreated is the Resource.GetCreated implementation for Payout.
+func (p *Payout) GetCreated() Timestamp { return p.Created }
+
+// GetID is the Resource.GetID implementation for Payout.
+func (p *Payout) GetID() string { return p.ID }
+
+// GetObject is the Resource.GetObject implementation for Payout.
+func (p *Payout) GetObject() string { return "payout" }
+
+// GetCreated is the Resource.GetCreated implementation for Person.
+func (p *Person) GetCreated() Timestamp { return p.Created }
+
+// GetID is the Resource.GetID implementation for Person.
+func (p *Person) GetID() string { return p.ID }
+
+// GetObject is the Resource.GetObject implementation for Person.
+func (p *Person) GetObject() string { return "person" }
+
+// GetCreated is the Resource.GetCreated implementation for Plan.
+func (p *Plan) GetCreated() Timestamp { return p.Created }
+
+// GetID is the Resource.GetID implementation for Plan.
+func (p *Plan) GetID() string { return p.ID }
+
+// GetObject is the Resource.GetObject implementation for Plan.
+func (p *Plan) GetObject() string { return "plan" }
+
+// GetCreated is the Resource.GetCreated implementation for Price.
+func (p *Price) GetCreated() Timestamp { return p.Created }
+
+// GetID is the Resource.GetID implementation for Price.
+func (p *Price) GetID() string { return p.ID }
+
+// GetObject is the Resource.GetObject implementation for Price.
+func (p *Price) GetObject() string { return "price" }
+
+// GetCreated is the Resource.GetCreated implementation for Product.
+func (p *Product) GetCreated() Timestamp { return p.Created }
+
+// GetID is the Resource.GetID implementation for Product.
+func (p *Product) GetID() string { return p.ID }
+
+// GetObject is the Resource.GetObject implementation for Product.
+func (p *Product) GetObject() string { return "product" }
+
+// GetCreated is the Resource.GetCreated implementation for ProductFeature.
+func (f *ProductFeature) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for ProductFeature.
+func (f *ProductFeature) GetID() string { return f.ID }
+
+// GetObject is the Resource.GetObject implementation for ProductFeature.
+func (f *ProductFeature) GetObject() string { return "product_feature" }
+
+// GetCreated is the Resource.GetCreated implementation for PromotionCode.
+func (p *PromotionCode) GetCreated() Timestamp { return p.Created }
+
+// GetID is the Resource.GetID implementation for PromotionCode.
+func (p *PromotionCode) GetID() string { return p.ID }
+
+// GetObject is the Resource.GetObject implementation for PromotionCode.
+func (p *PromotionCode) GetObject() string { return "promotion_code" }
+
+// GetCreated is the Resource.GetCreated implementation for Quote.
+func (q *Quote) GetCreated() Timestamp { return q.Created }
+
+// GetID is the Resource.GetID implementation for Quote.
+func (q *Quote) GetID() string { return q.ID }
+
+// GetObject is the Resource.GetObject implementation for Quote.
+func (q *Quote) GetObject() string { return "quote" }
+
+// GetCreated is the Resource.GetCreated implementation for QuoteLineItem.
+func (q *QuoteLineItem) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for QuoteLineItem.
+func (q *QuoteLineItem) GetID() string { return q.ID }
+
+// GetObject is the Resource.GetObject implementation for QuoteLineItem.
+func (q *QuoteLineItem) GetObject() string { return "item" }
+
+// GetCreated is the Resource.GetCreated implementation for RadarEarlyFraudWarning.
+func (r *RadarEarlyFraudWarning) GetCreated() Timestamp { return r.Created }
+
+// GetID is the Resource.GetID implementation for RadarEarlyFraudWarning.
+func (r *RadarEarlyFraudWarning) GetID() string { return r.ID }
+
+// GetObject is the Resource.GetObject implementation for RadarEarlyFraudWarning.
+func (r *RadarEarlyFraudWarning) GetObject() string { return "radar.early_fraud_warning" }
+
+// GetCreated is the Resource.GetCreated implementation for RadarValueList.
+func (r *RadarValueList) GetCreated() Timestamp { return r.Created }
+
+// GetID is the Resource.GetID implementation for RadarValueList.
+func (r *RadarValueList) GetID() string { return r.ID }
+
+// GetObject is the Resource.GetObject implementation for RadarValueList.
+func (r *RadarValueList) GetObject() string { return "radar.value_list" }
+
+// GetCreated is the Resource.GetCreated implementation for RadarValueListItem.
+func (r *RadarValueListItem) GetCreated() Timestamp { return r.Created }
+
+// GetID is the Resource.GetID implementation for RadarValueListItem.
+func (r *RadarValueListItem) GetID() string { return r.ID }
+
+// GetObject is the Resource.GetObject implementation for RadarValueListItem.
+func (r *RadarValueListItem) GetObject() string { return "radar.value_list_item" }
+
+// GetCreated is the Resource.GetCreated implementation for Recipient.
+func (r *Recipient) GetCreated() Timestamp { return r.Created }
+
+// GetID is the Resource.GetID implementation for Recipient.
+func (r *Recipient) GetID() string { return r.ID }
+
+// GetObject is the Resource.GetObject implementation for Recipient.
+func (r *Recipient) GetObject() string { return "recipient" }
+
+// GetCreated is the Resource.GetCreated implementation for RecipientTransfer.
+func (r *RecipientTransfer) GetCreated() Timestamp { return r.Created }
+
+// GetID is the Resource.GetID implementation for RecipientTransfer.
+func (r *RecipientTransfer) GetID() string { return r.ID }
+
+// GetObject is the Resource.GetObject implementation for RecipientTransfer.
+func (r *RecipientTransfer) GetObject() string { return "transfer" }
+
+// GetCreated is the Resource.GetCreated implementation for Refund.
+func (r *Refund) GetCreated() Timestamp { return r.Created }
+
+// GetID is the Resource.GetID implementation for Refund.
+func (r *Refund) GetID() string { return r.ID }
+
+// GetObject is the Resource.GetObject implementation for Refund.
+func (r *Refund) GetObject() string { return "refund" }
+
+// GetCreated is the Resource.GetCreated implementation for ReportRun.
+func (r *ReportRun) GetCreated() Timestamp { return r.Created }
+
+// GetID is the Resource.GetID implementation for ReportRun.
+func (r *ReportRun) GetID() string { return r.ID }
+
+// GetObject is the Resource.GetObject implementation for ReportRun.
+func (r *ReportRun) GetObject() string { return "reporting.report_run" }
+
+// GetCreated is the Resource.GetCreated implementation for ReportType.
+func (r *ReportType) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for ReportType.
+func (r *ReportType) GetID() string { return r.ID }
+
+// GetObject is the Resource.GetObject implementation for ReportType.
+func (r *ReportType) GetObject() string { return "reporting.report_type" }
+
+// GetCreated is the Resource.GetCreated implementation for Reversal.
+func (r *Reversal) GetCreated() Timestamp { return r.Created }
+
+// GetID is the Resource.GetID implementation for Reversal.
+func (r *Reversal) GetID() string { return r.ID }
+
+// GetObject is the Resource.GetObject implementation for Reversal.
+func (r *Reversal) GetObject() string { return "transfer_reversal" }
+
+// GetCreated is the Resource.GetCreated implementation for Review.
+func (r *Review) GetCreated() Timestamp { return r.Created }
+
+// GetID is the Resource.GetID implementation for Review.
+func (r *Review) GetID() string { return r.ID }
+
+// GetObject is the Resource.GetObject implementation for Review.
+func (r *Review) GetObject() string { return "review" }
+
+// GetCreated is the Resource.GetCreated implementation for ShippingRate.
+func (s *ShippingRate) GetCreated() Timestamp { return s.Created }
+
+// GetID is the Resource.GetID implementation for ShippingRate.
+func (s *ShippingRate) GetID() string { return s.ID }
+
+// GetObject is the Resource.GetObject implementation for ShippingRate.
+func (s *ShippingRate) GetObject() string { return "shipping_rate" }
+
+// GetCreated is the Resource.GetCreated implementation for SKU.
+func (s *SKU) GetCreated() Timestamp { return s.Created }
+
+// GetID is the Resource.GetID implementation for SKU.
+func (s *SKU) GetID() string { return s.ID }
+
+// GetObject is the Resource.GetObject implementation for SKU.
+func (s *SKU) GetObject() string { return "sku" }
+
+// GetCreated is the Resource.GetCreated implementation for Source.
+func (s *Source) GetCreated() Timestamp { return s.Created }
+
+// GetID is the Resource.GetID implementation for Source.
+func (s *Source) GetID() string { return s.ID }
+
+// GetObject is the Resource.GetObject implementation for Source.
+func (s *Source) GetObject() string { return "source" }
+
+// GetCreated is the Resource.GetCreated implementation for Sub.
+func (s *Sub) GetCreated() Timestamp { return s.Created }
+
+// GetID is the Resource.GetID implementation for Sub.
+func (s *Sub) GetID() string { return s.ID }
+
+// GetObject is the Resource.GetObject implementation for Sub.
+func (s *Sub) GetObject() string { return "subscription" }
+
+// GetCreated is the Resource.GetCreated implementation for SubItem.
+func (s *SubItem) GetCreated() Timestamp { return s.Created }
+
+// GetID is the Resource.GetID implementation for SubItem.
+func (s *SubItem) GetID() string { return s.ID }
+
+// GetObject is the Resource.GetObject implementation for SubItem.
+func (s *SubItem) GetObject() string { return "subscription_item" }
+
+// GetCreated is the Resource.GetCreated implementation for SubscriptionSchedule.
+func (s *SubscriptionSchedule) GetCreated() Timestamp { return s.Created }
+
+// GetID is the Resource.GetID implementation for SubscriptionSchedule.
+func (s *SubscriptionSchedule) GetID() string { return s.ID }
+
+// GetObject is the Resource.GetObject implementation for SubscriptionSchedule.
+func (s *SubscriptionSchedule) GetObject() string { return "subscription_schedule" }
+
+// GetCreated is the Resource.GetCreated implementation for TaxCalculation.
+func (t *TaxCalculation) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for TaxCalculation.
+func (t *TaxCalculation) GetID() string { return t.ID }
+
+// GetObject is the Resource.GetObject implementation for TaxCalculation.
+func (t *TaxCalculation) GetObject() string { return "tax.calculation" }
+
+// GetCreated is the Resource.GetCreated implementation for TaxID.
+func (t *TaxID) GetCreated() Timestamp { return t.Created }
+
+// GetID is the Resource.GetID implementation for TaxID.
+func (t *TaxID) GetID() string { return t.ID }
+
+// GetObject is the Resource.GetObject implementation for TaxID.
+func (t *TaxID) GetObject() string { return "tax_id" }
+
+// GetCreated is the Resource.GetCreated implementation for TaxRate.
+func (t *TaxRate) GetCreated() Timestamp { return t.Created }
+
+// GetID is the Resource.GetID implementation for TaxRate.
+func (t *TaxRate) GetID() string { return t.ID }
+
+// GetObject is the Resource.GetObject implementation for TaxRate.
+func (t *TaxRate) GetObject() string { return "tax_rate" }
+
+// GetCreated is the Resource.GetCreated implementation for TaxRegistration.
+func (t *TaxRegistration) GetCreated() Timestamp { return t.Created }
+
+// GetID is the Resource.GetID implementation for TaxRegistration.
+func (t *TaxRegistration) GetID() string { return t.ID }
+
+// GetObject is the Resource.GetObject implementation for TaxRegistration.
+func (t *TaxRegistration) GetObject() string { return "tax.registration" }
+
+// GetCreated is the Resource.GetCreated implementation for TaxTransaction.
+func (t *TaxTransaction) GetCreated() Timestamp { return t.Created }
+
+// GetID is the Resource.GetID implementation for TaxTransaction.
+func (t *TaxTransaction) GetID() string { return t.ID }
+
+// GetObject is the Resource.GetObject implementation for TaxTransaction.
+func (t *TaxTransaction) GetObject() string { return "tax.transaction" }
+
+// GetCreated is the Resource.GetCreated implementation for TerminalLocation.
+func (l *TerminalLocation) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for TerminalLocation.
+func (l *TerminalLocation) GetID() string { return l.ID }
+
+// GetObject is the Resource.GetObject implementation for TerminalLocation.
+func (l *TerminalLocation) GetObject() string { return "terminal.location" }
+
+// GetCreated is the Resource.GetCreated implementation for TerminalReader.
+func (r *TerminalReader) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for TerminalReader.
+func (r *TerminalReader) GetID() string { return r.ID }
+
+// GetObject is the Resource.GetObject implementation for TerminalReader.
+func (r *TerminalReader) GetObject() string { return "terminal.reader" }
+
+// GetCreated is the Resource.GetCreated implementation for TestHelpersTestClock.
+func (t *TestHelpersTestClock) GetCreated() Timestamp { return t.Created }
+
+// GetID is the Resource.GetID implementation for TestHelpersTestClock.
+func (t *TestHelpersTestClock) GetID() string { return t.ID }
+
+// GetObject is the Resource.GetObject implementation for TestHelpersTestClock.
+func (t *TestHelpersTestClock) GetObject() string { return "test_helpers.test_clock" }
+
+// GetCreated is the Resource.GetCreated implementation for ThreeDSecure.
+func (t *ThreeDSecure) GetCreated() Timestamp { return t.Created }
+
+// GetID is the Resource.GetID implementation for ThreeDSecure.
+func (t *ThreeDSecure) GetID() string { return t.ID }
+
+// GetObject is the Resource.GetObject implementation for ThreeDSecure.
+func (t *ThreeDSecure) GetObject() string { return "three_d_secure" }
+
+// GetCreated is the Resource.GetCreated implementation for Token.
+func (t *Token) GetCreated() Timestamp { return t.Created }
+
+// GetID is the Resource.GetID implementation for Token.
+func (t *Token) GetID() string { return t.ID }
+
+// GetObject is the Resource.GetObject implementation for Token.
+func (t *Token) GetObject() string { return "token" }
+
+// GetCreated is the Resource.GetCreated implementation for Topup.
+func (t *Topup) GetCreated() Timestamp { return t.Created }
+
+// GetID is the Resource.GetID implementation for Topup.
+func (t *Topup) GetID() string { return t.ID }
+
+// GetObject is the Resource.GetObject implementation for Topup.
+func (t *Topup) GetObject() string { return "topup" }
+
+// GetCreated is the Resource.GetCreated implementation for Transaction.
+func (t *Transaction) GetCreated() Timestamp { return t.Created }
+
+// GetID is the Resource.GetID implementation for Transaction.
+func (t *Transaction) GetID() string { return t.ID }
+
+// GetObject is the Resource.GetObject implementation for Transaction.
+func (t *Transaction) GetObject() string { return "balance_transaction" }
+
+// GetCreated is the Resource.GetCreated implementation for Transfer.
+func (t *Transfer) GetCreated() Timestamp { return t.Created }
+
+// GetID is the Resource.GetID implementation for Transfer.
+func (t *Transfer) GetID() string { return t.ID }
+
+// GetObject is the Resource.GetObject implementation for Transfer.
+func (t *Transfer) GetObject() string { return "transfer" }
+
+// GetCreated is the Resource.GetCreated implementation for UsageRecord.
+func (u *UsageRecord) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for UsageRecord.
+func (u *UsageRecord) GetID() string { return u.ID }
+
+// GetObject is the Resource.GetObject implementation for UsageRecord.
+func (u *UsageRecord) GetObject() string { return "usage_record" }
+
+// GetCreated is the Resource.GetCreated implementation for UsageRecordSummary.
+func (u *UsageRecordSummary) GetCreated() Timestamp { return 0 }
+
+// GetID is the Resource.GetID implementation for UsageRecordSummary.
+func (u *UsageRecordSummary) GetID() string { return u.ID }
+
+// GetObject is the Resource.GetObject implementation for UsageRecordSummary.
+func (u *UsageRecordSummary) GetObject() string { return "usage_record_summary" }
+
+// GetCreated is the Resource.GetCreated implementation for WebhookEndpoint.
+func (w *WebhookEndpoint) GetCreated() Timestamp { return w.Created }
+
+// GetID is the Resource.GetID implementation for WebhookEndpoint.
+func (w *WebhookEndpoint) GetID() string { return w.ID }
+
+// GetObject is the Resource.GetObject implementation for WebhookEndpoint.
+func (w *WebhookEndpoint) GetObject() string { return "webhook_endpoint" }
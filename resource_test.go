@@ -0,0 +1,28 @@
+package stripe
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestResource(t *testing.T) {
+	resources := []Resource{
+		&Charge{ID: "ch_123", Created: 1500000000},
+		&Invoice{ID: "in_123", Date: 1500000000},
+		&Sub{ID: "sub_123", Created: 1500000000},
+	}
+	for _, r := range resources {
+		assert.NotEqual(t, "", r.GetID())
+		assert.Equal(t, Timestamp(1500000000), r.GetCreated())
+	}
+
+	assert.Equal(t, "charge", resources[0].GetObject())
+	assert.Equal(t, "invoice", resources[1].GetObject())
+	assert.Equal(t, "subscription", resources[2].GetObject())
+
+	// Objects without a creation time
+	var r Resource = &Card{ID: "card_123"}
+	assert.Equal(t, Timestamp(0), r.GetCreated())
+	assert.Equal(t, "card", r.GetObject())
+}
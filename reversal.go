@@ -7,6 +7,7 @@ type ReversalParams struct {
 	Params   `form:"*"`
 	Transfer string `form:"-"` // Included in URL
 	Amount   uint64 `form:"amount"`
+	Desc     string `form:"description"`
 	Fee      bool   `form:"refund_application_fee"`
 }
 
@@ -18,17 +19,21 @@ type ReversalListParams struct {
 
 // Reversal represents a transfer reversal.
 type Reversal struct {
-	Amount   uint64            `json:"amount"`
-	Created  int64             `json:"created"`
-	Currency Currency          `json:"currency"`
-	ID       string            `json:"id"`
-	Meta     map[string]string `json:"metadata"`
-	Transfer string            `json:"transfer"`
-	Tx       *Transaction      `json:"balance_transaction"`
+	APIResource
+	Amount                   uint64            `json:"amount"`
+	Created                  Timestamp         `json:"created"`
+	Currency                 Currency          `json:"currency"`
+	DestinationPaymentRefund *Refund           `json:"destination_payment_refund"`
+	ID                       string            `json:"id"`
+	Meta                     map[string]string `json:"metadata"`
+	SourceRefund             *Refund           `json:"source_refund"`
+	Transfer                 *Transfer         `json:"transfer"`
+	Tx                       *Transaction      `json:"balance_transaction"`
 }
 
 // ReversalList is a list of object for reversals.
 type ReversalList struct {
+	APIResource
 	ListMeta
 	Values []*Reversal `json:"data"`
 }
@@ -37,15 +42,18 @@ type ReversalList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (r *Reversal) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		r.ID = id
+		return nil
+	}
+
 	type reversal Reversal
 	var rr reversal
 	err := json.Unmarshal(data, &rr)
-	if err == nil {
-		*r = Reversal(rr)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		r.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*r = Reversal(rr)
 	return nil
 }
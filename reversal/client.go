@@ -101,5 +101,5 @@ func (i *Iter) Reversal() *stripe.Reversal {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
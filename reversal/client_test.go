@@ -6,8 +6,25 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
+func TestReversalRefunds(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/transfers/tr_123/reversals/trr_123", `{
+		"id": "trr_123",
+		"destination_payment_refund": "pyr_123",
+		"source_refund": {"id": "re_123", "amount": 500}
+	}`)
+
+	reversal, err := Client{B: b}.Get("trr_123", &stripe.ReversalParams{
+		Transfer: "tr_123",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "pyr_123", reversal.DestinationPaymentRefund.ID)
+	assert.Equal(t, uint64(500), reversal.SourceRefund.Amount)
+}
+
 func TestReversalGet(t *testing.T) {
 	reversal, err := Get("trr_123", &stripe.ReversalParams{
 		Transfer: "tr_123",
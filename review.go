@@ -16,26 +16,85 @@ const (
 	ReasonRule            ReasonType = "rule"
 )
 
+// ReviewParams is the set of parameters that can be used when retrieving a
+// review.
+// For more details see https://stripe.com/docs/api#retrieve_review.
+type ReviewParams struct {
+	Params `form:"*"`
+}
+
+// ReviewApproveParams is the set of parameters that can be used when
+// approving a review.
+// For more details see https://stripe.com/docs/api#approve_review.
+type ReviewApproveParams struct {
+	Params `form:"*"`
+}
+
+// ReviewListParams is the set of parameters that can be used when listing
+// reviews. Only open reviews are listed.
+// For more details see https://stripe.com/docs/api#list_reviews.
+type ReviewListParams struct {
+	ListParams   `form:"*"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+}
+
+// ReviewIPAddressLocation is the approximate location of the IP address that
+// the payment under review originated from.
+type ReviewIPAddressLocation struct {
+	City      string  `json:"city"`
+	Country   string  `json:"country"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Region    string  `json:"region"`
+}
+
+// ReviewSession contains information about the browser session that the
+// payment under review originated from.
+type ReviewSession struct {
+	Browser  string `json:"browser"`
+	Device   string `json:"device"`
+	Platform string `json:"platform"`
+	Version  string `json:"version"`
+}
+
+// Review is the resource representing a Radar review.
+// For more details see https://stripe.com/docs/api#reviews.
 type Review struct {
-	Charge  *Charge    `json:"charge"`
-	Created int64      `json:"created"`
-	ID      string     `json:"id"`
-	Live    bool       `json:"livemode"`
-	Open    bool       `json:"open"`
-	Reason  ReasonType `json:"reason"`
+	APIResource
+	Charge            *Charge                  `json:"charge"`
+	ClosedReason      ReasonType               `json:"closed_reason"`
+	Created           Timestamp                `json:"created"`
+	ID                string                   `json:"id"`
+	IPAddress         string                   `json:"ip_address"`
+	IPAddressLocation *ReviewIPAddressLocation `json:"ip_address_location"`
+	Live              bool                     `json:"livemode"`
+	Open              bool                     `json:"open"`
+	OpenedReason      ReasonType               `json:"opened_reason"`
+	Reason            ReasonType               `json:"reason"`
+	Session           *ReviewSession           `json:"session"`
+}
+
+// ReviewList is a list of reviews as retrieved from a list endpoint.
+type ReviewList struct {
+	APIResource
+	ListMeta
+	Values []*Review `json:"data"`
 }
 
 func (r *Review) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		r.ID = id
+		return nil
+	}
+
 	type review Review
 	var rr review
-
 	err := json.Unmarshal(data, &rr)
-	if err == nil {
-		*r = Review(rr)
-	} else {
-		// Otherwise...we have to strip the escaping
-		r.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*r = Review(rr)
 	return nil
 }
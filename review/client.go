@@ -0,0 +1,108 @@
+// Package review provides the /reviews APIs
+package review
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /reviews APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of a review.
+// For more details see https://stripe.com/docs/api#retrieve_review.
+func Get(id string, params *stripe.ReviewParams) (*stripe.Review, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.ReviewParams) (*stripe.Review, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	review := &stripe.Review{}
+	err := c.B.Call("GET", fmt.Sprintf("/reviews/%v", id), c.Key, body, commonParams, review)
+
+	return review, err
+}
+
+// Approve approves a review, closing it and removing it from the list of
+// open reviews.
+// For more details see https://stripe.com/docs/api#approve_review.
+func Approve(id string, params *stripe.ReviewApproveParams) (*stripe.Review, error) {
+	return getC().Approve(id, params)
+}
+
+func (c Client) Approve(id string, params *stripe.ReviewApproveParams) (*stripe.Review, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	review := &stripe.Review{}
+	err := c.B.Call("POST", fmt.Sprintf("/reviews/%v/approve", id), c.Key, body, commonParams, review)
+
+	return review, err
+}
+
+// List returns a list of reviews.
+// For more details see https://stripe.com/docs/api#list_reviews.
+func List(params *stripe.ReviewListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.ReviewListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.ReviewList{}
+		err := c.B.Call("GET", "/reviews", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of Reviews.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Review returns the most recent Review
+// visited by a call to Next.
+func (i *Iter) Review() *stripe.Review {
+	return i.Current().(*stripe.Review)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
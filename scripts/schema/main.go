@@ -0,0 +1,303 @@
+// Command schema generates the String methods of the enums of the stripe
+// package, and a machine-readable description of its enums and structs that
+// downstream code generators and validation layers can use to stay in sync
+// with the library.
+//
+// It's run from the root of the repository with:
+//
+//	go generate
+//
+// which writes enum_string.go and schema.json.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	stringerFile = "enum_string.go"
+	schemaFile   = "schema.json"
+)
+
+// Schema is the description of the enums and structs of the stripe package.
+type Schema struct {
+	Enums   []*Enum   `json:"enums"`
+	Structs []*Struct `json:"structs"`
+}
+
+// Enum is a string type of the stripe package along with its allowed
+// values, wherever their constants are declared.
+type Enum struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// Struct is an exported struct of the stripe package.
+type Struct struct {
+	Name   string   `json:"name"`
+	Fields []*Field `json:"fields"`
+}
+
+// Field is a field of a struct. JSON and Form are the names of the field
+// when decoding responses and encoding parameters, if any.
+type Field struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Embedded bool   `json:"embedded,omitempty"`
+	Form     string `json:"form,omitempty"`
+	JSON     string `json:"json,omitempty"`
+}
+
+func main() {
+	stringer, schema, err := generate(".")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "schema: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := ioutil.WriteFile(stringerFile, stringer, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "schema: %v\n", err)
+		os.Exit(1)
+	}
+	if err := ioutil.WriteFile(schemaFile, schema, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "schema: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate returns the contents of the stringer and schema files of the
+// stripe package rooted at dir.
+func generate(dir string) ([]byte, []byte, error) {
+	fset := token.NewFileSet()
+
+	root, err := parseDir(fset, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	enums := make(map[string]*Enum)
+	var structs []*Struct
+
+	for _, file := range root {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if !typeSpec.Name.IsExported() {
+					continue
+				}
+
+				switch t := typeSpec.Type.(type) {
+				case *ast.Ident:
+					if t.Name == "string" {
+						enums[typeSpec.Name.Name] = &Enum{Name: typeSpec.Name.Name, Values: []string{}}
+					}
+				case *ast.StructType:
+					structs = append(structs, newStruct(typeSpec.Name.Name, t))
+				}
+			}
+		}
+	}
+
+	// The constants of most enums are declared by the client packages, so
+	// every package of the repository is scanned for them.
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if path != dir && (info.Name() == "scripts" || info.Name() == "testing" || info.Name() == "vendor" || strings.HasPrefix(info.Name(), ".")) {
+			return filepath.SkipDir
+		}
+
+		files, err := parseDir(fset, path)
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			addEnumValues(enums, file, path == dir)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schema := &Schema{Structs: structs}
+	for _, enum := range enums {
+		sort.Strings(enum.Values)
+		schema.Enums = append(schema.Enums, enum)
+	}
+	sort.Slice(schema.Enums, func(i, j int) bool { return schema.Enums[i].Name < schema.Enums[j].Name })
+	sort.Slice(schema.Structs, func(i, j int) bool { return schema.Structs[i].Name < schema.Structs[j].Name })
+
+	stringer, err := generateStringer(schema.Enums)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemaData, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stringer, append(schemaData, '\n'), nil
+}
+
+// parseDir parses the non-test Go files of the package in dir.
+func parseDir(fset *token.FileSet, dir string) ([]*ast.File, error) {
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != stringerFile
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+// addEnumValues adds the values of the constants of file typed with one of
+// the enums. Inside the stripe package the types aren't qualified.
+func addEnumValues(enums map[string]*Enum, file *ast.File, inRoot bool) {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			if valueSpec.Type == nil {
+				continue
+			}
+
+			var name string
+			switch t := valueSpec.Type.(type) {
+			case *ast.Ident:
+				if inRoot {
+					name = t.Name
+				}
+			case *ast.SelectorExpr:
+				if pkg, ok := t.X.(*ast.Ident); ok && pkg.Name == "stripe" && !inRoot {
+					name = t.Sel.Name
+				}
+			}
+
+			enum, ok := enums[name]
+			if !ok {
+				continue
+			}
+
+			for _, value := range valueSpec.Values {
+				lit, ok := value.(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+
+				s, err := strconv.Unquote(lit.Value)
+				if err != nil || contains(enum.Values, s) {
+					continue
+				}
+				enum.Values = append(enum.Values, s)
+			}
+		}
+	}
+}
+
+// newStruct describes the exported fields of a struct type.
+func newStruct(name string, t *ast.StructType) *Struct {
+	s := &Struct{Name: name, Fields: []*Field{}}
+
+	for _, f := range t.Fields.List {
+		var tag reflect.StructTag
+		if f.Tag != nil {
+			unquoted, err := strconv.Unquote(f.Tag.Value)
+			if err == nil {
+				tag = reflect.StructTag(unquoted)
+			}
+		}
+
+		field := &Field{
+			Type: types.ExprString(f.Type),
+			Form: tagName(tag.Get("form")),
+			JSON: tagName(tag.Get("json")),
+		}
+
+		if len(f.Names) == 0 {
+			field.Name = strings.TrimPrefix(field.Type, "*")
+			field.Embedded = true
+			s.Fields = append(s.Fields, field)
+			continue
+		}
+
+		for _, ident := range f.Names {
+			if !ident.IsExported() {
+				continue
+			}
+			named := *field
+			named.Name = ident.Name
+			s.Fields = append(s.Fields, &named)
+		}
+	}
+
+	return s
+}
+
+// tagName returns the name given by a struct tag, ignoring its options and
+// the "-" and "*" names used for fields that aren't encoded or are inlined.
+func tagName(tag string) string {
+	name := strings.Split(tag, ",")[0]
+	if name == "-" || name == "*" {
+		return ""
+	}
+	return name
+}
+
+// generateStringer returns the source of the String methods of the enums.
+func generateStringer(enums []*Enum) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by scripts/schema; DO NOT EDIT.\n\n")
+	buf.WriteString("package stripe\n")
+	for _, enum := range enums {
+		fmt.Fprintf(&buf, "\n// String returns the value of the %v.\n", enum.Name)
+		fmt.Fprintf(&buf, "func (x %v) String() string { return string(x) }\n", enum.Name)
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+// TestGenerateUpToDate fails when the generated files weren't regenerated
+// after a change to the enums or structs of the stripe package.
+func TestGenerateUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..")
+
+	stringer, schema, err := generate(root)
+	assert.NoError(t, err)
+
+	existing, err := ioutil.ReadFile(filepath.Join(root, stringerFile))
+	assert.NoError(t, err)
+	assert.Equal(t, string(existing), string(stringer), "run go generate to update "+stringerFile)
+
+	existing, err = ioutil.ReadFile(filepath.Join(root, schemaFile))
+	assert.NoError(t, err)
+	assert.Equal(t, string(existing), string(schema), "run go generate to update "+schemaFile)
+}
@@ -0,0 +1,128 @@
+package stripe
+
+import "encoding/json"
+
+// ShippingRateDeliveryEstimateUnit is the list of allowed values for the unit
+// of a shipping rate's delivery estimate. Allowed values are "business_day",
+// "day", "hour", "month" and "week".
+type ShippingRateDeliveryEstimateUnit string
+
+// ShippingRateType is the list of allowed values for the type of a shipping
+// rate. The only allowed value is "fixed_amount".
+type ShippingRateType string
+
+// ShippingRateDeliveryEstimateBoundParams is the set of parameters for one end
+// of a shipping rate's delivery estimate.
+type ShippingRateDeliveryEstimateBoundParams struct {
+	Unit  ShippingRateDeliveryEstimateUnit `form:"unit"`
+	Value int64                            `form:"value"`
+}
+
+// ShippingRateDeliveryEstimateParams is the set of parameters for how long
+// delivery is estimated to take with a shipping rate.
+type ShippingRateDeliveryEstimateParams struct {
+	Maximum *ShippingRateDeliveryEstimateBoundParams `form:"maximum"`
+	Minimum *ShippingRateDeliveryEstimateBoundParams `form:"minimum"`
+}
+
+// ShippingRateFixedAmountParams is the set of parameters for the amount
+// charged by a fixed amount shipping rate.
+type ShippingRateFixedAmountParams struct {
+	Amount   int64    `form:"amount"`
+	Currency Currency `form:"currency"`
+}
+
+// ShippingRateParams is the set of parameters that can be used when creating
+// or updating a shipping rate. A shipping rate can't be deleted, but it can be
+// archived by setting Inactive. Only Active, Inactive, TaxBehavior and
+// metadata can be updated.
+// For more details see https://stripe.com/docs/api/shipping_rates/create.
+type ShippingRateParams struct {
+	Params           `form:"*"`
+	Active           bool                                `form:"active"`
+	DeliveryEstimate *ShippingRateDeliveryEstimateParams `form:"delivery_estimate"`
+	DisplayName      string                              `form:"display_name"`
+	FixedAmount      *ShippingRateFixedAmountParams      `form:"fixed_amount"`
+	Inactive         bool                                `form:"active,invert"`
+	TaxBehavior      TaxBehavior                         `form:"tax_behavior"`
+	TaxCode          string                              `form:"tax_code"`
+	Type             ShippingRateType                    `form:"type"`
+}
+
+// ShippingRateListParams is the set of parameters that can be used when
+// listing shipping rates.
+// For more details see https://stripe.com/docs/api/shipping_rates/list.
+type ShippingRateListParams struct {
+	ListParams   `form:"*"`
+	Active       *bool             `form:"active"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Currency     Currency          `form:"currency"`
+}
+
+// ShippingRateDeliveryEstimateBound is one end of a shipping rate's delivery
+// estimate.
+type ShippingRateDeliveryEstimateBound struct {
+	Unit  ShippingRateDeliveryEstimateUnit `json:"unit"`
+	Value int64                            `json:"value"`
+}
+
+// ShippingRateDeliveryEstimate is how long delivery is estimated to take with
+// a shipping rate.
+type ShippingRateDeliveryEstimate struct {
+	Maximum *ShippingRateDeliveryEstimateBound `json:"maximum"`
+	Minimum *ShippingRateDeliveryEstimateBound `json:"minimum"`
+}
+
+// ShippingRateFixedAmount is the amount charged by a fixed amount shipping
+// rate.
+type ShippingRateFixedAmount struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+// ShippingRate is the resource representing a Stripe shipping rate, a
+// shipping option that can be offered to customers during checkout.
+// For more details see https://stripe.com/docs/api/shipping_rates.
+type ShippingRate struct {
+	APIResource
+	Active           bool                          `json:"active"`
+	Created          Timestamp                     `json:"created"`
+	DeliveryEstimate *ShippingRateDeliveryEstimate `json:"delivery_estimate"`
+	DisplayName      string                        `json:"display_name"`
+	FixedAmount      *ShippingRateFixedAmount      `json:"fixed_amount"`
+	ID               string                        `json:"id"`
+	Live             bool                          `json:"livemode"`
+	Meta             map[string]string             `json:"metadata"`
+	TaxBehavior      TaxBehavior                   `json:"tax_behavior"`
+	TaxCode          string                        `json:"tax_code"`
+	Type             ShippingRateType              `json:"type"`
+}
+
+// ShippingRateList is a list of shipping rates as retrieved from a list
+// endpoint.
+type ShippingRateList struct {
+	APIResource
+	ListMeta
+	Values []*ShippingRate `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a ShippingRate.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (s *ShippingRate) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		s.ID = id
+		return nil
+	}
+
+	type shippingRate ShippingRate
+	var sr shippingRate
+	err := json.Unmarshal(data, &sr)
+	if err != nil {
+		return err
+	}
+
+	*s = ShippingRate(sr)
+	return nil
+}
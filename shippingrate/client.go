@@ -0,0 +1,143 @@
+// Package shippingrate provides the /shipping_rates APIs
+package shippingrate
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	DeliveryEstimateUnitBusinessDay stripe.ShippingRateDeliveryEstimateUnit = "business_day"
+	DeliveryEstimateUnitDay         stripe.ShippingRateDeliveryEstimateUnit = "day"
+	DeliveryEstimateUnitHour        stripe.ShippingRateDeliveryEstimateUnit = "hour"
+	DeliveryEstimateUnitMonth       stripe.ShippingRateDeliveryEstimateUnit = "month"
+	DeliveryEstimateUnitWeek        stripe.ShippingRateDeliveryEstimateUnit = "week"
+
+	TaxBehaviorExclusive   stripe.TaxBehavior = "exclusive"
+	TaxBehaviorInclusive   stripe.TaxBehavior = "inclusive"
+	TaxBehaviorUnspecified stripe.TaxBehavior = "unspecified"
+
+	TypeFixedAmount stripe.ShippingRateType = "fixed_amount"
+)
+
+// Client is used to invoke /shipping_rates APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new shipping rate.
+// For more details see https://stripe.com/docs/api/shipping_rates/create.
+func New(params *stripe.ShippingRateParams) (*stripe.ShippingRate, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.ShippingRateParams) (*stripe.ShippingRate, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	shippingRate := &stripe.ShippingRate{}
+	err := c.B.Call("POST", "/shipping_rates", c.Key, body, commonParams, shippingRate)
+
+	return shippingRate, err
+}
+
+// Get returns the details of a shipping rate.
+// For more details see https://stripe.com/docs/api/shipping_rates/retrieve.
+func Get(id string, params *stripe.ShippingRateParams) (*stripe.ShippingRate, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.ShippingRateParams) (*stripe.ShippingRate, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	shippingRate := &stripe.ShippingRate{}
+	err := c.B.Call("GET", fmt.Sprintf("/shipping_rates/%v", id), c.Key, body, commonParams, shippingRate)
+
+	return shippingRate, err
+}
+
+// Update updates a shipping rate's properties.
+// For more details see https://stripe.com/docs/api/shipping_rates/update.
+func Update(id string, params *stripe.ShippingRateParams) (*stripe.ShippingRate, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.ShippingRateParams) (*stripe.ShippingRate, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	shippingRate := &stripe.ShippingRate{}
+	err := c.B.Call("POST", fmt.Sprintf("/shipping_rates/%v", id), c.Key, body, commonParams, shippingRate)
+
+	return shippingRate, err
+}
+
+// List returns a list of shipping rates.
+// For more details see https://stripe.com/docs/api/shipping_rates/list.
+func List(params *stripe.ShippingRateListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.ShippingRateListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.ShippingRateList{}
+		err := c.B.Call("GET", "/shipping_rates", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of ShippingRates.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// ShippingRate returns the most recent ShippingRate
+// visited by a call to Next.
+func (i *Iter) ShippingRate() *stripe.ShippingRate {
+	return i.Current().(*stripe.ShippingRate)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,79 @@
+package shippingrate
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestShippingRateGet(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/shipping_rates/shr_123", `{
+		"id": "shr_123",
+		"object": "shipping_rate",
+		"delivery_estimate": {"maximum": {"unit": "business_day", "value": 7}, "minimum": {"unit": "business_day", "value": 5}},
+		"fixed_amount": {"amount": 500, "currency": "usd"},
+		"tax_behavior": "exclusive",
+		"type": "fixed_amount"
+	}`)
+
+	shippingRate, err := Client{B: b}.Get("shr_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, TypeFixedAmount, shippingRate.Type)
+	assert.Equal(t, int64(500), shippingRate.FixedAmount.Amount)
+	assert.Equal(t, DeliveryEstimateUnitBusinessDay, shippingRate.DeliveryEstimate.Maximum.Unit)
+	assert.Equal(t, int64(7), shippingRate.DeliveryEstimate.Maximum.Value)
+	assert.Equal(t, TaxBehaviorExclusive, shippingRate.TaxBehavior)
+}
+
+func TestShippingRateList(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/shipping_rates", `{"data": [{"id": "shr_123"}]}`)
+
+	i := Client{B: b}.List(&stripe.ShippingRateListParams{Currency: "usd"})
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, "shr_123", i.ShippingRate().ID)
+	assert.Equal(t, "usd", b.LastCall().Values().Get("currency"))
+}
+
+func TestShippingRateNew(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/shipping_rates", `{"id": "shr_123", "display_name": "Ground shipping"}`)
+
+	shippingRate, err := Client{B: b}.New(&stripe.ShippingRateParams{
+		DeliveryEstimate: &stripe.ShippingRateDeliveryEstimateParams{
+			Maximum: &stripe.ShippingRateDeliveryEstimateBoundParams{Unit: DeliveryEstimateUnitBusinessDay, Value: 7},
+			Minimum: &stripe.ShippingRateDeliveryEstimateBoundParams{Unit: DeliveryEstimateUnitBusinessDay, Value: 5},
+		},
+		DisplayName: "Ground shipping",
+		FixedAmount: &stripe.ShippingRateFixedAmountParams{
+			Amount:   500,
+			Currency: "usd",
+		},
+		TaxBehavior: TaxBehaviorExclusive,
+		Type:        TypeFixedAmount,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Ground shipping", shippingRate.DisplayName)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "business_day", values.Get("delivery_estimate[maximum][unit]"))
+	assert.Equal(t, "5", values.Get("delivery_estimate[minimum][value]"))
+	assert.Equal(t, "500", values.Get("fixed_amount[amount]"))
+	assert.Equal(t, "exclusive", values.Get("tax_behavior"))
+	assert.Equal(t, "fixed_amount", values.Get("type"))
+}
+
+func TestShippingRateUpdate(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/shipping_rates/shr_123", `{"id": "shr_123", "active": false}`)
+
+	shippingRate, err := Client{B: b}.Update("shr_123", &stripe.ShippingRateParams{Inactive: true})
+	assert.Nil(t, err)
+	assert.False(t, shippingRate.Active)
+	assert.Equal(t, "false", b.LastCall().Values().Get("active"))
+}
@@ -23,9 +23,10 @@ type Inventory struct {
 }
 
 type SKU struct {
+	APIResource
 	Active            bool               `json:"active"`
 	Attrs             map[string]string  `json:"attributes"`
-	Created           int64              `json:"created"`
+	Created           Timestamp          `json:"created"`
 	Currency          string             `json:"currency"`
 	Desc              string             `json:"description"`
 	ID                string             `json:"id"`
@@ -36,10 +37,11 @@ type SKU struct {
 	PackageDimensions *PackageDimensions `json:"package_dimensions"`
 	Price             int64              `json:"price"`
 	Product           Product            `json:"product"`
-	Updated           int64              `json:"updated"`
+	Updated           Timestamp          `json:"updated"`
 }
 
 type SKUList struct {
+	APIResource
 	ListMeta
 	Values []*SKU `json:"data"`
 }
@@ -54,14 +56,18 @@ type SKUListParams struct {
 }
 
 func (s *SKU) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		s.ID = id
+		return nil
+	}
+
 	type sku SKU
 	var sk sku
 	err := json.Unmarshal(data, &sk)
-	if err == nil {
-		*s = SKU(sk)
-	} else {
-		s.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*s = SKU(sk)
 	return nil
 }
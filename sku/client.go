@@ -150,5 +150,5 @@ func (c Client) Del(id string, params *stripe.SKUParams) (*stripe.SKU, error) {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
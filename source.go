@@ -75,18 +75,61 @@ type RedirectParams struct {
 	ReturnURL string `form:"return_url"`
 }
 
+// ReceiverParams is the set of parameters for the receiver flow of a source.
+type ReceiverParams struct {
+	RefundAttributesMethod RefundAttributesMethod `form:"refund_attributes_method"`
+}
+
+// SourceRefundAttributesParams is the set of parameters that can be used to
+// provide the refund attributes of a receiver flow source, which tell where
+// funds pushed by the customer should be returned. They're requested when the
+// receiver flow's RefundAttributesStatus is "requested".
+//
+// Refund attributes are specific to the type of the source, so Type must be
+// set to the type of the source being updated.
+type SourceRefundAttributesParams struct {
+	Params            `form:"*"`
+	AccountHolderName string `form:"-"` // See custom AppendTo
+	AccountHolderType string `form:"-"` // See custom AppendTo
+	AccountNumber     string `form:"-"` // See custom AppendTo
+	RoutingNumber     string `form:"-"` // See custom AppendTo
+	Type              string `form:"-"` // See custom AppendTo
+}
+
+// AppendTo implements custom encoding logic for SourceRefundAttributesParams
+// so that the refund attributes are nested under the type of the source.
+func (p *SourceRefundAttributesParams) AppendTo(body *form.Values, keyParts []string) {
+	attributes := []struct {
+		key   string
+		value string
+	}{
+		{"refund_account_holder_name", p.AccountHolderName},
+		{"refund_account_holder_type", p.AccountHolderType},
+		{"refund_account_number", p.AccountNumber},
+		{"refund_routing_number", p.RoutingNumber},
+	}
+
+	for _, attribute := range attributes {
+		if attribute.value != "" {
+			body.Add(form.FormatKey(append(keyParts, p.Type, attribute.key)), attribute.value)
+		}
+	}
+}
+
 type SourceObjectParams struct {
-	Params   `form:"*"`
-	Amount   uint64             `form:"amount"`
-	Currency Currency           `form:"currency"`
-	Customer string             `form:"customer"`
-	Flow     SourceFlow         `form:"flow"`
-	Owner    *SourceOwnerParams `form:"owner"`
-	Redirect *RedirectParams    `form:"redirect"`
-	Token    string             `form:"token"`
-	Type     string             `form:"type"`
-	TypeData map[string]string  `form:"-"`
-	Usage    SourceUsage        `form:"usage"`
+	Params    `form:"*"`
+	Amount    uint64             `form:"amount"`
+	Currency  Currency           `form:"currency"`
+	Customer  string             `form:"customer"`
+	Flow      SourceFlow         `form:"flow"`
+	Owner     *SourceOwnerParams `form:"owner"`
+	Receiver  *ReceiverParams    `form:"receiver"`
+	Redirect  *RedirectParams    `form:"redirect"`
+	Statement string             `form:"statement_descriptor"`
+	Token     string             `form:"token"`
+	Type      string             `form:"type"`
+	TypeData  map[string]string  `form:"-"`
+	Usage     SourceUsage        `form:"usage"`
 }
 
 type SourceOwner struct {
@@ -110,10 +153,16 @@ const (
 )
 
 // ReceiverFlow informs of the state of a redirect authentication flow.
+//
+// Wallet flows can also be authenticated in the native app of the wallet
+// rather than in a browser: AndroidNativeURL and IOSNativeURL are then the
+// URLs that open the app on each platform, and are empty otherwise.
 type RedirectFlow struct {
-	ReturnURL string             `json:"return_url"`
-	Status    RedirectFlowStatus `json:"status"`
-	URL       string             `json:"url"`
+	AndroidNativeURL string             `json:"android_native_url"`
+	IOSNativeURL     string             `json:"ios_native_url"`
+	ReturnURL        string             `json:"return_url"`
+	Status           RedirectFlowStatus `json:"status"`
+	URL              string             `json:"url"`
 }
 
 // RefundAttributesStatus are the possible status of a receiver's refund
@@ -170,9 +219,10 @@ type VerificationFlow struct {
 }
 
 type Source struct {
+	APIResource
 	Amount       int64             `json:"amount"`
 	ClientSecret string            `json:"client_secret"`
-	Created      int64             `json:"created"`
+	Created      Timestamp         `json:"created"`
 	Currency     Currency          `json:"currency"`
 	Flow         SourceFlow        `json:"flow"`
 	ID           string            `json:"id"`
@@ -181,6 +231,7 @@ type Source struct {
 	Owner        SourceOwner       `json:"owner"`
 	Receiver     *ReceiverFlow     `json:"receiver,omitempty"`
 	Redirect     *RedirectFlow     `json:"redirect,omitempty"`
+	Statement    string            `json:"statement_descriptor"`
 	Status       SourceStatus      `json:"status"`
 	Type         string            `json:"type"`
 	TypeData     map[string]interface{}
@@ -1,6 +1,8 @@
 package source
 
 import (
+	"fmt"
+
 	stripe "github.com/stripe/stripe-go"
 	"github.com/stripe/stripe-go/form"
 )
@@ -80,6 +82,31 @@ func (c Client) Update(id string, params *stripe.SourceObjectParams) (*stripe.So
 	return source, err
 }
 
+// UpdateRefundAttributes provides the refund attributes of a receiver flow
+// source so that the funds pushed by the customer can be returned to them.
+// For more details see https://stripe.com/docs/sources#refunds.
+func UpdateRefundAttributes(id string, params *stripe.SourceRefundAttributesParams) (*stripe.Source, error) {
+	return getC().UpdateRefundAttributes(id, params)
+}
+
+func (c Client) UpdateRefundAttributes(id string, params *stripe.SourceRefundAttributesParams) (*stripe.Source, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+
+	if params.Type == "" {
+		return nil, fmt.Errorf("params.Type must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	source := &stripe.Source{}
+	err := c.B.Call("POST", "/sources/"+id, c.Key, body, &params.Params, source)
+
+	return source, err
+}
+
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
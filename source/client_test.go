@@ -37,3 +37,14 @@ func TestSourceUpdate(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, source)
 }
+
+func TestSourceUpdateRefundAttributes(t *testing.T) {
+	source, err := UpdateRefundAttributes("src_123", &stripe.SourceRefundAttributesParams{
+		AccountHolderName: "Jenny Rosen",
+		AccountNumber:     "000123456789",
+		RoutingNumber:     "110000000",
+		Type:              "ach_credit_transfer",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, source)
+}
@@ -1,6 +1,7 @@
 package stripe
 
 import (
+	"encoding/json"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
@@ -22,4 +23,53 @@ func TestSourceObjectParams_AppendTo(t *testing.T) {
 		t.Logf("body = %+v", body)
 		assert.Equal(t, []string{"bar"}, body.Get("source_type[foo]"))
 	}
+
+	{
+		params := &SourceObjectParams{Statement: "ACME ORDER 123"}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"ACME ORDER 123"}, body.Get("statement_descriptor"))
+	}
+}
+
+func TestSourceRefundAttributesParams_AppendTo(t *testing.T) {
+	params := &SourceRefundAttributesParams{
+		AccountHolderName: "Jenny Rosen",
+		AccountNumber:     "000123456789",
+		RoutingNumber:     "110000000",
+		Type:              "ach_credit_transfer",
+	}
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	t.Logf("body = %+v", body)
+	assert.Equal(t, []string{"Jenny Rosen"}, body.Get("ach_credit_transfer[refund_account_holder_name]"))
+	assert.Equal(t, []string{"000123456789"}, body.Get("ach_credit_transfer[refund_account_number]"))
+	assert.Equal(t, []string{"110000000"}, body.Get("ach_credit_transfer[refund_routing_number]"))
+	assert.Equal(t, 0, len(body.Get("ach_credit_transfer[refund_account_holder_type]")))
+}
+
+func TestSourceUnmarshalRedirect(t *testing.T) {
+	data := []byte(`{
+		"id": "src_123",
+		"flow": "redirect",
+		"redirect": {
+			"android_native_url": "weixin://pay/src_123",
+			"ios_native_url": "weixin://app/pay/src_123",
+			"return_url": "https://example.com/return",
+			"status": "pending",
+			"url": "https://hooks.stripe.com/redirect/src_123"
+		},
+		"statement_descriptor": "ACME ORDER 123",
+		"type": "wechat"
+	}`)
+
+	var source Source
+	err := json.Unmarshal(data, &source)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "ACME ORDER 123", source.Statement)
+	assert.Equal(t, "weixin://pay/src_123", source.Redirect.AndroidNativeURL)
+	assert.Equal(t, "weixin://app/pay/src_123", source.Redirect.IOSNativeURL)
+	assert.Equal(t, RedirectFlowStatusPending, source.Redirect.Status)
 }
@@ -3,6 +3,7 @@ package stripe
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,18 +15,25 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/stripe/stripe-go/form"
 )
 
+//go:generate go run scripts/schema/main.go
+
 const (
 	apiURL     = "https://api.stripe.com/v1"
+	connectURL = "https://connect.stripe.com"
+	filesURL   = "https://files.stripe.com/v1"
 	uploadsURL = "https://uploads.stripe.com/v1"
 )
 
-// apiversion is the currently supported API version
-const apiversion = "2017-05-25"
+// APIVersion is the API version that the library is written against and
+// sends with every request, unless it's overridden by the StripeVersion of
+// the backend or of the params of a request.
+const APIVersion = "2017-05-25"
 
 // clientversion is the binding version
 const clientversion = "28.0.0"
@@ -37,7 +45,7 @@ const defaultHTTPTimeout = 80 * time.Second
 
 // TotalBackends is the total number of Stripe API endpoints supported by the
 // binding.
-const TotalBackends = 2
+const TotalBackends = 4
 
 // UnknownPlatform is the string returned as the system name if we couldn't get
 // one from `uname`.
@@ -73,15 +81,115 @@ type Backend interface {
 	CallMultipart(method, path, key, boundary string, body io.Reader, params *Params, v interface{}) error
 }
 
+// StreamingBackend is an interface implemented by backends that can return
+// the raw body of a response, like a PDF, instead of decoding it as JSON.
+// The caller is responsible for closing the returned body.
+type StreamingBackend interface {
+	CallStreaming(method, path, key string, body *form.Values, params *Params) (io.ReadCloser, error)
+}
+
 // BackendConfiguration is the internal implementation for making HTTP calls to Stripe.
+//
+// Each backend can be given its own HTTP client and timeout, for instance to
+// send the requests of the API backend through a proxy:
+//
+//	proxyURL, _ := url.Parse("http://proxy.internal:3128")
+//	stripe.SetBackend(stripe.APIBackend, stripe.BackendConfiguration{
+//	    Type: stripe.APIBackend,
+//	    HTTPClient: &http.Client{
+//	        Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)},
+//	    },
+//	    Timeout: 30 * time.Second,
+//	})
 type BackendConfiguration struct {
-	Type       SupportedBackend
-	URL        string
+	Type SupportedBackend
+
+	// URL is the URL of the backend. It defaults to the URL of its Type,
+	// like APIURL for APIBackend.
+	URL string
+
+	// HTTPClient is the client that sends the requests of the backend. It
+	// defaults to the client set with SetHTTPClient, which is shared by
+	// every backend that doesn't have its own.
 	HTTPClient *http.Client
+
+	// EnableTelemetry sends the ID and duration of previous requests to
+	// Stripe in the X-Stripe-Client-Telemetry header of later requests,
+	// which helps Stripe monitor the latency seen by its users. No data
+	// other than request IDs and durations is sent.
+	EnableTelemetry bool
+
+	// Limiter, if set, limits the number of requests of the backend in
+	// flight. Requests wait for a slot to be free, within their Timeout.
+	Limiter *ConcurrencyLimiter
+
+	// MaxRateLimitRetries, if positive, is how many times a request
+	// rejected by the rate limiter of the API is retried by Call. Before
+	// every retry, the request waits for as long as the Retry-After header
+	// of the response asks, or backs off exponentially. When Timeout is
+	// set, a retry that couldn't be made before Timeout has elapsed since
+	// the first attempt isn't waited for: the rate limiting error is
+	// returned at once.
+	// Requests made with CallMultipart or CallStreaming aren't retried.
+	MaxRateLimitRetries int
+
+	// MaxResponseSize, if positive, is the largest response body in bytes
+	// that the backend reads. Larger responses are discarded and a
+	// *ResponseTooLargeError is returned instead, which protects
+	// memory-constrained services from pathologically large responses, like
+	// lists with many expanded objects. Streamed responses, like file
+	// downloads, aren't limited.
+	MaxResponseSize int64
+
+	// Metrics, if set, is given the metrics of every request, like its
+	// latency and the error it returned.
+	Metrics MetricsCollector
+
+	// OnRequest, if set, is called with every request right before it's
+	// sent, once the backend has set its headers. It can add headers of its
+	// own, log the request for auditing, or alter it for chaos testing.
+	OnRequest func(*http.Request)
+
+	// OnResponse, if set, is called once every request completes with the
+	// response, or the error returned by the HTTP client, and the time the
+	// request took. It must not read or close the body of the response,
+	// which the backend still needs.
+	OnResponse func(*http.Response, error, time.Duration)
+
+	// StripeVersion, if set, is the API version sent with the requests of
+	// the backend instead of APIVersion. The params of a request can
+	// override it with their own StripeVersion. Note that responses are
+	// still decoded into the types of this library, which are written
+	// against APIVersion.
+	StripeVersion string
+
+	// Timeout, if positive, limits the time each request of the backend
+	// can take, including reading its response, on top of any timeout of
+	// its HTTP client.
+	Timeout time.Duration
+
+	// TraceForm records the encoded parameters of each request and attaches
+	// them to the *InvalidRequestError returned when the request fails, with
+	// the values of sensitive parameters like card numbers redacted. It helps
+	// diagnosing errors like unknown parameters without capturing traffic.
+	TraceForm bool
+}
+
+// ResponseTooLargeError is the error returned when a response is larger than
+// the MaxResponseSize of the backend.
+type ResponseTooLargeError struct {
+	Limit  int64
+	Method string
+	Path   string
+}
+
+// Error returns a description of the request whose response was too large.
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("Response to %v %v exceeds the maximum size of %v bytes", e.Method, e.Path, e.Limit)
 }
 
 // SupportedBackend is an enumeration of supported Stripe endpoints.
-// Currently supported values are "api" and "uploads".
+// Currently supported values are "api", "connect", "files", and "uploads".
 type SupportedBackend string
 
 const (
@@ -91,6 +199,21 @@ const (
 	// APIURL is the URL of the API service backend.
 	APIURL string = "https://api.stripe.com/v1"
 
+	// ConnectBackend is a constant representing the Connect service backend,
+	// which serves the OAuth endpoints used to connect accounts.
+	ConnectBackend SupportedBackend = "connect"
+
+	// ConnectURL is the URL of the Connect service backend. Unlike the other
+	// backends, its endpoints aren't versioned.
+	ConnectURL string = "https://connect.stripe.com"
+
+	// FilesBackend is a constant representing the files service backend,
+	// which serves file contents like quote PDFs.
+	FilesBackend SupportedBackend = "files"
+
+	// FilesURL is the URL of the files service backend.
+	FilesURL string = "https://files.stripe.com/v1"
+
 	// UploadsBackend is a constant representing the uploads service backend.
 	UploadsBackend SupportedBackend = "uploads"
 
@@ -98,9 +221,11 @@ const (
 	UploadsURL string = "https://uploads.stripe.com/v1"
 )
 
-// Backends are the currently supported endpoints.
+// Backends are the currently supported endpoints. Each of them can be
+// configured with its own URL and HTTP client, for instance to point the API
+// backend at a mock server while leaving the others untouched.
 type Backends struct {
-	API, Uploads Backend
+	API, Connect, Files, Uploads Backend
 }
 
 // stripeClientUserAgent contains information about the current runtime which
@@ -115,7 +240,21 @@ type stripeClientUserAgent struct {
 	Uname           string   `json:"uname"`
 }
 
+// ClientID is the Connect client ID of the platform, used globally by the
+// oauth package. Like Key, it should be changed with SetClientID once
+// requests may be in flight.
+var ClientID string
+
 // Key is the Stripe API key used globally in the binding.
+//
+// Key can be assigned directly before any request is made. Once requests may
+// be in flight, it must only be changed with SetKey: it's read by every
+// request made through the package-level functions, so assigning it directly
+// then is a data race. Code that needs several keys at once (like tests
+// running in parallel) should give each caller its own client with its own
+// key instead, with client.New or the Client type of a resource package. The
+// functions that configure backends, like SetBackend, SetHTTPClient and
+// SetAppInfo, are safe for concurrent use too.
 var Key string
 
 // LogLevel is the logging level for this library.
@@ -140,65 +279,150 @@ func init() {
 	initUserAgent()
 }
 
+// configMu guards the configuration set by SetAppInfo, SetBackend,
+// SetClientID, SetHTTPClient and SetKey, which may be changed while requests
+// are in flight.
+var configMu sync.RWMutex
+
 var appInfo *AppInfo
 var httpClient = &http.Client{Timeout: defaultHTTPTimeout}
 var backends Backends
 var encodedStripeUserAgent string
 var encodedUserAgent string
 
+// GetClientID returns the Connect client ID set with SetClientID, or
+// assigned to ClientID. It's safe for concurrent use.
+func GetClientID() string {
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return ClientID
+}
+
+// SetClientID sets ClientID. It's safe to call while requests are in flight.
+func SetClientID(clientID string) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	ClientID = clientID
+}
+
+// GetKey returns the API key set with SetKey, or assigned to Key. It's safe
+// for concurrent use.
+func GetKey() string {
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return Key
+}
+
+// SetKey sets Key. It's safe to call while requests are in flight; they
+// complete with the key they started with.
+func SetKey(key string) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	Key = key
+}
+
 // SetHTTPClient overrides the default HTTP client.
 // This is useful if you're running in a Google AppEngine environment
-// where the http.DefaultClient is not available.
+// where the http.DefaultClient is not available. It's safe to call while
+// requests are in flight, and affects the backends that don't have their own
+// HTTP client.
 func SetHTTPClient(client *http.Client) {
+	configMu.Lock()
+	defer configMu.Unlock()
 	httpClient = client
 }
 
+// defaultHTTPClient returns the HTTP client set with SetHTTPClient.
+func defaultHTTPClient() *http.Client {
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return httpClient
+}
+
 // NewBackends creates a new set of backends with the given HTTP client. You
 // should only need to use this for testing purposes or on App Engine.
 func NewBackends(httpClient *http.Client) *Backends {
 	return &Backends{
 		API: BackendConfiguration{
-			APIBackend, APIURL, httpClient},
+			Type: APIBackend, URL: APIURL, HTTPClient: httpClient},
+		Connect: BackendConfiguration{
+			Type: ConnectBackend, URL: ConnectURL, HTTPClient: httpClient},
+		Files: BackendConfiguration{
+			Type: FilesBackend, URL: FilesURL, HTTPClient: httpClient},
 		Uploads: BackendConfiguration{
-			UploadsBackend, UploadsURL, httpClient},
+			Type: UploadsBackend, URL: UploadsURL, HTTPClient: httpClient},
 	}
 }
 
-// GetBackend returns the currently used backend in the binding.
+// GetBackend returns the currently used backend in the binding. It's safe for
+// concurrent use.
 func GetBackend(backend SupportedBackend) Backend {
 	var ret Backend
-	switch backend {
-	case APIBackend:
-		if backends.API == nil {
-			backends.API = BackendConfiguration{backend, apiURL, httpClient}
-		}
-
-		ret = backends.API
-	case UploadsBackend:
-		if backends.Uploads == nil {
-			backends.Uploads = BackendConfiguration{backend, uploadsURL, httpClient}
+	configMu.RLock()
+	slot := backendSlot(backend)
+	if slot != nil {
+		ret = *slot
+	}
+	configMu.RUnlock()
+
+	if slot == nil || ret != nil {
+		return ret
+	}
+
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	// Another caller may have set the backend in the meantime
+	if *slot == nil {
+		var url string
+		switch backend {
+		case APIBackend:
+			url = apiURL
+		case ConnectBackend:
+			url = connectURL
+		case FilesBackend:
+			url = filesURL
+		case UploadsBackend:
+			url = uploadsURL
 		}
-		ret = backends.Uploads
+		*slot = BackendConfiguration{Type: backend, URL: url, HTTPClient: httpClient}
 	}
-
-	return ret
+	return *slot
 }
 
-// SetBackend sets the backend used in the binding.
+// SetBackend sets the backend used in the binding. It's safe to call while
+// requests are in flight; they complete with the backend they started with.
 func SetBackend(backend SupportedBackend, b Backend) {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	if slot := backendSlot(backend); slot != nil {
+		*slot = b
+	}
+}
+
+// backendSlot returns where the backend of the given type is stored, or nil
+// if the type isn't supported. configMu must be held.
+func backendSlot(backend SupportedBackend) *Backend {
 	switch backend {
 	case APIBackend:
-		backends.API = b
+		return &backends.API
+	case ConnectBackend:
+		return &backends.Connect
+	case FilesBackend:
+		return &backends.Files
 	case UploadsBackend:
-		backends.Uploads = b
+		return &backends.Uploads
 	}
+	return nil
 }
 
 // Call is the Backend.Call implementation for invoking Stripe APIs.
 func (s BackendConfiguration) Call(method, path, key string, form *form.Values, params *Params, v interface{}) error {
 	var body io.Reader
+	var data string
 	if form != nil && !form.Empty() {
-		data := form.Encode()
+		data = form.Encode()
 		if strings.ToUpper(method) == "GET" {
 			path += "?" + data
 		} else {
@@ -206,16 +430,42 @@ func (s BackendConfiguration) Call(method, path, key string, form *form.Values,
 		}
 	}
 
-	req, err := s.NewRequest(method, path, key, "application/x-www-form-urlencoded", body, params)
-	if err != nil {
-		return err
+	// Retries of rate limited requests have to fit in Timeout along with
+	// the first attempt
+	var deadline time.Time
+	if s.Timeout > 0 {
+		deadline = time.Now().Add(s.Timeout)
 	}
 
-	if err := s.Do(req, v); err != nil {
-		return err
-	}
+	for attempt := 0; ; attempt++ {
+		req, err := s.NewRequest(method, path, key, "application/x-www-form-urlencoded", body, params)
+		if err != nil {
+			return err
+		}
 
-	return nil
+		err = s.Do(req, v)
+		if err == nil {
+			return nil
+		}
+
+		wait, retry := s.rateLimitWait(err, attempt)
+		if retry {
+			if LogLevel > 1 {
+				Logger.Printf("Request to Stripe was rate limited, retrying in %v\n", wait)
+			}
+			retry = waitRateLimit(req.Context(), wait, deadline)
+		}
+		if !retry {
+			if s.TraceForm {
+				attachForm(err, data)
+			}
+			return err
+		}
+
+		if body != nil {
+			body = bytes.NewBufferString(data)
+		}
+	}
 }
 
 // CallMultipart is the Backend.CallMultipart implementation for invoking Stripe APIs.
@@ -234,6 +484,63 @@ func (s BackendConfiguration) CallMultipart(method, path, key, boundary string,
 	return nil
 }
 
+// CallStreaming is the StreamingBackend.CallStreaming implementation for
+// downloading the raw contents of a file from Stripe.
+func (s BackendConfiguration) CallStreaming(method, path, key string, form *form.Values, params *Params) (io.ReadCloser, error) {
+	var body io.Reader
+	var data string
+	if form != nil && !form.Empty() {
+		data = form.Encode()
+		if strings.ToUpper(method) == "GET" {
+			path += "?" + data
+		} else {
+			body = bytes.NewBufferString(data)
+		}
+	}
+
+	req, err := s.NewRequest(method, path, key, "application/x-www-form-urlencoded", body, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if LogLevel > 1 {
+		Logger.Printf("Requesting %v %v%v\n", req.Method, req.URL.Host, req.URL.Path)
+	}
+
+	start := time.Now()
+
+	res, err := s.do(req)
+	if err != nil {
+		if LogLevel > 0 {
+			Logger.Printf("Request to Stripe failed: %v\n", err)
+		}
+		s.observe(req, nil, err, start)
+		return nil, err
+	}
+
+	if res.StatusCode >= 400 {
+		defer res.Body.Close()
+
+		resBody, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			s.observe(req, res, err, start)
+			return nil, err
+		}
+
+		err = s.ResponseToError(res, resBody)
+		s.observe(req, res, err, start)
+		if s.TraceForm {
+			attachForm(err, data)
+		}
+		return nil, err
+	}
+
+	// The duration of streamed responses doesn't include reading them,
+	// which is left to the caller.
+	s.observe(req, res, nil, start)
+	return res.Body, nil
+}
+
 // NewRequest is used by Call to generate an http.Request. It handles encoding
 // parameters and attaching the appropriate headers.
 func (s *BackendConfiguration) NewRequest(method, path, key, contentType string, body io.Reader, params *Params) (*http.Request, error) {
@@ -241,7 +548,7 @@ func (s *BackendConfiguration) NewRequest(method, path, key, contentType string,
 		path = "/" + path
 	}
 
-	path = s.URL + path
+	path = s.baseURL() + path
 
 	req, err := http.NewRequest(method, path, body)
 	if err != nil {
@@ -254,10 +561,16 @@ func (s *BackendConfiguration) NewRequest(method, path, key, contentType string,
 	authorization := "Bearer " + key
 
 	req.Header.Add("Authorization", authorization)
-	req.Header.Add("Stripe-Version", apiversion)
+	req.Header.Add("Stripe-Version", s.stripeVersion(params))
+	configMu.RLock()
 	req.Header.Add("User-Agent", encodedUserAgent)
 	req.Header.Add("Content-Type", contentType)
 	req.Header.Add("X-Stripe-Client-User-Agent", encodedStripeUserAgent)
+	configMu.RUnlock()
+
+	if s.EnableTelemetry {
+		addTelemetryHeader(req)
+	}
 
 	if params != nil {
 		if idempotency := strings.TrimSpace(params.IdempotencyKey); idempotency != "" {
@@ -270,12 +583,12 @@ func (s *BackendConfiguration) NewRequest(method, path, key, contentType string,
 
 		// Support the value of the old Account field for now.
 		if account := strings.TrimSpace(params.Account); account != "" {
-			req.Header.Add("Stripe-Account", account)
+			req.Header.Set("Stripe-Account", account)
 		}
 
-		// But prefer StripeAccount.
+		// But prefer StripeAccount. Only one account can be sent per request.
 		if stripeAccount := strings.TrimSpace(params.StripeAccount); stripeAccount != "" {
-			req.Header.Add("Stripe-Account", stripeAccount)
+			req.Header.Set("Stripe-Account", stripeAccount)
 		}
 
 		for k, v := range params.Headers {
@@ -288,17 +601,32 @@ func (s *BackendConfiguration) NewRequest(method, path, key, contentType string,
 	return req, nil
 }
 
+// stripeVersion returns the API version to send with a request made with
+// params.
+func (s *BackendConfiguration) stripeVersion(params *Params) string {
+	if params != nil {
+		if version := strings.TrimSpace(params.StripeVersion); version != "" {
+			return version
+		}
+	}
+	if s.StripeVersion != "" {
+		return s.StripeVersion
+	}
+	return APIVersion
+}
+
 // Do is used by Call to execute an API request and parse the response. It uses
 // the backend's HTTP client to execute the request and unmarshals the response
 // into v. It also handles unmarshaling errors returned by the API.
-func (s *BackendConfiguration) Do(req *http.Request, v interface{}) error {
+func (s *BackendConfiguration) Do(req *http.Request, v interface{}) (err error) {
 	if LogLevel > 1 {
 		Logger.Printf("Requesting %v %v%v\n", req.Method, req.URL.Host, req.URL.Path)
 	}
 
 	start := time.Now()
 
-	res, err := s.HTTPClient.Do(req)
+	res, err := s.do(req)
+	defer func() { s.observe(req, res, err, start) }()
 
 	if LogLevel > 2 {
 		Logger.Printf("Completed in %v\n", time.Since(start))
@@ -312,7 +640,7 @@ func (s *BackendConfiguration) Do(req *http.Request, v interface{}) error {
 	}
 	defer res.Body.Close()
 
-	resBody, err := ioutil.ReadAll(res.Body)
+	resBody, err := s.readBody(req, res)
 	if err != nil {
 		if LogLevel > 0 {
 			Logger.Printf("Cannot parse Stripe response: %v\n", err)
@@ -321,6 +649,9 @@ func (s *BackendConfiguration) Do(req *http.Request, v interface{}) error {
 	}
 
 	if res.StatusCode >= 400 {
+		if setter, ok := v.(errorResponseSetter); ok {
+			setter.setErrorResponse(newAPIResponse(res, resBody))
+		}
 		return s.ResponseToError(res, resBody)
 	}
 
@@ -329,12 +660,133 @@ func (s *BackendConfiguration) Do(req *http.Request, v interface{}) error {
 	}
 
 	if v != nil {
-		return json.Unmarshal(resBody, v)
+		err = unmarshalResponse(resBody, v)
+		if setter, ok := v.(LastResponseSetter); ok {
+			setter.SetLastResponse(newAPIResponse(res, resBody))
+		}
+		return err
 	}
 
 	return nil
 }
 
+// do sends req with the backend's HTTP client, calling the OnRequest and
+// OnResponse hooks around it.
+func (s *BackendConfiguration) do(req *http.Request) (*http.Response, error) {
+	var cancel context.CancelFunc
+	if s.Timeout > 0 {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(req.Context(), s.Timeout)
+		req = req.WithContext(ctx)
+	}
+
+	// done is run once the response has been read, or the request failed
+	done := func() {
+		if cancel != nil {
+			cancel()
+		}
+	}
+
+	if s.Limiter != nil {
+		if err := s.Limiter.acquire(req.Context()); err != nil {
+			done()
+			return nil, err
+		}
+		done = func() {
+			s.Limiter.release()
+			if cancel != nil {
+				cancel()
+			}
+		}
+	}
+
+	if s.OnRequest != nil {
+		s.OnRequest(req)
+	}
+
+	client := s.HTTPClient
+	if client == nil {
+		client = defaultHTTPClient()
+	}
+
+	start := time.Now()
+	res, err := client.Do(req)
+	duration := time.Since(start)
+
+	if err != nil {
+		done()
+	} else {
+		// The timeout must keep running and the slot of the limiter must be
+		// held until the body has been read
+		res.Body = &doneOnClose{ReadCloser: res.Body, done: done}
+	}
+
+	if s.EnableTelemetry && err == nil {
+		recordTelemetry(res, duration)
+	}
+
+	if s.OnResponse != nil {
+		s.OnResponse(res, err, duration)
+	}
+
+	return res, err
+}
+
+// baseURL returns the URL of the backend, defaulting to the URL of its type.
+func (s *BackendConfiguration) baseURL() string {
+	if s.URL != "" {
+		return s.URL
+	}
+
+	switch s.Type {
+	case APIBackend:
+		return APIURL
+	case ConnectBackend:
+		return ConnectURL
+	case FilesBackend:
+		return FilesURL
+	case UploadsBackend:
+		return UploadsURL
+	}
+	return ""
+}
+
+// doneOnClose is the body of a response that runs done once it's closed,
+// which cancels the context of the request and frees its slot in the
+// limiter of the backend.
+type doneOnClose struct {
+	io.ReadCloser
+	done func()
+	once sync.Once
+}
+
+// Close closes the body and runs done, once.
+func (b *doneOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.done)
+	return err
+}
+
+// readBody reads the body of res, enforcing the MaxResponseSize of the
+// backend.
+func (s *BackendConfiguration) readBody(req *http.Request, res *http.Response) ([]byte, error) {
+	if s.MaxResponseSize <= 0 {
+		return ioutil.ReadAll(res.Body)
+	}
+
+	// Read one byte past the limit to tell a response of exactly the maximum
+	// size from a larger one.
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, s.MaxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(body)) > s.MaxResponseSize {
+		return nil, &ResponseTooLargeError{Limit: s.MaxResponseSize, Method: req.Method, Path: req.URL.Path}
+	}
+	return body, nil
+}
+
 func (s *BackendConfiguration) ResponseToError(res *http.Response, resBody []byte) error {
 	// for some odd reason, the Erro structure doesn't unmarshal
 	// initially I thought it was because it's a struct inside of a struct
@@ -353,6 +805,24 @@ func (s *BackendConfiguration) ResponseToError(res *http.Response, resBody []byt
 		return err
 	}
 
+	// The OAuth endpoints report errors as a code with a separate
+	// description rather than as an object.
+	if code, ok := e.(string); ok {
+		oauthErr := &OAuthError{
+			Code:           OAuthErrorCode(code),
+			HTTPStatusCode: res.StatusCode,
+			RequestID:      res.Header.Get("Request-Id"),
+		}
+		if desc, ok := errMap["error_description"].(string); ok {
+			oauthErr.Desc = desc
+		}
+
+		if LogLevel > 0 {
+			Logger.Printf("Error encountered from Stripe: %v\n", oauthErr)
+		}
+		return oauthErr
+	}
+
 	root := e.(map[string]interface{})
 
 	stripeErr := &Error{
@@ -390,8 +860,12 @@ func (s *BackendConfiguration) ResponseToError(res *http.Response, resBody []byt
 
 		if declineCode, ok := root["decline_code"]; ok {
 			cardErr.DeclineCode = declineCode.(string)
+			stripeErr.DeclineCode = cardErr.DeclineCode
 		}
 
+	case ErrorTypeIdempotency:
+		stripeErr.Err = &IdempotencyError{stripeErr: stripeErr}
+
 	case ErrorTypeInvalidRequest:
 		stripeErr.Err = &InvalidRequestError{stripeErr: stripeErr}
 
@@ -402,6 +876,12 @@ func (s *BackendConfiguration) ResponseToError(res *http.Response, resBody []byt
 		stripeErr.Err = &RateLimitError{stripeErr: stripeErr}
 	}
 
+	// Rate limited requests are usually reported as invalid requests with a
+	// rate_limit code, which is only told apart by the status code.
+	if res.StatusCode == http.StatusTooManyRequests {
+		stripeErr.Err = &RateLimitError{stripeErr: stripeErr, RetryAfter: parseRetryAfter(res)}
+	}
+
 	if LogLevel > 0 {
 		Logger.Printf("Error encountered from Stripe: %v\n", stripeErr)
 	}
@@ -414,11 +894,16 @@ func SetAppInfo(info *AppInfo) {
 	if info != nil && info.Name == "" {
 		panic(fmt.Errorf("App info name cannot be empty"))
 	}
-	appInfo = info
 
 	// This is run in init, but we need to reinitialize it now that we have
 	// some app info.
-	initUserAgent()
+	userAgent, stripeUserAgent := formatUserAgents(info)
+
+	configMu.Lock()
+	defer configMu.Unlock()
+	appInfo = info
+	encodedUserAgent = userAgent
+	encodedStripeUserAgent = stripeUserAgent
 }
 
 // getUname tries to get a uname from the system, but not that hard. It tries
@@ -443,13 +928,19 @@ func getUname() string {
 }
 
 func initUserAgent() {
-	encodedUserAgent = "Stripe/v1 GoBindings/" + clientversion
-	if appInfo != nil {
-		encodedUserAgent += " " + appInfo.formatUserAgent()
+	encodedUserAgent, encodedStripeUserAgent = formatUserAgents(appInfo)
+}
+
+// formatUserAgents returns the values of the User-Agent and
+// X-Stripe-Client-User-Agent headers for the given app info.
+func formatUserAgents(info *AppInfo) (string, string) {
+	userAgent := "Stripe/v1 GoBindings/" + clientversion
+	if info != nil {
+		userAgent += " " + info.formatUserAgent()
 	}
 
 	stripeUserAgent := &stripeClientUserAgent{
-		Application:     appInfo,
+		Application:     info,
 		BindingsVersion: clientversion,
 		Language:        "go",
 		LanguageVersion: runtime.Version(),
@@ -462,5 +953,5 @@ func initUserAgent() {
 	if err != nil {
 		panic(err)
 	}
-	encodedStripeUserAgent = string(marshaled)
+	return userAgent, string(marshaled)
 }
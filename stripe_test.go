@@ -2,16 +2,40 @@ package stripe_test
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"runtime"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
 	. "github.com/stripe/stripe-go/testing"
 )
 
+func TestCallStreaming(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/quotes/qt_123/pdf", r.URL.Path)
+		w.Write([]byte("%PDF-1.4"))
+	}))
+	defer server.Close()
+
+	c := &stripe.BackendConfiguration{URL: server.URL + "/v1", HTTPClient: http.DefaultClient}
+
+	body, err := c.CallStreaming("GET", "/quotes/qt_123/pdf", "", nil, nil)
+	assert.NoError(t, err)
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4", string(data))
+}
+
 func TestCheckinUseBearerAuth(t *testing.T) {
 	c := &stripe.BackendConfiguration{URL: stripe.APIURL}
 	key := "apiKey"
@@ -22,6 +46,21 @@ func TestCheckinUseBearerAuth(t *testing.T) {
 	assert.Equal(t, "Bearer "+key, req.Header.Get("Authorization"))
 }
 
+func TestGetBackend(t *testing.T) {
+	connect := stripe.GetBackend(stripe.ConnectBackend).(stripe.BackendConfiguration)
+	assert.Equal(t, stripe.ConnectBackend, connect.Type)
+	assert.Equal(t, stripe.ConnectURL, connect.URL)
+
+	files := stripe.GetBackend(stripe.FilesBackend).(stripe.BackendConfiguration)
+	assert.Equal(t, stripe.FilesURL, files.URL)
+
+	backends := []stripe.SupportedBackend{stripe.APIBackend, stripe.ConnectBackend, stripe.FilesBackend, stripe.UploadsBackend}
+	assert.Equal(t, stripe.TotalBackends, len(backends))
+	for _, backend := range backends {
+		assert.NotNil(t, stripe.GetBackend(backend))
+	}
+}
+
 func TestIdempotencyKey(t *testing.T) {
 	c := &stripe.BackendConfiguration{URL: stripe.APIURL}
 	p := &stripe.Params{IdempotencyKey: "idempotency-key"}
@@ -32,6 +71,146 @@ func TestIdempotencyKey(t *testing.T) {
 	assert.Equal(t, "idempotency-key", req.Header.Get("Idempotency-Key"))
 }
 
+func TestLastResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Request-Id", "req_123")
+		if r.URL.Path == "/v1/charges" {
+			w.Write([]byte(`{"data":[{"id":"ch_123"}],"has_more":false}`))
+			return
+		}
+		w.Write([]byte(`{"id":"ch_123"}`))
+	}))
+	defer server.Close()
+
+	c := &stripe.BackendConfiguration{URL: server.URL + "/v1", HTTPClient: http.DefaultClient}
+
+	charge := &stripe.Charge{}
+	err := c.Call("GET", "/charges/ch_123", "", nil, nil, charge)
+	assert.NoError(t, err)
+	assert.NotNil(t, charge.LastResponse)
+	assert.Equal(t, "req_123", charge.LastResponse.RequestID)
+	assert.Equal(t, http.StatusOK, charge.LastResponse.StatusCode)
+	assert.Equal(t, `{"id":"ch_123"}`, string(charge.LastResponse.RawJSON))
+
+	list := &stripe.ChargeList{}
+	err = c.Call("GET", "/charges", "", nil, nil, list)
+	assert.NoError(t, err)
+	assert.NotNil(t, list.LastResponse)
+	assert.Equal(t, "req_123", list.LastResponse.RequestID)
+	assert.Nil(t, list.Values[0].LastResponse)
+}
+
+func TestMaxResponseSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"ch_123","description":"` + strings.Repeat("a", 100) + `"}`))
+	}))
+	defer server.Close()
+
+	c := &stripe.BackendConfiguration{URL: server.URL + "/v1", HTTPClient: http.DefaultClient, MaxResponseSize: 64}
+
+	err := c.Call("GET", "/charges/ch_123", "", nil, nil, &stripe.Charge{})
+	sizeErr, ok := err.(*stripe.ResponseTooLargeError)
+	assert.True(t, ok)
+	assert.Equal(t, int64(64), sizeErr.Limit)
+	assert.Equal(t, "/v1/charges/ch_123", sizeErr.Path)
+
+	// Streamed responses aren't limited
+	body, err := c.CallStreaming("GET", "/charges/ch_123", "", nil, nil)
+	assert.NoError(t, err)
+	defer body.Close()
+	data, err := ioutil.ReadAll(body)
+	assert.NoError(t, err)
+	assert.True(t, len(data) > 64)
+
+	c.MaxResponseSize = 1024
+	charge := &stripe.Charge{}
+	err = c.Call("GET", "/charges/ch_123", "", nil, nil, charge)
+	assert.NoError(t, err)
+	assert.Equal(t, "ch_123", charge.ID)
+}
+
+func TestRequestHooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "audit", r.Header.Get("X-Audit"))
+		w.Write([]byte(`{"id":"ch_123"}`))
+	}))
+	defer server.Close()
+
+	var statusCode int
+	var responses int
+	c := &stripe.BackendConfiguration{
+		URL:        server.URL + "/v1",
+		HTTPClient: http.DefaultClient,
+		OnRequest: func(req *http.Request) {
+			req.Header.Set("X-Audit", "audit")
+		},
+		OnResponse: func(res *http.Response, err error, d time.Duration) {
+			assert.NoError(t, err)
+			statusCode = res.StatusCode
+			responses++
+		},
+	}
+
+	charge := &stripe.Charge{}
+	err := c.Call("GET", "/charges/ch_123", "", nil, nil, charge)
+	assert.NoError(t, err)
+	assert.Equal(t, "ch_123", charge.ID)
+	assert.Equal(t, http.StatusOK, statusCode)
+
+	body, err := c.CallStreaming("GET", "/charges/ch_123", "", nil, nil)
+	assert.NoError(t, err)
+	body.Close()
+	assert.Equal(t, 2, responses)
+}
+
+func TestSetBackend(t *testing.T) {
+	original := stripe.GetBackend(stripe.ConnectBackend)
+	defer stripe.SetBackend(stripe.ConnectBackend, original)
+
+	// Backends are configured independently of each other
+	client := &http.Client{}
+	stripe.SetBackend(stripe.ConnectBackend, stripe.BackendConfiguration{
+		Type:       stripe.ConnectBackend,
+		URL:        "http://localhost:12112",
+		HTTPClient: client,
+	})
+
+	connect := stripe.GetBackend(stripe.ConnectBackend).(stripe.BackendConfiguration)
+	assert.Equal(t, "http://localhost:12112", connect.URL)
+	assert.Equal(t, client, connect.HTTPClient)
+
+	files := stripe.GetBackend(stripe.FilesBackend).(stripe.BackendConfiguration)
+	assert.Equal(t, stripe.FilesURL, files.URL)
+}
+
+func TestConcurrentConfiguration(t *testing.T) {
+	original := stripe.GetBackend(stripe.FilesBackend)
+	defer stripe.SetBackend(stripe.FilesBackend, original)
+	defer stripe.SetAppInfo(nil)
+	defer stripe.SetKey(stripe.GetKey())
+	defer stripe.SetClientID(stripe.GetClientID())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			stripe.SetBackend(stripe.FilesBackend, original)
+			stripe.SetAppInfo(&stripe.AppInfo{Name: "MyAwesomePlugin"})
+			stripe.SetKey("sk_test_123")
+			stripe.SetClientID("ca_123")
+		}()
+		go func() {
+			defer wg.Done()
+			b := stripe.GetBackend(stripe.FilesBackend).(stripe.BackendConfiguration)
+			_, err := b.NewRequest("GET", "/files", stripe.GetKey(), "", nil, nil)
+			assert.NoError(t, err)
+			stripe.GetClientID()
+		}()
+	}
+	wg.Wait()
+}
+
 func TestStripeAccount(t *testing.T) {
 	c := &stripe.BackendConfiguration{URL: stripe.APIURL}
 	p := &stripe.Params{StripeAccount: TestMerchantID}
@@ -49,6 +228,106 @@ func TestStripeAccount(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, TestMerchantID, req.Header.Get("Stripe-Account"))
+
+	// StripeAccount wins when both fields are set, and the header is only
+	// sent once.
+	p = &stripe.Params{Account: "acct_old", StripeAccount: TestMerchantID}
+
+	req, err = c.NewRequest("", "", "", "", nil, p)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{TestMerchantID}, req.Header["Stripe-Account"])
+}
+
+func TestStripeVersion(t *testing.T) {
+	c := &stripe.BackendConfiguration{URL: stripe.APIURL}
+
+	req, err := c.NewRequest("", "", "", "", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, stripe.APIVersion, req.Header.Get("Stripe-Version"))
+
+	c.StripeVersion = "2016-07-06"
+	req, err = c.NewRequest("", "", "", "", nil, &stripe.Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2016-07-06", req.Header.Get("Stripe-Version"))
+
+	// The version of the params wins over the one of the backend
+	p := &stripe.Params{StripeVersion: "2015-10-16"}
+	req, err = c.NewRequest("", "", "", "", nil, p)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2015-10-16"}, req.Header["Stripe-Version"])
+
+	lp := &stripe.ListParams{StripeVersion: "2015-10-16"}
+	req, err = c.NewRequest("", "", "", "", nil, lp.ToParams())
+	assert.NoError(t, err)
+	assert.Equal(t, "2015-10-16", req.Header.Get("Stripe-Version"))
+}
+
+func TestTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/charges/ch_slow" {
+			<-done
+		}
+		w.Write([]byte(`{"id":"ch_123"}`))
+	}))
+	defer server.Close()
+	defer close(done)
+
+	c := &stripe.BackendConfiguration{URL: server.URL + "/v1", Timeout: 10 * time.Millisecond}
+
+	err := c.Call("GET", "/charges/ch_slow", "", nil, nil, &stripe.Charge{})
+	assert.Error(t, err)
+
+	// Requests that complete in time aren't affected
+	c.Timeout = time.Minute
+	charge := &stripe.Charge{}
+	err = c.Call("GET", "/charges/ch_123", "", nil, nil, charge)
+	assert.NoError(t, err)
+	assert.Equal(t, "ch_123", charge.ID)
+}
+
+func TestDefaultURL(t *testing.T) {
+	c := &stripe.BackendConfiguration{Type: stripe.UploadsBackend}
+
+	req, err := c.NewRequest("POST", "/files", "", "", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, stripe.UploadsURL+"/files", req.URL.String())
+}
+
+func TestTraceForm(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"type":"invalid_request_error","message":"Received unknown parameter: colour","param":"colour"}}`))
+	}))
+	defer server.Close()
+
+	body := &form.Values{}
+	body.Add("colour", "blue & green")
+	body.Add("card[number]", "4242424242424242")
+	body.Add("card[cvc]", "123")
+	body.Add("bank_account[routing_number]", "110000000")
+	body.Add("client_secret", "pi_123_secret_456")
+
+	c := &stripe.BackendConfiguration{URL: server.URL + "/v1", HTTPClient: http.DefaultClient}
+
+	// Nothing is recorded by default
+	err := c.Call("POST", "/charges", "", body, nil, &stripe.Charge{})
+	invalidErr, ok := err.(*stripe.Error).Err.(*stripe.InvalidRequestError)
+	assert.True(t, ok)
+	assert.Nil(t, invalidErr.Form)
+
+	c.TraceForm = true
+	err = c.Call("POST", "/charges", "", body, nil, &stripe.Charge{})
+	invalidErr, ok = err.(*stripe.Error).Err.(*stripe.InvalidRequestError)
+	assert.True(t, ok)
+	assert.Equal(t, []stripe.FormPair{
+		{Key: "colour", Value: "blue & green"},
+		{Key: "card[number]", Value: "[REDACTED]"},
+		{Key: "card[cvc]", Value: "[REDACTED]"},
+		{Key: "bank_account[routing_number]", Value: "[REDACTED]"},
+		{Key: "client_secret", Value: "[REDACTED]"},
+	}, invalidErr.Form)
 }
 
 func TestUserAgent(t *testing.T) {
@@ -140,6 +419,26 @@ func TestStripeClientUserAgentWithAppInfo(t *testing.T) {
 	assert.Equal(t, appInfo.Version, decodedAppInfo["version"])
 }
 
+func TestResponseToOAuthError(t *testing.T) {
+	c := &stripe.BackendConfiguration{URL: stripe.ConnectURL}
+
+	res := &http.Response{
+		Header: http.Header{
+			"Request-Id": []string{"request-id"},
+		},
+		StatusCode: 400,
+	}
+
+	err := c.ResponseToError(res, []byte(`{"error":"invalid_grant","error_description":"Authorization code does not exist"}`))
+
+	oauthErr, ok := err.(*stripe.OAuthError)
+	assert.True(t, ok)
+	assert.Equal(t, stripe.OAuthErrorCode("invalid_grant"), oauthErr.Code)
+	assert.Equal(t, "Authorization code does not exist", oauthErr.Desc)
+	assert.Equal(t, "request-id", oauthErr.RequestID)
+	assert.Equal(t, 400, oauthErr.HTTPStatusCode)
+}
+
 func TestResponseToError(t *testing.T) {
 	c := &stripe.BackendConfiguration{URL: stripe.APIURL}
 
@@ -198,6 +497,7 @@ func TestResponseToError(t *testing.T) {
 	cardErr, ok := stripeErr.Err.(*stripe.CardError)
 	assert.True(t, ok)
 	assert.Equal(t, expectedDeclineCode, cardErr.DeclineCode)
+	assert.Equal(t, expectedDeclineCode, stripeErr.DeclineCode)
 }
 
 //
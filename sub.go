@@ -7,40 +7,114 @@ import (
 )
 
 // SubStatus is the list of allowed values for the subscription's status.
-// Allowed values are "trialing", "active", "past_due", "canceled", "unpaid", "all".
+// Allowed values are "trialing", "active", "past_due", "canceled", "unpaid",
+// "paused".
+// Subscriptions can also be listed with "all", which includes canceled
+// subscriptions, and "ended", which only includes canceled subscriptions.
 type SubStatus string
 
 // SubBilling is the type of billing method for this subscription's invoices.
 // Currently supported values are "send_invoice" and "charge_automatically".
 type SubBilling string
 
+// SubCancellationDetailsFeedback is the list of allowed values for the
+// feedback given by a customer canceling their subscription. Allowed values
+// are "customer_service", "low_quality", "missing_features", "other",
+// "switched_service", "too_complex", "too_expensive", "unused".
+type SubCancellationDetailsFeedback string
+
+// SubCancellationDetailsReason is the list of allowed values for the reason
+// a subscription was canceled. Allowed values are "cancellation_requested",
+// "payment_disputed", "payment_failed".
+type SubCancellationDetailsReason string
+
+// SubPauseCollectionBehavior is the list of allowed values for what happens
+// to the invoices of a subscription while its collection is paused. Allowed
+// values are "keep_as_draft", "mark_uncollectible", "void".
+type SubPauseCollectionBehavior string
+
+// SubProrationBehavior is the list of allowed values for how prorations are
+// handled when a subscription changes. Allowed values are "always_invoice",
+// "create_prorations", "none".
+type SubProrationBehavior string
+
+// SubPaymentMethodOptionsACSSDebitMandateOptionsParams is the set of
+// options of the mandates created for a subscription's ACSS debits.
+type SubPaymentMethodOptionsACSSDebitMandateOptionsParams struct {
+	TransactionType PaymentIntentPaymentMethodOptionsACSSDebitTransactionType `form:"transaction_type"`
+}
+
+// SubPaymentMethodOptionsACSSDebitParams is the set of ACSS debit-specific
+// options of the payment intents created by a subscription.
+type SubPaymentMethodOptionsACSSDebitParams struct {
+	MandateOptions     *SubPaymentMethodOptionsACSSDebitMandateOptionsParams `form:"mandate_options"`
+	VerificationMethod PaymentIntentPaymentMethodOptionsVerificationMethod   `form:"verification_method"`
+}
+
+// SubPaymentMethodOptionsCardParams is the set of card-specific options of
+// the payment intents created by a subscription.
+type SubPaymentMethodOptionsCardParams struct {
+	RequestThreeDSecure PaymentIntentPaymentMethodOptionsCardRequestThreeDSecure `form:"request_three_d_secure"`
+}
+
+// SubPaymentMethodOptionsUSBankAccountParams is the set of US bank
+// account-specific options of the payment intents created by a subscription.
+type SubPaymentMethodOptionsUSBankAccountParams struct {
+	VerificationMethod PaymentIntentPaymentMethodOptionsVerificationMethod `form:"verification_method"`
+}
+
+// SubPaymentMethodOptionsParams is the set of payment method specific
+// options of the payment intents created by a subscription.
+type SubPaymentMethodOptionsParams struct {
+	ACSSDebit     *SubPaymentMethodOptionsACSSDebitParams     `form:"acss_debit"`
+	Card          *SubPaymentMethodOptionsCardParams          `form:"card"`
+	USBankAccount *SubPaymentMethodOptionsUSBankAccountParams `form:"us_bank_account"`
+}
+
+// SubPaymentSettingsParams is the set of parameters that configure how the
+// invoices of a subscription are paid.
+type SubPaymentSettingsParams struct {
+	PaymentMethodOptions *SubPaymentMethodOptionsParams `form:"payment_method_options"`
+	PaymentMethodTypes   []string                       `form:"payment_method_types"`
+}
+
 // SubParams is the set of parameters that can be used when creating or updating a subscription.
 // For more details see https://stripe.com/docs/api#create_subscription and https://stripe.com/docs/api#update_subscription.
 type SubParams struct {
-	Params                `form:"*"`
-	Billing               SubBilling        `form:"billing"`
-	BillingCycleAnchor    int64             `form:"billing_cycle_anchor"`
-	BillingCycleAnchorNow bool              `form:"-"` // See custom AppendTo
-	Card                  *CardParams       `form:"card"`
-	Coupon                string            `form:"coupon"`
-	CouponEmpty           bool              `form:"coupon,empty"`
-	Customer              string            `form:"customer"`
-	DaysUntilDue          uint64            `form:"days_until_due"`
-	FeePercent            float64           `form:"application_fee_percent"`
-	FeePercentZero        bool              `form:"application_fee_percent,zero"`
-	Items                 []*SubItemsParams `form:"items,indexed"`
-	NoProrate             bool              `form:"prorate,invert"`
-	OnBehalfOf            string            `form:"on_behalf_of"`
-	Plan                  string            `form:"plan"`
-	ProrationDate         int64             `form:"proration_date"`
-	Quantity              uint64            `form:"quantity"`
-	QuantityZero          bool              `form:"quantity,zero"`
-	TaxPercent            float64           `form:"tax_percent"`
-	TaxPercentZero        bool              `form:"tax_percent,zero"`
-	Token                 string            `form:"card"`
-	TrialEnd              int64             `form:"trial_end"`
-	TrialEndNow           bool              `form:"-"` // See custom AppendTo
-	TrialPeriod           int64             `form:"trial_period_days"`
+	Params                      `form:"*"`
+	Billing                     SubBilling                    `form:"billing"`
+	BillingCycleAnchor          int64                         `form:"billing_cycle_anchor"`
+	BillingCycleAnchorNow       bool                          `form:"-"` // See custom AppendTo
+	BillingCycleAnchorUnchanged bool                          `form:"-"` // See custom AppendTo
+	CancelAt                    int64                         `form:"cancel_at"`
+	CancelAtPeriodEnd           *bool                         `form:"cancel_at_period_end"`
+	CancellationDetails         *SubCancellationDetailsParams `form:"cancellation_details"`
+	Card                        *CardParams                   `form:"card"`
+	Coupon                      string                        `form:"coupon"`
+	CouponEmpty                 bool                          `form:"coupon,empty"`
+	Customer                    string                        `form:"customer"`
+	DaysUntilDue                uint64                        `form:"days_until_due"`
+	DefaultTaxRates             []string                      `form:"default_tax_rates"`
+	DefaultTaxRatesEmpty        bool                          `form:"default_tax_rates,empty"`
+	FeePercent                  float64                       `form:"application_fee_percent"`
+	FeePercentZero              bool                          `form:"application_fee_percent,zero"`
+	Items                       []*SubItemsParams             `form:"items,indexed"`
+	NoProrate                   bool                          `form:"prorate,invert"`
+	OnBehalfOf                  string                        `form:"on_behalf_of"`
+	PauseCollection             *SubPauseCollectionParams     `form:"pause_collection"`
+	PauseCollectionEmpty        bool                          `form:"pause_collection,empty"`
+	PaymentSettings             *SubPaymentSettingsParams     `form:"payment_settings"`
+	Plan                        string                        `form:"plan"`
+	ProrationBehavior           SubProrationBehavior          `form:"proration_behavior"`
+	ProrationDate               int64                         `form:"proration_date"`
+	Quantity                    uint64                        `form:"quantity"`
+	QuantityZero                bool                          `form:"quantity,zero"`
+	TaxPercent                  float64                       `form:"tax_percent"`
+	TaxPercentZero              bool                          `form:"tax_percent,zero"`
+	Token                       string                        `form:"card"`
+	TrialEnd                    int64                         `form:"trial_end"`
+	TrialEndNow                 bool                          `form:"-"` // See custom AppendTo
+	TrialPeriod                 int64                         `form:"trial_period_days"`
 
 	// Used for Cancel
 
@@ -48,18 +122,51 @@ type SubParams struct {
 }
 
 // AppendTo implements custom encoding logic for SubParams so that the special
-// "now" value for billing_cycle_anchor and trial_end can be implemented
-// (they're otherwise timestamps rather than strings).
+// "now" and "unchanged" values for billing_cycle_anchor and "now" for
+// trial_end can be implemented (they're otherwise timestamps rather than
+// strings).
 func (p *SubParams) AppendTo(body *form.Values, keyParts []string) {
 	if p.BillingCycleAnchorNow {
 		body.Add(form.FormatKey(append(keyParts, "billing_cycle_anchor")), "now")
 	}
 
+	if p.BillingCycleAnchorUnchanged {
+		body.Add(form.FormatKey(append(keyParts, "billing_cycle_anchor")), "unchanged")
+	}
+
 	if p.TrialEndNow {
 		body.Add(form.FormatKey(append(keyParts, "trial_end")), "now")
 	}
 }
 
+// SubCancellationDetailsParams is the set of parameters describing why a
+// subscription was canceled.
+type SubCancellationDetailsParams struct {
+	Comment  string                         `form:"comment"`
+	Feedback SubCancellationDetailsFeedback `form:"feedback"`
+}
+
+// SubPauseCollectionParams is the set of parameters describing how the
+// collection of the payments of a subscription is paused.
+type SubPauseCollectionParams struct {
+	Behavior  SubPauseCollectionBehavior `form:"behavior"`
+	ResumesAt int64                      `form:"resumes_at"`
+}
+
+// SubResumeParams is the set of parameters that can be used when resuming a
+// paused subscription.
+// For more details see https://stripe.com/docs/api#resume_subscription.
+type SubResumeParams struct {
+	Params `form:"*"`
+
+	// BillingCycleAnchor is either "now", to start a new billing cycle when
+	// the subscription is resumed, or "unchanged".
+	BillingCycleAnchor string `form:"billing_cycle_anchor"`
+
+	ProrationBehavior SubProrationBehavior `form:"proration_behavior"`
+	ProrationDate     int64                `form:"proration_date"`
+}
+
 // SubItemsParams is the set of parameters that can be used when creating or updating a subscription item on a subscription
 // For more details see https://stripe.com/docs/api#create_subscription and https://stripe.com/docs/api#update_subscription.
 type SubItemsParams struct {
@@ -67,50 +174,85 @@ type SubItemsParams struct {
 	Deleted      bool   `form:"deleted"`
 	ID           string `form:"id"`
 	Plan         string `form:"plan"`
+	Price        string `form:"price"`
 	Quantity     uint64 `form:"quantity"`
 	QuantityZero bool   `form:"quantity,zero"`
+
+	// TaxRates replaces the tax rates applied to the subscription item. Use
+	// TaxRatesEmpty to remove all of them.
+	TaxRates      []string `form:"tax_rates"`
+	TaxRatesEmpty bool     `form:"tax_rates,empty"`
 }
 
 // SubListParams is the set of parameters that can be used when listing active subscriptions.
 // For more details see https://stripe.com/docs/api#list_subscriptions.
 type SubListParams struct {
-	ListParams   `form:"*"`
-	Billing      SubBilling        `form:"billing"`
-	Created      int64             `form:"created"`
-	CreatedRange *RangeQueryParams `form:"created"`
-	Customer     string            `form:"customer"`
-	Plan         string            `form:"plan"`
-	Status       SubStatus         `form:"status"`
+	ListParams              `form:"*"`
+	Billing                 SubBilling        `form:"billing"`
+	CollectionMethod        SubBilling        `form:"collection_method"`
+	Created                 int64             `form:"created"`
+	CreatedRange            *RangeQueryParams `form:"created"`
+	CurrentPeriodEnd        int64             `form:"current_period_end"`
+	CurrentPeriodEndRange   *RangeQueryParams `form:"current_period_end"`
+	CurrentPeriodStart      int64             `form:"current_period_start"`
+	CurrentPeriodStartRange *RangeQueryParams `form:"current_period_start"`
+	Customer                string            `form:"customer"`
+	Plan                    string            `form:"plan"`
+	Price                   string            `form:"price"`
+	Status                  SubStatus         `form:"status"`
 }
 
 // Sub is the resource representing a Stripe subscription.
 // For more details see https://stripe.com/docs/api#subscriptions.
 type Sub struct {
-	Billing      SubBilling        `json:"billing"`
-	Canceled     int64             `json:"canceled_at"`
-	Created      int64             `json:"created"`
-	Customer     *Customer         `json:"customer"`
-	DaysUntilDue uint64            `json:"days_until_due"`
-	Discount     *Discount         `json:"discount"`
-	EndCancel    bool              `json:"cancel_at_period_end"`
-	Ended        int64             `json:"ended_at"`
-	FeePercent   float64           `json:"application_fee_percent"`
-	ID           string            `json:"id"`
-	Items        *SubItemList      `json:"items"`
-	Meta         map[string]string `json:"metadata"`
-	PeriodEnd    int64             `json:"current_period_end"`
-	PeriodStart  int64             `json:"current_period_start"`
-	Plan         *Plan             `json:"plan"`
-	Quantity     uint64            `json:"quantity"`
-	Start        int64             `json:"start"`
-	Status       SubStatus         `json:"status"`
-	TaxPercent   float64           `json:"tax_percent"`
-	TrialEnd     int64             `json:"trial_end"`
-	TrialStart   int64             `json:"trial_start"`
+	APIResource
+	Billing             SubBilling              `json:"billing"`
+	CancelAt            Timestamp               `json:"cancel_at"`
+	Canceled            Timestamp               `json:"canceled_at"`
+	CancellationDetails *SubCancellationDetails `json:"cancellation_details"`
+	Created             Timestamp               `json:"created"`
+	Customer            *Customer               `json:"customer"`
+	DaysUntilDue        uint64                  `json:"days_until_due"`
+	DefaultTaxRates     []*TaxRate              `json:"default_tax_rates"`
+	Discount            *Discount               `json:"discount"`
+	Discounts           []*Discount             `json:"discounts"`
+	EndCancel           bool                    `json:"cancel_at_period_end"`
+	Ended               Timestamp               `json:"ended_at"`
+	FeePercent          float64                 `json:"application_fee_percent"`
+	ID                  string                  `json:"id"`
+	Items               *SubItemList            `json:"items"`
+	Meta                map[string]string       `json:"metadata"`
+	PauseCollection     *SubPauseCollection     `json:"pause_collection"`
+	PeriodEnd           Timestamp               `json:"current_period_end"`
+	PeriodStart         Timestamp               `json:"current_period_start"`
+	Plan                *Plan                   `json:"plan"`
+	Quantity            uint64                  `json:"quantity"`
+	Schedule            *SubscriptionSchedule   `json:"schedule"`
+	Start               Timestamp               `json:"start"`
+	Status              SubStatus               `json:"status"`
+	TaxPercent          float64                 `json:"tax_percent"`
+	TrialEnd            Timestamp               `json:"trial_end"`
+	TrialStart          Timestamp               `json:"trial_start"`
+}
+
+// SubCancellationDetails describes why a subscription was canceled. Reason
+// is set by Stripe while Comment and Feedback are given by the customer.
+type SubCancellationDetails struct {
+	Comment  string                         `json:"comment"`
+	Feedback SubCancellationDetailsFeedback `json:"feedback"`
+	Reason   SubCancellationDetailsReason   `json:"reason"`
+}
+
+// SubPauseCollection describes how the collection of the payments of a
+// subscription is paused. ResumesAt is 0 when the pause has no end.
+type SubPauseCollection struct {
+	Behavior  SubPauseCollectionBehavior `json:"behavior"`
+	ResumesAt Timestamp                  `json:"resumes_at"`
 }
 
 // SubList is a list object for subscriptions.
 type SubList struct {
+	APIResource
 	ListMeta
 	Values []*Sub `json:"data"`
 }
@@ -119,15 +261,18 @@ type SubList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (s *Sub) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		s.ID = id
+		return nil
+	}
+
 	type sub Sub
 	var ss sub
 	err := json.Unmarshal(data, &ss)
-	if err == nil {
-		*s = Sub(ss)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		s.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*s = Sub(ss)
 	return nil
 }
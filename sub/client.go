@@ -14,7 +14,32 @@ const (
 	PastDue  stripe.SubStatus = "past_due"
 	Canceled stripe.SubStatus = "canceled"
 	Unpaid   stripe.SubStatus = "unpaid"
+	Paused   stripe.SubStatus = "paused"
 	All      stripe.SubStatus = "all"
+	Ended    stripe.SubStatus = "ended"
+)
+
+const (
+	CancellationDetailsFeedbackCustomerService stripe.SubCancellationDetailsFeedback = "customer_service"
+	CancellationDetailsFeedbackLowQuality      stripe.SubCancellationDetailsFeedback = "low_quality"
+	CancellationDetailsFeedbackMissingFeatures stripe.SubCancellationDetailsFeedback = "missing_features"
+	CancellationDetailsFeedbackOther           stripe.SubCancellationDetailsFeedback = "other"
+	CancellationDetailsFeedbackSwitchedService stripe.SubCancellationDetailsFeedback = "switched_service"
+	CancellationDetailsFeedbackTooComplex      stripe.SubCancellationDetailsFeedback = "too_complex"
+	CancellationDetailsFeedbackTooExpensive    stripe.SubCancellationDetailsFeedback = "too_expensive"
+	CancellationDetailsFeedbackUnused          stripe.SubCancellationDetailsFeedback = "unused"
+
+	CancellationDetailsReasonCancellationRequested stripe.SubCancellationDetailsReason = "cancellation_requested"
+	CancellationDetailsReasonPaymentDisputed       stripe.SubCancellationDetailsReason = "payment_disputed"
+	CancellationDetailsReasonPaymentFailed         stripe.SubCancellationDetailsReason = "payment_failed"
+
+	PauseCollectionBehaviorKeepAsDraft       stripe.SubPauseCollectionBehavior = "keep_as_draft"
+	PauseCollectionBehaviorMarkUncollectible stripe.SubPauseCollectionBehavior = "mark_uncollectible"
+	PauseCollectionBehaviorVoid              stripe.SubPauseCollectionBehavior = "void"
+
+	ProrationBehaviorAlwaysInvoice    stripe.SubProrationBehavior = "always_invoice"
+	ProrationBehaviorCreateProrations stripe.SubProrationBehavior = "create_prorations"
+	ProrationBehaviorNone             stripe.SubProrationBehavior = "none"
 )
 
 // Client is used to invoke /subscriptions APIs.
@@ -111,6 +136,30 @@ func (c Client) Cancel(id string, params *stripe.SubParams) (*stripe.Sub, error)
 	return sub, err
 }
 
+// Resume resumes a paused subscription, which starts invoicing the customer
+// again. Subscriptions whose payment collection is paused are still active
+// and are resumed by updating them with PauseCollectionEmpty instead.
+// For more details see https://stripe.com/docs/api#resume_subscription.
+func Resume(id string, params *stripe.SubResumeParams) (*stripe.Sub, error) {
+	return getC().Resume(id, params)
+}
+
+func (c Client) Resume(id string, params *stripe.SubResumeParams) (*stripe.Sub, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	sub := &stripe.Sub{}
+	err := c.B.Call("POST", fmt.Sprintf("/subscriptions/%v/resume", id), c.Key, body, commonParams, sub)
+
+	return sub, err
+}
+
 // List returns a list of subscriptions.
 // For more details see https://stripe.com/docs/api#list_subscriptions.
 func List(params *stripe.SubListParams) *Iter {
@@ -156,5 +205,5 @@ func (i *Iter) Sub() *stripe.Sub {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -6,7 +6,9 @@ import (
 
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/paymentintent"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestSubCancel(t *testing.T) {
@@ -15,6 +17,25 @@ func TestSubCancel(t *testing.T) {
 	assert.NotNil(t, subscription)
 }
 
+func TestSubCancelWithDetails(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("DELETE", "/subscriptions/sub_123", `{"id": "sub_123", "status": "canceled"}`)
+
+	c := Client{B: b, Key: "sk_test_123"}
+	subscription, err := c.Cancel("sub_123", &stripe.SubParams{
+		CancellationDetails: &stripe.SubCancellationDetailsParams{
+			Comment:  "Moving to another provider",
+			Feedback: CancellationDetailsFeedbackSwitchedService,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, Canceled, subscription.Status)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "Moving to another provider", values.Get("cancellation_details[comment]"))
+	assert.Equal(t, "switched_service", values.Get("cancellation_details[feedback]"))
+}
+
 func TestSubGet(t *testing.T) {
 	subscription, err := Get("sub_123", nil)
 	assert.Nil(t, err)
@@ -30,6 +51,34 @@ func TestSubList(t *testing.T) {
 	assert.NotNil(t, i.Sub())
 }
 
+func TestSubListFilters(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/subscriptions", `{"object": "list", "data": [{"id": "sub_123"}]}`)
+
+	c := Client{B: b, Key: "sk_test_123"}
+	i := c.List(&stripe.SubListParams{
+		CollectionMethod: "send_invoice",
+		CurrentPeriodEndRange: &stripe.RangeQueryParams{
+			LesserThan: 1548979200,
+		},
+		CurrentPeriodStartRange: &stripe.RangeQueryParams{
+			GreaterThanOrEqual: 1546300800,
+		},
+		Price:  "price_123",
+		Status: Ended,
+	})
+	assert.True(t, i.Next())
+	assert.NoError(t, i.Err())
+	assert.Equal(t, "sub_123", i.Sub().ID)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "send_invoice", values.Get("collection_method"))
+	assert.Equal(t, "1548979200", values.Get("current_period_end[lt]"))
+	assert.Equal(t, "1546300800", values.Get("current_period_start[gte]"))
+	assert.Equal(t, "price_123", values.Get("price"))
+	assert.Equal(t, "ended", values.Get("status"))
+}
+
 func TestSubNew(t *testing.T) {
 	subscription, err := New(&stripe.SubParams{
 		Customer:           "cus_123",
@@ -58,6 +107,59 @@ func TestSubNew_WithItems(t *testing.T) {
 	assert.NotNil(t, subscription)
 }
 
+func TestSubNew_PaymentSettings(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/subscriptions", `{"id": "sub_123"}`)
+
+	_, err := Client{B: b}.New(&stripe.SubParams{
+		Customer: "cus_123",
+		PaymentSettings: &stripe.SubPaymentSettingsParams{
+			PaymentMethodOptions: &stripe.SubPaymentMethodOptionsParams{
+				Card: &stripe.SubPaymentMethodOptionsCardParams{
+					RequestThreeDSecure: paymentintent.CardRequestThreeDSecureAny,
+				},
+				USBankAccount: &stripe.SubPaymentMethodOptionsUSBankAccountParams{
+					VerificationMethod: paymentintent.VerificationMethodInstant,
+				},
+			},
+			PaymentMethodTypes: []string{"card", "us_bank_account"},
+		},
+	})
+	assert.Nil(t, err)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "any", values.Get("payment_settings[payment_method_options][card][request_three_d_secure]"))
+	assert.Equal(t, "instant", values.Get("payment_settings[payment_method_options][us_bank_account][verification_method]"))
+	assert.Equal(t, []string{"card", "us_bank_account"}, values["payment_settings[payment_method_types][]"])
+}
+
+func TestSubResume(t *testing.T) {
+	subscription, err := Resume("sub_123", &stripe.SubResumeParams{
+		BillingCycleAnchor: "now",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, subscription)
+}
+
+func TestSubUpdateProration(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/subscriptions/sub_123", `{"id": "sub_123"}`)
+
+	c := Client{B: b, Key: "sk_test_123"}
+	_, err := c.Update("sub_123", &stripe.SubParams{
+		BillingCycleAnchorUnchanged: true,
+		Plan:                        "gold",
+		ProrationBehavior:           ProrationBehaviorAlwaysInvoice,
+		ProrationDate:               1546300800,
+	})
+	assert.NoError(t, err)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "unchanged", values.Get("billing_cycle_anchor"))
+	assert.Equal(t, "always_invoice", values.Get("proration_behavior"))
+	assert.Equal(t, "1546300800", values.Get("proration_date"))
+}
+
 func TestSubUpdate(t *testing.T) {
 	subscription, err := Update("sub_123", &stripe.SubParams{
 		NoProrate:      true,
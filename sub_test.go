@@ -1,6 +1,7 @@
 package stripe
 
 import (
+	"encoding/json"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
@@ -16,6 +17,14 @@ func TestSubParams_AppendTo(t *testing.T) {
 		assert.Equal(t, []string{"now"}, body.Get("billing_cycle_anchor"))
 	}
 
+	{
+		params := &SubParams{BillingCycleAnchorUnchanged: true}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"unchanged"}, body.Get("billing_cycle_anchor"))
+	}
+
 	{
 		params := &SubParams{TrialEndNow: true}
 		body := &form.Values{}
@@ -24,3 +33,75 @@ func TestSubParams_AppendTo(t *testing.T) {
 		assert.Equal(t, []string{"now"}, body.Get("trial_end"))
 	}
 }
+
+func TestSubParams_AppendTo_PauseCollection(t *testing.T) {
+	{
+		params := &SubParams{
+			PauseCollection: &SubPauseCollectionParams{
+				Behavior:  "void",
+				ResumesAt: 1546300800,
+			},
+		}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"void"}, body.Get("pause_collection[behavior]"))
+		assert.Equal(t, []string{"1546300800"}, body.Get("pause_collection[resumes_at]"))
+	}
+
+	{
+		params := &SubParams{PauseCollectionEmpty: true}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{""}, body.Get("pause_collection"))
+	}
+}
+
+func TestSubParams_AppendTo_TaxRates(t *testing.T) {
+	{
+		params := &SubParams{
+			DefaultTaxRates: []string{"txr_123", "txr_456"},
+			Items: []*SubItemsParams{
+				{Plan: "gold", TaxRates: []string{"txr_789"}},
+			},
+		}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"txr_123", "txr_456"}, body.Get("default_tax_rates[]"))
+		assert.Equal(t, []string{"txr_789"}, body.Get("items[0][tax_rates][]"))
+	}
+
+	{
+		params := &SubParams{DefaultTaxRatesEmpty: true}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{""}, body.Get("default_tax_rates"))
+	}
+}
+
+func TestSubUnmarshalCancellationDetails(t *testing.T) {
+	data := []byte(`{
+		"id": "sub_123",
+		"cancel_at": 1546300800,
+		"cancellation_details": {
+			"comment": "Too many features I don't use",
+			"feedback": "unused",
+			"reason": "cancellation_requested"
+		},
+		"pause_collection": {"behavior": "keep_as_draft", "resumes_at": null}
+	}`)
+
+	var sub Sub
+	err := json.Unmarshal(data, &sub)
+	assert.NoError(t, err)
+
+	assert.Equal(t, Timestamp(1546300800), sub.CancelAt)
+	assert.Equal(t, "Too many features I don't use", sub.CancellationDetails.Comment)
+	assert.Equal(t, SubCancellationDetailsFeedback("unused"), sub.CancellationDetails.Feedback)
+	assert.Equal(t, SubCancellationDetailsReason("cancellation_requested"), sub.CancellationDetails.Reason)
+	assert.Equal(t, SubPauseCollectionBehavior("keep_as_draft"), sub.PauseCollection.Behavior)
+	assert.Equal(t, Timestamp(0), sub.PauseCollection.ResumesAt)
+}
@@ -3,14 +3,21 @@ package stripe
 // SubItemParams is the set of parameters that can be used when creating or updating a subscription item.
 // For more details see https://stripe.com/docs/api#create_subscription_item and https://stripe.com/docs/api#update_subscription_item.
 type SubItemParams struct {
-	Params        `form:"*"`
-	ID            string `form:"-"` // Handled in URL
-	NoProrate     bool   `form:"prorate,invert"`
-	Plan          string `form:"plan"`
-	ProrationDate int64  `form:"proration_date"`
-	Quantity      uint64 `form:"quantity"`
-	QuantityZero  bool   `form:"quantity,zero"`
-	Sub           string `form:"subscription"`
+	Params            `form:"*"`
+	ID                string               `form:"-"` // Handled in URL
+	NoProrate         bool                 `form:"prorate,invert"`
+	Plan              string               `form:"plan"`
+	Price             string               `form:"price"`
+	ProrationBehavior SubProrationBehavior `form:"proration_behavior"`
+	ProrationDate     int64                `form:"proration_date"`
+	Quantity          uint64               `form:"quantity"`
+	QuantityZero      bool                 `form:"quantity,zero"`
+	Sub               string               `form:"subscription"`
+
+	// TaxRates replaces the tax rates applied to the subscription item. Use
+	// TaxRatesEmpty to remove all of them.
+	TaxRates      []string `form:"tax_rates"`
+	TaxRatesEmpty bool     `form:"tax_rates,empty"`
 }
 
 // SubItemListParams is the set of parameters that can be used when listing invoice items.
@@ -23,16 +30,20 @@ type SubItemListParams struct {
 // SubItem is the resource representing a Stripe subscription item.
 // For more details see https://stripe.com/docs/api#subscription_items.
 type SubItem struct {
-	Created  int64             `json:"created"`
+	APIResource
+	Created  Timestamp         `json:"created"`
 	Deleted  bool              `json:"deleted"`
 	ID       string            `json:"id"`
 	Meta     map[string]string `json:"metadata"`
 	Plan     *Plan             `json:"plan"`
+	Price    *Price            `json:"price"`
 	Quantity uint64            `json:"quantity"`
+	TaxRates []*TaxRate        `json:"tax_rates"`
 }
 
 // SubItemList is a list of invoice items as retrieved from a list endpoint.
 type SubItemList struct {
+	APIResource
 	ListMeta
 	Values []*SubItem `json:"data"`
 }
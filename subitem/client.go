@@ -148,5 +148,5 @@ func (i *Iter) SubItem() *stripe.SubItem {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
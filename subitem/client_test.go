@@ -6,6 +6,7 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
 func TestSubItemDel(t *testing.T) {
@@ -46,3 +47,16 @@ func TestSubItemUpdate(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, item)
 }
+
+func TestSubItemUpdateProrationBehavior(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/subscription_items/si_123", `{"id": "si_123"}`)
+
+	item, err := Client{B: b}.Update("si_123", &stripe.SubItemParams{
+		ProrationBehavior: "none",
+		Quantity:          10,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "si_123", item.ID)
+	assert.Equal(t, "none", b.LastCall().Values().Get("proration_behavior"))
+}
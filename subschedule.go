@@ -0,0 +1,163 @@
+package stripe
+
+import (
+	"encoding/json"
+
+	"github.com/stripe/stripe-go/form"
+)
+
+// SubscriptionScheduleEndBehavior describe what happens to a schedule when it
+// ends. Allowed values are "cancel", "none" and "release".
+type SubscriptionScheduleEndBehavior string
+
+// SubscriptionScheduleStatus is the list of allowed values for the schedule's
+// status. Allowed values are "active", "canceled", "completed",
+// "not_started", and "released".
+type SubscriptionScheduleStatus string
+
+// SubscriptionScheduleParams is the set of parameters that can be used when
+// creating or updating a subscription schedule.
+// For more details see https://stripe.com/docs/api#create_subscription_schedule
+// and https://stripe.com/docs/api#update_subscription_schedule.
+type SubscriptionScheduleParams struct {
+	Params           `form:"*"`
+	Customer         string                             `form:"customer"`
+	EndBehavior      SubscriptionScheduleEndBehavior    `form:"end_behavior"`
+	FromSubscription string                             `form:"from_subscription"`
+	NoProrate        bool                               `form:"prorate,invert"`
+	Phases           []*SubscriptionSchedulePhaseParams `form:"phases,indexed"`
+	StartDate        int64                              `form:"start_date"`
+	StartDateNow     bool                               `form:"-"` // See custom AppendTo
+}
+
+// AppendTo implements custom encoding logic for SubscriptionScheduleParams
+// so that the special "now" value for start_date can be implemented (it's
+// otherwise a timestamp rather than a string).
+func (p *SubscriptionScheduleParams) AppendTo(body *form.Values, keyParts []string) {
+	if p.StartDateNow {
+		body.Add(form.FormatKey(append(keyParts, "start_date")), "now")
+	}
+}
+
+// SubscriptionSchedulePhaseParams is the set of parameters that describe a
+// single phase of a subscription schedule.
+type SubscriptionSchedulePhaseParams struct {
+	Coupon         string                                 `form:"coupon"`
+	EndDate        int64                                  `form:"end_date"`
+	Iterations     uint64                                 `form:"iterations"`
+	Plans          []*SubscriptionSchedulePhaseItemParams `form:"plans,indexed"`
+	NoProrate      bool                                   `form:"prorate,invert"`
+	TaxPercent     float64                                `form:"tax_percent"`
+	TaxPercentZero bool                                   `form:"tax_percent,zero"`
+	TrialEnd       int64                                  `form:"trial_end"`
+}
+
+// SubscriptionSchedulePhaseItemParams is the set of parameters that describe
+// a plan that's subscribed to during a phase of a subscription schedule.
+type SubscriptionSchedulePhaseItemParams struct {
+	Plan         string `form:"plan"`
+	Price        string `form:"price"`
+	Quantity     uint64 `form:"quantity"`
+	QuantityZero bool   `form:"quantity,zero"`
+}
+
+// SubscriptionScheduleCancelParams is the set of parameters that can be used
+// when canceling a subscription schedule.
+// For more details see https://stripe.com/docs/api#cancel_subscription_schedule.
+type SubscriptionScheduleCancelParams struct {
+	Params     `form:"*"`
+	InvoiceNow bool `form:"invoice_now"`
+	NoProrate  bool `form:"prorate,invert"`
+}
+
+// SubscriptionScheduleReleaseParams is the set of parameters that can be used
+// when releasing a subscription schedule.
+// For more details see https://stripe.com/docs/api#release_subscription_schedule.
+type SubscriptionScheduleReleaseParams struct {
+	Params             `form:"*"`
+	PreserveCancelDate bool `form:"preserve_cancel_date"`
+}
+
+// SubscriptionScheduleListParams is the set of parameters that can be used
+// when listing subscription schedules.
+// For more details see https://stripe.com/docs/api#list_subscription_schedules.
+type SubscriptionScheduleListParams struct {
+	ListParams   `form:"*"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Customer     string            `form:"customer"`
+	Scheduled    bool              `form:"scheduled"`
+}
+
+// SubscriptionScheduleCurrentPhase contains the start and end dates of the
+// phase that a schedule is currently in.
+type SubscriptionScheduleCurrentPhase struct {
+	EndDate   Timestamp `json:"end_date"`
+	StartDate Timestamp `json:"start_date"`
+}
+
+// SubscriptionSchedulePhaseItem is a plan that's subscribed to during a phase
+// of a subscription schedule.
+type SubscriptionSchedulePhaseItem struct {
+	Plan     *Plan  `json:"plan"`
+	Quantity uint64 `json:"quantity"`
+}
+
+// SubscriptionSchedulePhase is a single phase of a subscription schedule.
+type SubscriptionSchedulePhase struct {
+	Coupon     *Coupon                          `json:"coupon"`
+	EndDate    Timestamp                        `json:"end_date"`
+	Plans      []*SubscriptionSchedulePhaseItem `json:"plans"`
+	StartDate  Timestamp                        `json:"start_date"`
+	TaxPercent float64                          `json:"tax_percent"`
+	TrialEnd   Timestamp                        `json:"trial_end"`
+}
+
+// SubscriptionSchedule is the resource representing a Stripe subscription
+// schedule.
+// For more details see https://stripe.com/docs/api#subscription_schedules.
+type SubscriptionSchedule struct {
+	APIResource
+	CanceledAt           Timestamp                         `json:"canceled_at"`
+	CompletedAt          Timestamp                         `json:"completed_at"`
+	Created              Timestamp                         `json:"created"`
+	CurrentPhase         *SubscriptionScheduleCurrentPhase `json:"current_phase"`
+	Customer             *Customer                         `json:"customer"`
+	EndBehavior          SubscriptionScheduleEndBehavior   `json:"end_behavior"`
+	ID                   string                            `json:"id"`
+	Live                 bool                              `json:"livemode"`
+	Meta                 map[string]string                 `json:"metadata"`
+	Phases               []*SubscriptionSchedulePhase      `json:"phases"`
+	ReleasedAt           Timestamp                         `json:"released_at"`
+	ReleasedSubscription string                            `json:"released_subscription"`
+	Status               SubscriptionScheduleStatus        `json:"status"`
+	Subscription         *Sub                              `json:"subscription"`
+}
+
+// SubscriptionScheduleList is a list of subscription schedules as retrieved
+// from a list endpoint.
+type SubscriptionScheduleList struct {
+	APIResource
+	ListMeta
+	Values []*SubscriptionSchedule `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a SubscriptionSchedule.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (s *SubscriptionSchedule) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		s.ID = id
+		return nil
+	}
+
+	type schedule SubscriptionSchedule
+	var ss schedule
+	err := json.Unmarshal(data, &ss)
+	if err != nil {
+		return err
+	}
+
+	*s = SubscriptionSchedule(ss)
+	return nil
+}
@@ -0,0 +1,186 @@
+// Package subschedule provides the /subscription_schedules APIs
+package subschedule
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	EndBehaviorCancel  stripe.SubscriptionScheduleEndBehavior = "cancel"
+	EndBehaviorNone    stripe.SubscriptionScheduleEndBehavior = "none"
+	EndBehaviorRelease stripe.SubscriptionScheduleEndBehavior = "release"
+
+	Active     stripe.SubscriptionScheduleStatus = "active"
+	Canceled   stripe.SubscriptionScheduleStatus = "canceled"
+	Completed  stripe.SubscriptionScheduleStatus = "completed"
+	NotStarted stripe.SubscriptionScheduleStatus = "not_started"
+	Released   stripe.SubscriptionScheduleStatus = "released"
+)
+
+// Client is used to invoke /subscription_schedules APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new subscription schedule.
+// For more details see https://stripe.com/docs/api#create_subscription_schedule.
+func New(params *stripe.SubscriptionScheduleParams) (*stripe.SubscriptionSchedule, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.SubscriptionScheduleParams) (*stripe.SubscriptionSchedule, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	schedule := &stripe.SubscriptionSchedule{}
+	err := c.B.Call("POST", "/subscription_schedules", c.Key, body, commonParams, schedule)
+
+	return schedule, err
+}
+
+// Get returns the details of a subscription schedule.
+// For more details see https://stripe.com/docs/api#retrieve_subscription_schedule.
+func Get(id string, params *stripe.SubscriptionScheduleParams) (*stripe.SubscriptionSchedule, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.SubscriptionScheduleParams) (*stripe.SubscriptionSchedule, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	schedule := &stripe.SubscriptionSchedule{}
+	err := c.B.Call("GET", fmt.Sprintf("/subscription_schedules/%v", id), c.Key, body, commonParams, schedule)
+
+	return schedule, err
+}
+
+// Update updates a subscription schedule's properties.
+// For more details see https://stripe.com/docs/api#update_subscription_schedule.
+func Update(id string, params *stripe.SubscriptionScheduleParams) (*stripe.SubscriptionSchedule, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.SubscriptionScheduleParams) (*stripe.SubscriptionSchedule, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	schedule := &stripe.SubscriptionSchedule{}
+	err := c.B.Call("POST", fmt.Sprintf("/subscription_schedules/%v", id), c.Key, body, commonParams, schedule)
+
+	return schedule, err
+}
+
+// Cancel cancels a subscription schedule and its associated subscription.
+// For more details see https://stripe.com/docs/api#cancel_subscription_schedule.
+func Cancel(id string, params *stripe.SubscriptionScheduleCancelParams) (*stripe.SubscriptionSchedule, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.SubscriptionScheduleCancelParams) (*stripe.SubscriptionSchedule, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	schedule := &stripe.SubscriptionSchedule{}
+	err := c.B.Call("POST", fmt.Sprintf("/subscription_schedules/%v/cancel", id), c.Key, body, commonParams, schedule)
+
+	return schedule, err
+}
+
+// Release releases a subscription schedule, leaving its subscription in
+// place but no longer managed by the schedule.
+// For more details see https://stripe.com/docs/api#release_subscription_schedule.
+func Release(id string, params *stripe.SubscriptionScheduleReleaseParams) (*stripe.SubscriptionSchedule, error) {
+	return getC().Release(id, params)
+}
+
+func (c Client) Release(id string, params *stripe.SubscriptionScheduleReleaseParams) (*stripe.SubscriptionSchedule, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	schedule := &stripe.SubscriptionSchedule{}
+	err := c.B.Call("POST", fmt.Sprintf("/subscription_schedules/%v/release", id), c.Key, body, commonParams, schedule)
+
+	return schedule, err
+}
+
+// List returns a list of subscription schedules.
+// For more details see https://stripe.com/docs/api#list_subscription_schedules.
+func List(params *stripe.SubscriptionScheduleListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.SubscriptionScheduleListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.SubscriptionScheduleList{}
+		err := c.B.Call("GET", "/subscription_schedules", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of SubscriptionSchedules.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// SubscriptionSchedule returns the most recent SubscriptionSchedule
+// visited by a call to Next.
+func (i *Iter) SubscriptionSchedule() *stripe.SubscriptionSchedule {
+	return i.Current().(*stripe.SubscriptionSchedule)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
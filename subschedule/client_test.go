@@ -0,0 +1,68 @@
+package subschedule
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestSubscriptionScheduleCancel(t *testing.T) {
+	schedule, err := Cancel("sub_sched_123", &stripe.SubscriptionScheduleCancelParams{
+		InvoiceNow: true,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, schedule)
+}
+
+func TestSubscriptionScheduleGet(t *testing.T) {
+	schedule, err := Get("sub_sched_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, schedule)
+}
+
+func TestSubscriptionScheduleList(t *testing.T) {
+	i := List(&stripe.SubscriptionScheduleListParams{})
+
+	// Verify that we can get at least one schedule
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.SubscriptionSchedule())
+}
+
+func TestSubscriptionScheduleNew(t *testing.T) {
+	schedule, err := New(&stripe.SubscriptionScheduleParams{
+		Customer:     "cus_123",
+		StartDateNow: true,
+		Phases: []*stripe.SubscriptionSchedulePhaseParams{
+			{
+				Iterations: 3,
+				Plans: []*stripe.SubscriptionSchedulePhaseItemParams{
+					{Plan: "plan_intro", Quantity: 1},
+				},
+			},
+			{
+				Plans: []*stripe.SubscriptionSchedulePhaseItemParams{
+					{Plan: "plan_full", Quantity: 1},
+				},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, schedule)
+}
+
+func TestSubscriptionScheduleRelease(t *testing.T) {
+	schedule, err := Release("sub_sched_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, schedule)
+}
+
+func TestSubscriptionScheduleUpdate(t *testing.T) {
+	schedule, err := Update("sub_sched_123", &stripe.SubscriptionScheduleParams{
+		EndBehavior: EndBehaviorRelease,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, schedule)
+}
@@ -0,0 +1,34 @@
+package stripe
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/form"
+)
+
+func TestSubscriptionScheduleParams_AppendTo(t *testing.T) {
+	{
+		params := &SubscriptionScheduleParams{StartDateNow: true}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"now"}, body.Get("start_date"))
+	}
+
+	{
+		params := &SubscriptionScheduleParams{
+			Phases: []*SubscriptionSchedulePhaseParams{
+				{Plans: []*SubscriptionSchedulePhaseItemParams{{Plan: "plan_intro"}}, Iterations: 3},
+				{Plans: []*SubscriptionSchedulePhaseItemParams{{Plan: "plan_full", Quantity: 2}}},
+			},
+		}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"plan_intro"}, body.Get("phases[0][plans][0][plan]"))
+		assert.Equal(t, []string{"3"}, body.Get("phases[0][iterations]"))
+		assert.Equal(t, []string{"plan_full"}, body.Get("phases[1][plans][0][plan]"))
+		assert.Equal(t, []string{"2"}, body.Get("phases[1][plans][0][quantity]"))
+	}
+}
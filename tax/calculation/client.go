@@ -0,0 +1,108 @@
+// Package calculation provides the /tax/calculations APIs
+package calculation
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	AddressSourceBilling  stripe.TaxCalculationAddressSource = "billing"
+	AddressSourceShipping stripe.TaxCalculationAddressSource = "shipping"
+
+	TaxBehaviorExclusive stripe.TaxBehavior = "exclusive"
+	TaxBehaviorInclusive stripe.TaxBehavior = "inclusive"
+
+	TaxabilityOverrideCustomerExempt stripe.TaxCalculationTaxabilityOverride = "customer_exempt"
+	TaxabilityOverrideNone           stripe.TaxCalculationTaxabilityOverride = "none"
+	TaxabilityOverrideReverseCharge  stripe.TaxCalculationTaxabilityOverride = "reverse_charge"
+
+	TaxabilityReasonCustomerExempt       stripe.TaxCalculationTaxabilityReason = "customer_exempt"
+	TaxabilityReasonNotCollecting        stripe.TaxCalculationTaxabilityReason = "not_collecting"
+	TaxabilityReasonNotSubjectToTax      stripe.TaxCalculationTaxabilityReason = "not_subject_to_tax"
+	TaxabilityReasonNotSupported         stripe.TaxCalculationTaxabilityReason = "not_supported"
+	TaxabilityReasonPortionProductExempt stripe.TaxCalculationTaxabilityReason = "portion_product_exempt"
+	TaxabilityReasonPortionReducedRated  stripe.TaxCalculationTaxabilityReason = "portion_reduced_rated"
+	TaxabilityReasonPortionStandardRated stripe.TaxCalculationTaxabilityReason = "portion_standard_rated"
+	TaxabilityReasonProductExempt        stripe.TaxCalculationTaxabilityReason = "product_exempt"
+	TaxabilityReasonProductExemptHoliday stripe.TaxCalculationTaxabilityReason = "product_exempt_holiday"
+	TaxabilityReasonProportionallyRated  stripe.TaxCalculationTaxabilityReason = "proportionally_rated"
+	TaxabilityReasonReducedRated         stripe.TaxCalculationTaxabilityReason = "reduced_rated"
+	TaxabilityReasonReverseCharge        stripe.TaxCalculationTaxabilityReason = "reverse_charge"
+	TaxabilityReasonStandardRated        stripe.TaxCalculationTaxabilityReason = "standard_rated"
+	TaxabilityReasonTaxableBasisReduced  stripe.TaxCalculationTaxabilityReason = "taxable_basis_reduced"
+	TaxabilityReasonZeroRated            stripe.TaxCalculationTaxabilityReason = "zero_rated"
+)
+
+// Client is used to invoke /tax/calculations APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New calculates the tax for a set of line items. The calculation isn't
+// recorded until it's turned into a tax transaction.
+// For more details see https://stripe.com/docs/api/tax/calculations/create.
+func New(params *stripe.TaxCalculationParams) (*stripe.TaxCalculation, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TaxCalculationParams) (*stripe.TaxCalculation, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	calculation := &stripe.TaxCalculation{}
+	err := c.B.Call("POST", "/tax/calculations", c.Key, body, commonParams, calculation)
+
+	return calculation, err
+}
+
+// ListLineItems returns a list of the line items of a tax calculation.
+// For more details see https://stripe.com/docs/api/tax/calculations/line_items.
+func ListLineItems(params *stripe.TaxCalculationLineItemListParams) *LineItemIter {
+	return getC().ListLineItems(params)
+}
+
+func (c Client) ListLineItems(params *stripe.TaxCalculationLineItemListParams) *LineItemIter {
+	body := &form.Values{}
+	var lp *stripe.ListParams = &params.ListParams
+	var p *stripe.Params = params.ToParams()
+	form.AppendTo(body, params)
+
+	return &LineItemIter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TaxCalculationLineItemList{}
+		err := c.B.Call("GET", fmt.Sprintf("/tax/calculations/%v/line_items", params.Calculation), c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// LineItemIter is an iterator for lists of tax calculation line items.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type LineItemIter struct {
+	*stripe.Iter
+}
+
+// TaxCalculationLineItem returns the most recent TaxCalculationLineItem
+// visited by a call to Next.
+func (i *LineItemIter) TaxCalculationLineItem() *stripe.TaxCalculationLineItem {
+	return i.Current().(*stripe.TaxCalculationLineItem)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
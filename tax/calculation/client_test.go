@@ -0,0 +1,63 @@
+package calculation
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestTaxCalculationListLineItems(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/tax/calculations/taxcalc_123/line_items", `{"data": [{"id": "tax_li_123", "amount": 1000, "amount_tax": 88, "tax_behavior": "exclusive"}]}`)
+
+	i := Client{B: b}.ListLineItems(&stripe.TaxCalculationLineItemListParams{
+		Calculation: "taxcalc_123",
+	})
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, int64(88), i.TaxCalculationLineItem().AmountTax)
+	assert.Equal(t, TaxBehaviorExclusive, i.TaxCalculationLineItem().TaxBehavior)
+}
+
+func TestTaxCalculationNew(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/tax/calculations", `{
+		"id": "taxcalc_123",
+		"object": "tax.calculation",
+		"amount_total": 1088,
+		"tax_amount_exclusive": 88,
+		"tax_breakdown": [{
+			"amount": 88,
+			"taxability_reason": "standard_rated",
+			"taxable_amount": 1000,
+			"tax_rate_details": {"country": "US", "percentage_decimal": "8.875", "state": "NY", "tax_type": "sales_tax"}
+		}]
+	}`)
+
+	calculation, err := Client{B: b}.New(&stripe.TaxCalculationParams{
+		Currency: "usd",
+		CustomerDetails: &stripe.TaxCalculationCustomerDetailsParams{
+			Address: &stripe.AddressParams{
+				Country:    "US",
+				PostalCode: "10001",
+			},
+			AddressSource: AddressSourceShipping,
+		},
+		LineItems: []*stripe.TaxCalculationLineItemParams{
+			{Amount: 1000, Reference: "L1", TaxBehavior: TaxBehaviorExclusive},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1088), calculation.AmountTotal)
+	assert.Equal(t, TaxabilityReasonStandardRated, calculation.TaxBreakdown[0].TaxabilityReason)
+	assert.Equal(t, "8.875", calculation.TaxBreakdown[0].TaxRateDetails.PercentageDecimal)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "10001", values.Get("customer_details[address][postal_code]"))
+	assert.Equal(t, "shipping", values.Get("customer_details[address_source]"))
+	assert.Equal(t, "1000", values.Get("line_items[0][amount]"))
+	assert.Equal(t, "exclusive", values.Get("line_items[0][tax_behavior]"))
+}
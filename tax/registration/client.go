@@ -0,0 +1,143 @@
+// Package registration provides the /tax/registrations APIs
+package registration
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	StatusActive    stripe.TaxRegistrationStatus = "active"
+	StatusExpired   stripe.TaxRegistrationStatus = "expired"
+	StatusScheduled stripe.TaxRegistrationStatus = "scheduled"
+
+	TypeIOSS             stripe.TaxRegistrationType = "ioss"
+	TypeOSSNonUnion      stripe.TaxRegistrationType = "oss_non_union"
+	TypeOSSUnion         stripe.TaxRegistrationType = "oss_union"
+	TypeProvinceStandard stripe.TaxRegistrationType = "province_standard"
+	TypeSimplified       stripe.TaxRegistrationType = "simplified"
+	TypeStandard         stripe.TaxRegistrationType = "standard"
+	TypeStateSalesTax    stripe.TaxRegistrationType = "state_sales_tax"
+)
+
+// Client is used to invoke /tax/registrations APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New creates a tax registration.
+// For more details see https://stripe.com/docs/api/tax/registrations/create.
+func New(params *stripe.TaxRegistrationParams) (*stripe.TaxRegistration, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TaxRegistrationParams) (*stripe.TaxRegistration, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	registration := &stripe.TaxRegistration{}
+	err := c.B.Call("POST", "/tax/registrations", c.Key, body, commonParams, registration)
+
+	return registration, err
+}
+
+// Get returns the details of a tax registration.
+// For more details see https://stripe.com/docs/api/tax/registrations/retrieve.
+func Get(id string, params *stripe.TaxRegistrationParams) (*stripe.TaxRegistration, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TaxRegistrationParams) (*stripe.TaxRegistration, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	registration := &stripe.TaxRegistration{}
+	err := c.B.Call("GET", fmt.Sprintf("/tax/registrations/%v", id), c.Key, body, commonParams, registration)
+
+	return registration, err
+}
+
+// Update updates a tax registration, typically to schedule when it expires.
+// For more details see https://stripe.com/docs/api/tax/registrations/update.
+func Update(id string, params *stripe.TaxRegistrationParams) (*stripe.TaxRegistration, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.TaxRegistrationParams) (*stripe.TaxRegistration, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	registration := &stripe.TaxRegistration{}
+	err := c.B.Call("POST", fmt.Sprintf("/tax/registrations/%v", id), c.Key, body, commonParams, registration)
+
+	return registration, err
+}
+
+// List returns a list of tax registrations.
+// For more details see https://stripe.com/docs/api/tax/registrations/list.
+func List(params *stripe.TaxRegistrationListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.TaxRegistrationListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TaxRegistrationList{}
+		err := c.B.Call("GET", "/tax/registrations", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of TaxRegistrations.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// TaxRegistration returns the most recent TaxRegistration
+// visited by a call to Next.
+func (i *Iter) TaxRegistration() *stripe.TaxRegistration {
+	return i.Current().(*stripe.TaxRegistration)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
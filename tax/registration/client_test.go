@@ -0,0 +1,50 @@
+package registration
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestTaxRegistrationList(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/tax/registrations", `{"data": [{"id": "taxreg_123", "status": "active"}]}`)
+
+	i := Client{B: b}.List(&stripe.TaxRegistrationListParams{Status: StatusActive})
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, "taxreg_123", i.TaxRegistration().ID)
+	assert.Equal(t, "active", b.LastCall().Values().Get("status"))
+}
+
+func TestTaxRegistrationNew(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/tax/registrations", `{"id": "taxreg_123", "country": "US", "country_options": {"us": {"state": "CA", "type": "state_sales_tax"}}}`)
+
+	registration, err := Client{B: b}.New(&stripe.TaxRegistrationParams{
+		ActiveFromNow: true,
+		Country:       "US",
+		CountryOptions: map[string]*stripe.TaxRegistrationCountryOptionsParams{
+			"us": {State: "CA", Type: TypeStateSalesTax},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, TypeStateSalesTax, registration.CountryOptions["us"].Type)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "now", values.Get("active_from"))
+	assert.Equal(t, "CA", values.Get("country_options[us][state]"))
+	assert.Equal(t, "state_sales_tax", values.Get("country_options[us][type]"))
+}
+
+func TestTaxRegistrationUpdate(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/tax/registrations/taxreg_123", `{"id": "taxreg_123", "status": "expired"}`)
+
+	_, err := Client{B: b}.Update("taxreg_123", &stripe.TaxRegistrationParams{ExpiresAtNow: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "now", b.LastCall().Values().Get("expires_at"))
+}
@@ -0,0 +1,70 @@
+// Package settings provides the /tax/settings APIs
+package settings
+
+import (
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	StatusActive  stripe.TaxSettingsStatus = "active"
+	StatusPending stripe.TaxSettingsStatus = "pending"
+
+	TaxBehaviorExclusive          stripe.TaxBehavior = "exclusive"
+	TaxBehaviorInclusive          stripe.TaxBehavior = "inclusive"
+	TaxBehaviorInferredByCurrency stripe.TaxBehavior = "inferred_by_currency"
+)
+
+// Client is used to invoke /tax/settings APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the tax settings of the account.
+// For more details see https://stripe.com/docs/api/tax/settings/retrieve.
+func Get(params *stripe.TaxSettingsParams) (*stripe.TaxSettings, error) {
+	return getC().Get(params)
+}
+
+func (c Client) Get(params *stripe.TaxSettingsParams) (*stripe.TaxSettings, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	settings := &stripe.TaxSettings{}
+	err := c.B.Call("GET", "/tax/settings", c.Key, body, commonParams, settings)
+
+	return settings, err
+}
+
+// Update updates the tax settings of the account.
+// For more details see https://stripe.com/docs/api/tax/settings/update.
+func Update(params *stripe.TaxSettingsParams) (*stripe.TaxSettings, error) {
+	return getC().Update(params)
+}
+
+func (c Client) Update(params *stripe.TaxSettingsParams) (*stripe.TaxSettings, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	settings := &stripe.TaxSettings{}
+	err := c.B.Call("POST", "/tax/settings", c.Key, body, commonParams, settings)
+
+	return settings, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
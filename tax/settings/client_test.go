@@ -0,0 +1,40 @@
+package settings
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestTaxSettingsGet(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/tax/settings", `{"status": "pending", "status_details": {"pending": {"missing_fields": ["head_office"]}}}`)
+
+	settings, err := Client{B: b}.Get(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, StatusPending, settings.Status)
+	assert.Equal(t, []string{"head_office"}, settings.StatusDetails.Pending.MissingFields)
+}
+
+func TestTaxSettingsUpdate(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/tax/settings", `{"status": "active", "defaults": {"tax_behavior": "inferred_by_currency"}}`)
+
+	settings, err := Client{B: b}.Update(&stripe.TaxSettingsParams{
+		Defaults: &stripe.TaxSettingsDefaultsParams{
+			TaxBehavior: TaxBehaviorInferredByCurrency,
+		},
+		HeadOffice: &stripe.TaxSettingsHeadOfficeParams{
+			Address: &stripe.AddressParams{Country: "US", State: "CA"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, StatusActive, settings.Status)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "inferred_by_currency", values.Get("defaults[tax_behavior]"))
+	assert.Equal(t, "CA", values.Get("head_office[address][state]"))
+}
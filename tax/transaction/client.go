@@ -0,0 +1,94 @@
+// Package transaction provides the /tax/transactions APIs
+package transaction
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	ReversalModeFull    stripe.TaxTransactionReversalMode = "full"
+	ReversalModePartial stripe.TaxTransactionReversalMode = "partial"
+
+	TypeReversal    stripe.TaxTransactionType = "reversal"
+	TypeTransaction stripe.TaxTransactionType = "transaction"
+)
+
+// Client is used to invoke /tax/transactions APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// CreateFromCalculation records a tax calculation as a tax transaction, so
+// that the tax it computed is included in tax reporting.
+// For more details see https://stripe.com/docs/api/tax/transactions/create_from_calculation.
+func CreateFromCalculation(params *stripe.TaxTransactionCreateFromCalculationParams) (*stripe.TaxTransaction, error) {
+	return getC().CreateFromCalculation(params)
+}
+
+func (c Client) CreateFromCalculation(params *stripe.TaxTransactionCreateFromCalculationParams) (*stripe.TaxTransaction, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	transaction := &stripe.TaxTransaction{}
+	err := c.B.Call("POST", "/tax/transactions/create_from_calculation", c.Key, body, commonParams, transaction)
+
+	return transaction, err
+}
+
+// CreateReversal fully or partially reverses a tax transaction.
+// For more details see https://stripe.com/docs/api/tax/transactions/create_reversal.
+func CreateReversal(params *stripe.TaxTransactionCreateReversalParams) (*stripe.TaxTransaction, error) {
+	return getC().CreateReversal(params)
+}
+
+func (c Client) CreateReversal(params *stripe.TaxTransactionCreateReversalParams) (*stripe.TaxTransaction, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	transaction := &stripe.TaxTransaction{}
+	err := c.B.Call("POST", "/tax/transactions/create_reversal", c.Key, body, commonParams, transaction)
+
+	return transaction, err
+}
+
+// Get returns the details of a tax transaction.
+// For more details see https://stripe.com/docs/api/tax/transactions/retrieve.
+func Get(id string, params *stripe.TaxTransactionParams) (*stripe.TaxTransaction, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TaxTransactionParams) (*stripe.TaxTransaction, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	transaction := &stripe.TaxTransaction{}
+	err := c.B.Call("GET", fmt.Sprintf("/tax/transactions/%v", id), c.Key, body, commonParams, transaction)
+
+	return transaction, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,53 @@
+package transaction
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestTaxTransactionCreateFromCalculation(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/tax/transactions/create_from_calculation", `{"id": "tax_123", "type": "transaction", "reference": "order_123"}`)
+
+	transaction, err := Client{B: b}.CreateFromCalculation(&stripe.TaxTransactionCreateFromCalculationParams{
+		Calculation: "taxcalc_123",
+		Reference:   "order_123",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, TypeTransaction, transaction.Type)
+	assert.Equal(t, "taxcalc_123", b.LastCall().Values().Get("calculation"))
+}
+
+func TestTaxTransactionCreateReversal(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/tax/transactions/create_reversal", `{"id": "tax_456", "type": "reversal", "reversal": {"original_transaction": "tax_123"}}`)
+
+	transaction, err := Client{B: b}.CreateReversal(&stripe.TaxTransactionCreateReversalParams{
+		LineItems: []*stripe.TaxTransactionReversalLineItemParams{
+			{Amount: -1000, AmountTax: -88, OriginalLineItem: "tax_li_123", Reference: "L1"},
+		},
+		Mode:                ReversalModePartial,
+		OriginalTransaction: "tax_123",
+		Reference:           "order_123-refund",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, TypeReversal, transaction.Type)
+	assert.Equal(t, "tax_123", transaction.Reversal.OriginalTransaction)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "partial", values.Get("mode"))
+	assert.Equal(t, "-88", values.Get("line_items[0][amount_tax]"))
+}
+
+func TestTaxTransactionGet(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/tax/transactions/tax_123", `{"id": "tax_123", "object": "tax.transaction"}`)
+
+	transaction, err := Client{B: b}.Get("tax_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "tax.transaction", transaction.GetObject())
+}
@@ -0,0 +1,195 @@
+package stripe
+
+import "encoding/json"
+
+// TaxBehavior is the list of allowed values for whether an amount is
+// inclusive or exclusive of tax. Allowed values are "exclusive" and
+// "inclusive", "inferred_by_currency" for tax settings defaults and
+// "unspecified" for shipping rates.
+type TaxBehavior string
+
+// TaxCalculationAddressSource is the list of allowed values for which of the
+// customer's addresses a tax calculation is based on. Allowed values are
+// "billing" and "shipping".
+type TaxCalculationAddressSource string
+
+// TaxCalculationTaxabilityOverride is the list of allowed values for
+// overriding the taxability of a customer. Allowed values are
+// "customer_exempt", "none" and "reverse_charge".
+type TaxCalculationTaxabilityOverride string
+
+// TaxCalculationTaxabilityReason is the list of allowed values for the reason
+// an amount was or wasn't taxed, like "standard_rated" or "not_collecting".
+type TaxCalculationTaxabilityReason string
+
+// TaxCalculationCustomerDetailsTaxIDParams is the set of parameters for a tax
+// ID of the customer a tax calculation is for.
+type TaxCalculationCustomerDetailsTaxIDParams struct {
+	Type  TaxIDType `form:"type"`
+	Value string    `form:"value"`
+}
+
+// TaxCalculationCustomerDetailsParams is the set of parameters describing the
+// customer a tax calculation is for, when Customer isn't set.
+type TaxCalculationCustomerDetailsParams struct {
+	Address            *AddressParams                              `form:"address"`
+	AddressSource      TaxCalculationAddressSource                 `form:"address_source"`
+	IPAddress          string                                      `form:"ip_address"`
+	TaxabilityOverride TaxCalculationTaxabilityOverride            `form:"taxability_override"`
+	TaxIDs             []*TaxCalculationCustomerDetailsTaxIDParams `form:"tax_ids,indexed"`
+}
+
+// TaxCalculationLineItemParams is the set of parameters for a line item of a
+// tax calculation.
+type TaxCalculationLineItemParams struct {
+	Amount      int64       `form:"amount"`
+	Product     string      `form:"product"`
+	Quantity    uint64      `form:"quantity"`
+	Reference   string      `form:"reference"`
+	TaxBehavior TaxBehavior `form:"tax_behavior"`
+	TaxCode     string      `form:"tax_code"`
+}
+
+// TaxCalculationShippingCostParams is the set of parameters for the shipping
+// cost of a tax calculation.
+type TaxCalculationShippingCostParams struct {
+	Amount       int64       `form:"amount"`
+	ShippingRate string      `form:"shipping_rate"`
+	TaxBehavior  TaxBehavior `form:"tax_behavior"`
+	TaxCode      string      `form:"tax_code"`
+}
+
+// TaxCalculationParams is the set of parameters that can be used when
+// creating a tax calculation.
+// For more details see https://stripe.com/docs/api/tax/calculations/create.
+type TaxCalculationParams struct {
+	Params          `form:"*"`
+	Currency        Currency                             `form:"currency"`
+	Customer        string                               `form:"customer"`
+	CustomerDetails *TaxCalculationCustomerDetailsParams `form:"customer_details"`
+	LineItems       []*TaxCalculationLineItemParams      `form:"line_items,indexed"`
+	ShippingCost    *TaxCalculationShippingCostParams    `form:"shipping_cost"`
+	TaxDate         int64                                `form:"tax_date"`
+}
+
+// TaxCalculationLineItemListParams is the set of parameters that can be used
+// when listing the line items of a tax calculation.
+// For more details see https://stripe.com/docs/api/tax/calculations/line_items.
+type TaxCalculationLineItemListParams struct {
+	ListParams `form:"*"`
+
+	// Calculation is the tax calculation ID to list line items for.
+	Calculation string `form:"-"` // Goes in the URL
+}
+
+// TaxCalculationCustomerDetailsTaxID is a tax ID of the customer a tax
+// calculation is for.
+type TaxCalculationCustomerDetailsTaxID struct {
+	Type  TaxIDType `json:"type"`
+	Value string    `json:"value"`
+}
+
+// TaxCalculationCustomerDetails is the details of the customer a tax
+// calculation is for.
+type TaxCalculationCustomerDetails struct {
+	Address            *Address                              `json:"address"`
+	AddressSource      TaxCalculationAddressSource           `json:"address_source"`
+	IPAddress          string                                `json:"ip_address"`
+	TaxabilityOverride TaxCalculationTaxabilityOverride      `json:"taxability_override"`
+	TaxIDs             []*TaxCalculationCustomerDetailsTaxID `json:"tax_ids"`
+}
+
+// TaxCalculationTaxRateDetails is the details of the tax rate applied to an
+// amount.
+type TaxCalculationTaxRateDetails struct {
+	Country           string `json:"country"`
+	PercentageDecimal string `json:"percentage_decimal"`
+	State             string `json:"state"`
+	TaxType           string `json:"tax_type"`
+}
+
+// TaxCalculationTaxBreakdown is the tax applied to an amount in one
+// jurisdiction.
+type TaxCalculationTaxBreakdown struct {
+	Amount           int64                          `json:"amount"`
+	Inclusive        bool                           `json:"inclusive"`
+	TaxabilityReason TaxCalculationTaxabilityReason `json:"taxability_reason"`
+	TaxableAmount    int64                          `json:"taxable_amount"`
+	TaxRateDetails   *TaxCalculationTaxRateDetails  `json:"tax_rate_details"`
+}
+
+// TaxCalculationShippingCost is the shipping cost of a tax calculation and
+// the tax computed for it.
+type TaxCalculationShippingCost struct {
+	Amount       int64                         `json:"amount"`
+	AmountTax    int64                         `json:"amount_tax"`
+	ShippingRate string                        `json:"shipping_rate"`
+	TaxBehavior  TaxBehavior                   `json:"tax_behavior"`
+	TaxBreakdown []*TaxCalculationTaxBreakdown `json:"tax_breakdown"`
+	TaxCode      string                        `json:"tax_code"`
+}
+
+// TaxCalculationLineItem is the resource representing a line item of a tax
+// calculation.
+// For more details see https://stripe.com/docs/api/tax/calculations/line_item_object.
+type TaxCalculationLineItem struct {
+	Amount       int64                         `json:"amount"`
+	AmountTax    int64                         `json:"amount_tax"`
+	ID           string                        `json:"id"`
+	Live         bool                          `json:"livemode"`
+	Product      string                        `json:"product"`
+	Quantity     uint64                        `json:"quantity"`
+	Reference    string                        `json:"reference"`
+	TaxBehavior  TaxBehavior                   `json:"tax_behavior"`
+	TaxBreakdown []*TaxCalculationTaxBreakdown `json:"tax_breakdown"`
+	TaxCode      string                        `json:"tax_code"`
+}
+
+// TaxCalculationLineItemList is a list of tax calculation line items as
+// retrieved from a list endpoint.
+type TaxCalculationLineItemList struct {
+	APIResource
+	ListMeta
+	Values []*TaxCalculationLineItem `json:"data"`
+}
+
+// TaxCalculation is the resource representing a Stripe tax calculation. A
+// calculation is only a quote: it has to be turned into a tax transaction to
+// be recorded.
+// For more details see https://stripe.com/docs/api/tax/calculations.
+type TaxCalculation struct {
+	APIResource
+	AmountTotal        int64                          `json:"amount_total"`
+	Currency           Currency                       `json:"currency"`
+	Customer           string                         `json:"customer"`
+	CustomerDetails    *TaxCalculationCustomerDetails `json:"customer_details"`
+	ExpiresAt          Timestamp                      `json:"expires_at"`
+	ID                 string                         `json:"id"`
+	LineItems          *TaxCalculationLineItemList    `json:"line_items"`
+	Live               bool                           `json:"livemode"`
+	ShippingCost       *TaxCalculationShippingCost    `json:"shipping_cost"`
+	TaxAmountExclusive int64                          `json:"tax_amount_exclusive"`
+	TaxAmountInclusive int64                          `json:"tax_amount_inclusive"`
+	TaxBreakdown       []*TaxCalculationTaxBreakdown  `json:"tax_breakdown"`
+	TaxDate            Timestamp                      `json:"tax_date"`
+}
+
+// UnmarshalJSON handles deserialization of a TaxCalculation.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TaxCalculation) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type taxCalculation TaxCalculation
+	var tc taxCalculation
+	err := json.Unmarshal(data, &tc)
+	if err != nil {
+		return err
+	}
+
+	*t = TaxCalculation(tc)
+	return nil
+}
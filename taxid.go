@@ -0,0 +1,80 @@
+package stripe
+
+import "encoding/json"
+
+// TaxIDType is the list of allowed values for the tax ID's type.
+// Allowed values are "au_abn", "eu_vat", "in_gst", "no_vat", "nz_gst", and
+// "unknown".
+type TaxIDType string
+
+// TaxIDVerificationStatus is the list of allowed values for the tax ID's
+// verification status. Allowed values are "pending", "unavailable",
+// "unverified", and "verified".
+type TaxIDVerificationStatus string
+
+// TaxIDParams is the set of parameters that can be used when creating a tax
+// ID.
+// For more details see https://stripe.com/docs/api#create_tax_id.
+type TaxIDParams struct {
+	Params   `form:"*"`
+	Customer string    `form:"-"` // Included in URL
+	Type     TaxIDType `form:"type"`
+	Value    string    `form:"value"`
+}
+
+// TaxIDListParams is the set of parameters that can be used when listing tax
+// IDs.
+// For more details see https://stripe.com/docs/api#list_tax_ids.
+type TaxIDListParams struct {
+	ListParams `form:"*"`
+	Customer   string `form:"-"` // Included in URL
+}
+
+// TaxIDVerification represents the verification details of a tax ID.
+type TaxIDVerification struct {
+	Status          TaxIDVerificationStatus `json:"status"`
+	VerifiedAddress string                  `json:"verified_address"`
+	VerifiedName    string                  `json:"verified_name"`
+}
+
+// TaxID is the resource representing a customer's tax ID.
+// For more details see https://stripe.com/docs/api#tax_ids.
+type TaxID struct {
+	APIResource
+	Country      string             `json:"country"`
+	Created      Timestamp          `json:"created"`
+	Customer     *Customer          `json:"customer"`
+	Deleted      bool               `json:"deleted"`
+	ID           string             `json:"id"`
+	Live         bool               `json:"livemode"`
+	Type         TaxIDType          `json:"type"`
+	Value        string             `json:"value"`
+	Verification *TaxIDVerification `json:"verification"`
+}
+
+// TaxIDList is a list of tax IDs as retrieved from a list endpoint.
+type TaxIDList struct {
+	APIResource
+	ListMeta
+	Values []*TaxID `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a TaxID.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TaxID) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type taxID TaxID
+	var tt taxID
+	err := json.Unmarshal(data, &tt)
+	if err != nil {
+		return err
+	}
+
+	*t = TaxID(tt)
+	return nil
+}
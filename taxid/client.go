@@ -0,0 +1,143 @@
+// Package taxid provides the /customers/tax_ids APIs
+package taxid
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	TypeAUABN   stripe.TaxIDType = "au_abn"
+	TypeEUVAT   stripe.TaxIDType = "eu_vat"
+	TypeINGST   stripe.TaxIDType = "in_gst"
+	TypeNOVAT   stripe.TaxIDType = "no_vat"
+	TypeNZGST   stripe.TaxIDType = "nz_gst"
+	TypeUnknown stripe.TaxIDType = "unknown"
+
+	VerificationPending     stripe.TaxIDVerificationStatus = "pending"
+	VerificationUnavailable stripe.TaxIDVerificationStatus = "unavailable"
+	VerificationUnverified  stripe.TaxIDVerificationStatus = "unverified"
+	VerificationVerified    stripe.TaxIDVerificationStatus = "verified"
+)
+
+// Client is used to invoke /customers/tax_ids APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new tax ID.
+// For more details see https://stripe.com/docs/api#create_tax_id.
+func New(params *stripe.TaxIDParams) (*stripe.TaxID, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TaxIDParams) (*stripe.TaxID, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Customer == "" {
+		return nil, fmt.Errorf("params.Customer must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	taxID := &stripe.TaxID{}
+	err := c.B.Call("POST", fmt.Sprintf("/customers/%v/tax_ids", params.Customer), c.Key, body, &params.Params, taxID)
+
+	return taxID, err
+}
+
+// Get returns the details of a tax ID.
+// For more details see https://stripe.com/docs/api#retrieve_tax_id.
+func Get(id string, params *stripe.TaxIDParams) (*stripe.TaxID, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TaxIDParams) (*stripe.TaxID, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Customer == "" {
+		return nil, fmt.Errorf("params.Customer must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	taxID := &stripe.TaxID{}
+	err := c.B.Call("GET", fmt.Sprintf("/customers/%v/tax_ids/%v", params.Customer, id), c.Key, body, &params.Params, taxID)
+
+	return taxID, err
+}
+
+// Del removes a tax ID.
+// For more details see https://stripe.com/docs/api#delete_tax_id.
+func Del(id string, params *stripe.TaxIDParams) (*stripe.TaxID, error) {
+	return getC().Del(id, params)
+}
+
+func (c Client) Del(id string, params *stripe.TaxIDParams) (*stripe.TaxID, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.Customer == "" {
+		return nil, fmt.Errorf("params.Customer must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	taxID := &stripe.TaxID{}
+	err := c.B.Call("DELETE", fmt.Sprintf("/customers/%v/tax_ids/%v", params.Customer, id), c.Key, body, &params.Params, taxID)
+
+	return taxID, err
+}
+
+// List returns a list of tax IDs.
+// For more details see https://stripe.com/docs/api#list_tax_ids.
+func List(params *stripe.TaxIDListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.TaxIDListParams) *Iter {
+	body := &form.Values{}
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+	p = params.ToParams()
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TaxIDList{}
+		err := c.B.Call("GET", fmt.Sprintf("/customers/%v/tax_ids", params.Customer), c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of TaxIDs.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// TaxID returns the most recent TaxID
+// visited by a call to Next.
+func (i *Iter) TaxID() *stripe.TaxID {
+	return i.Current().(*stripe.TaxID)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
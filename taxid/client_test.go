@@ -0,0 +1,45 @@
+package taxid
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestTaxIDDel(t *testing.T) {
+	taxID, err := Del("txi_123", &stripe.TaxIDParams{Customer: "cus_123"})
+	assert.Nil(t, err)
+	assert.NotNil(t, taxID)
+}
+
+func TestTaxIDGet(t *testing.T) {
+	taxID, err := Get("txi_123", &stripe.TaxIDParams{Customer: "cus_123"})
+	assert.Nil(t, err)
+	assert.NotNil(t, taxID)
+}
+
+func TestTaxIDGet_NoCustomer(t *testing.T) {
+	_, err := Get("txi_123", &stripe.TaxIDParams{})
+	assert.NotNil(t, err)
+}
+
+func TestTaxIDList(t *testing.T) {
+	i := List(&stripe.TaxIDListParams{Customer: "cus_123"})
+
+	// Verify that we can get at least one tax ID
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.TaxID())
+}
+
+func TestTaxIDNew(t *testing.T) {
+	taxID, err := New(&stripe.TaxIDParams{
+		Customer: "cus_123",
+		Type:     TypeEUVAT,
+		Value:    "DE123456789",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, taxID)
+}
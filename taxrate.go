@@ -0,0 +1,74 @@
+package stripe
+
+import "encoding/json"
+
+// TaxRateParams is the set of parameters that can be used when creating or
+// updating a tax rate. Tax rates can't be deleted, but they can be archived by
+// setting Inactive.
+// For more details see https://stripe.com/docs/api#create_tax_rate and https://stripe.com/docs/api#update_tax_rate.
+type TaxRateParams struct {
+	Params       `form:"*"`
+	Active       bool    `form:"active"`
+	Desc         string  `form:"description"`
+	DisplayName  string  `form:"display_name"`
+	Inactive     bool    `form:"active,invert"`
+	Inclusive    bool    `form:"inclusive"`
+	Jurisdiction string  `form:"jurisdiction"`
+	Percentage   float64 `form:"percentage"`
+}
+
+// TaxRateListParams is the set of parameters that can be used when listing
+// tax rates.
+// For more details see https://stripe.com/docs/api#list_tax_rates.
+type TaxRateListParams struct {
+	ListParams   `form:"*"`
+	Active       bool              `form:"active"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Exclusive    bool              `form:"inclusive,invert"`
+	Inactive     bool              `form:"active,invert"`
+	Inclusive    bool              `form:"inclusive"`
+}
+
+// TaxRate is the resource representing a Stripe tax rate.
+// For more details see https://stripe.com/docs/api#tax_rates.
+type TaxRate struct {
+	APIResource
+	Active       bool              `json:"active"`
+	Created      Timestamp         `json:"created"`
+	Desc         string            `json:"description"`
+	DisplayName  string            `json:"display_name"`
+	ID           string            `json:"id"`
+	Inclusive    bool              `json:"inclusive"`
+	Jurisdiction string            `json:"jurisdiction"`
+	Live         bool              `json:"livemode"`
+	Meta         map[string]string `json:"metadata"`
+	Percentage   float64           `json:"percentage"`
+}
+
+// TaxRateList is a list of tax rates as retrieved from a list endpoint.
+type TaxRateList struct {
+	APIResource
+	ListMeta
+	Values []*TaxRate `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a TaxRate.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TaxRate) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type taxRate TaxRate
+	var tt taxRate
+	err := json.Unmarshal(data, &tt)
+	if err != nil {
+		return err
+	}
+
+	*t = TaxRate(tt)
+	return nil
+}
@@ -0,0 +1,129 @@
+// Package taxrate provides the /tax_rates APIs
+package taxrate
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /tax_rates APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new tax rate.
+// For more details see https://stripe.com/docs/api#create_tax_rate.
+func New(params *stripe.TaxRateParams) (*stripe.TaxRate, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TaxRateParams) (*stripe.TaxRate, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	taxRate := &stripe.TaxRate{}
+	err := c.B.Call("POST", "/tax_rates", c.Key, body, commonParams, taxRate)
+
+	return taxRate, err
+}
+
+// Get returns the details of a tax rate.
+// For more details see https://stripe.com/docs/api#retrieve_tax_rate.
+func Get(id string, params *stripe.TaxRateParams) (*stripe.TaxRate, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TaxRateParams) (*stripe.TaxRate, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	taxRate := &stripe.TaxRate{}
+	err := c.B.Call("GET", fmt.Sprintf("/tax_rates/%v", id), c.Key, body, commonParams, taxRate)
+
+	return taxRate, err
+}
+
+// Update updates a tax rate's properties.
+// For more details see https://stripe.com/docs/api#update_tax_rate.
+func Update(id string, params *stripe.TaxRateParams) (*stripe.TaxRate, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.TaxRateParams) (*stripe.TaxRate, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	taxRate := &stripe.TaxRate{}
+	err := c.B.Call("POST", fmt.Sprintf("/tax_rates/%v", id), c.Key, body, commonParams, taxRate)
+
+	return taxRate, err
+}
+
+// List returns a list of tax rates.
+// For more details see https://stripe.com/docs/api#list_tax_rates.
+func List(params *stripe.TaxRateListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.TaxRateListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TaxRateList{}
+		err := c.B.Call("GET", "/tax_rates", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of TaxRates.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// TaxRate returns the most recent TaxRate
+// visited by a call to Next.
+func (i *Iter) TaxRate() *stripe.TaxRate {
+	return i.Current().(*stripe.TaxRate)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,43 @@
+package taxrate
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestTaxRateGet(t *testing.T) {
+	taxRate, err := Get("txr_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, taxRate)
+}
+
+func TestTaxRateList(t *testing.T) {
+	i := List(&stripe.TaxRateListParams{Active: true})
+
+	// Verify that we can get at least one tax rate
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.TaxRate())
+}
+
+func TestTaxRateNew(t *testing.T) {
+	taxRate, err := New(&stripe.TaxRateParams{
+		DisplayName:  "VAT",
+		Inclusive:    true,
+		Jurisdiction: "DE",
+		Percentage:   19,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, taxRate)
+}
+
+func TestTaxRateUpdate(t *testing.T) {
+	taxRate, err := Update("txr_123", &stripe.TaxRateParams{
+		Inactive: true,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, taxRate)
+}
@@ -0,0 +1,126 @@
+package stripe
+
+import (
+	"encoding/json"
+
+	"github.com/stripe/stripe-go/form"
+)
+
+// TaxRegistrationStatus is the list of allowed values for the status of a tax
+// registration. Allowed values are "active", "expired" and "scheduled".
+type TaxRegistrationStatus string
+
+// TaxRegistrationType is the list of allowed values for the type of a tax
+// registration in a country, like "standard", "oss_union" or
+// "state_sales_tax".
+type TaxRegistrationType string
+
+// TaxRegistrationCountryOptionsStandardParams is the set of parameters for a
+// standard registration in a European country.
+type TaxRegistrationCountryOptionsStandardParams struct {
+	PlaceOfSupplyScheme string `form:"place_of_supply_scheme"`
+}
+
+// TaxRegistrationCountryOptionsParams is the set of parameters describing a
+// tax registration in one country.
+type TaxRegistrationCountryOptionsParams struct {
+	Standard *TaxRegistrationCountryOptionsStandardParams `form:"standard"`
+
+	// State is the two-letter state code a US or Canadian registration is
+	// for.
+	State string              `form:"state"`
+	Type  TaxRegistrationType `form:"type"`
+}
+
+// TaxRegistrationParams is the set of parameters that can be used when
+// creating or updating a tax registration.
+// For more details see https://stripe.com/docs/api/tax/registrations/create.
+type TaxRegistrationParams struct {
+	Params        `form:"*"`
+	ActiveFrom    int64  `form:"active_from"`
+	ActiveFromNow bool   `form:"-"` // See custom AppendTo
+	Country       string `form:"country"`
+
+	// CountryOptions is keyed by the lowercase two-letter code of Country,
+	// like "us" or "de".
+	CountryOptions map[string]*TaxRegistrationCountryOptionsParams `form:"country_options"`
+
+	ExpiresAt    int64 `form:"expires_at"`
+	ExpiresAtNow bool  `form:"-"` // See custom AppendTo
+}
+
+// AppendTo implements custom encoding logic for TaxRegistrationParams so that
+// a registration can start or end immediately.
+func (p *TaxRegistrationParams) AppendTo(body *form.Values, keyParts []string) {
+	if p.ActiveFromNow {
+		body.Add(form.FormatKey(append(keyParts, "active_from")), "now")
+	}
+	if p.ExpiresAtNow {
+		body.Add(form.FormatKey(append(keyParts, "expires_at")), "now")
+	}
+}
+
+// TaxRegistrationListParams is the set of parameters that can be used when
+// listing tax registrations.
+// For more details see https://stripe.com/docs/api/tax/registrations/list.
+type TaxRegistrationListParams struct {
+	ListParams `form:"*"`
+	Status     TaxRegistrationStatus `form:"status"`
+}
+
+// TaxRegistrationCountryOptionsStandard is the details of a standard
+// registration in a European country.
+type TaxRegistrationCountryOptionsStandard struct {
+	PlaceOfSupplyScheme string `json:"place_of_supply_scheme"`
+}
+
+// TaxRegistrationCountryOptions is the details of a tax registration in one
+// country.
+type TaxRegistrationCountryOptions struct {
+	Standard *TaxRegistrationCountryOptionsStandard `json:"standard"`
+	State    string                                 `json:"state"`
+	Type     TaxRegistrationType                    `json:"type"`
+}
+
+// TaxRegistration is the resource representing a Stripe tax registration,
+// which tells Stripe Tax to collect tax in a country or state.
+// For more details see https://stripe.com/docs/api/tax/registrations.
+type TaxRegistration struct {
+	APIResource
+	ActiveFrom     Timestamp                                 `json:"active_from"`
+	Country        string                                    `json:"country"`
+	CountryOptions map[string]*TaxRegistrationCountryOptions `json:"country_options"`
+	Created        Timestamp                                 `json:"created"`
+	ExpiresAt      Timestamp                                 `json:"expires_at"`
+	ID             string                                    `json:"id"`
+	Live           bool                                      `json:"livemode"`
+	Status         TaxRegistrationStatus                     `json:"status"`
+}
+
+// TaxRegistrationList is a list of tax registrations as retrieved from a list
+// endpoint.
+type TaxRegistrationList struct {
+	APIResource
+	ListMeta
+	Values []*TaxRegistration `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a TaxRegistration.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TaxRegistration) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type taxRegistration TaxRegistration
+	var tr taxRegistration
+	err := json.Unmarshal(data, &tr)
+	if err != nil {
+		return err
+	}
+
+	*t = TaxRegistration(tr)
+	return nil
+}
@@ -0,0 +1,62 @@
+package stripe
+
+// TaxSettingsStatus is the list of allowed values for the status of an
+// account's tax settings. Allowed values are "active" and "pending".
+type TaxSettingsStatus string
+
+// TaxSettingsDefaultsParams is the set of parameters for the default tax
+// behavior and tax code of an account.
+type TaxSettingsDefaultsParams struct {
+	TaxBehavior TaxBehavior `form:"tax_behavior"`
+	TaxCode     string      `form:"tax_code"`
+}
+
+// TaxSettingsHeadOfficeParams is the set of parameters for the place where an
+// account's business is located.
+type TaxSettingsHeadOfficeParams struct {
+	Address *AddressParams `form:"address"`
+}
+
+// TaxSettingsParams is the set of parameters that can be used when retrieving
+// or updating an account's tax settings.
+// For more details see https://stripe.com/docs/api/tax/settings/update.
+type TaxSettingsParams struct {
+	Params     `form:"*"`
+	Defaults   *TaxSettingsDefaultsParams   `form:"defaults"`
+	HeadOffice *TaxSettingsHeadOfficeParams `form:"head_office"`
+}
+
+// TaxSettingsDefaults is the default tax behavior and tax code of an account.
+type TaxSettingsDefaults struct {
+	TaxBehavior TaxBehavior `json:"tax_behavior"`
+	TaxCode     string      `json:"tax_code"`
+}
+
+// TaxSettingsHeadOffice is the place where an account's business is located.
+type TaxSettingsHeadOffice struct {
+	Address *Address `json:"address"`
+}
+
+// TaxSettingsStatusDetailsPending is the details of tax settings that aren't
+// complete enough yet for taxes to be calculated.
+type TaxSettingsStatusDetailsPending struct {
+	MissingFields []string `json:"missing_fields"`
+}
+
+// TaxSettingsStatusDetails is the details of the status of an account's tax
+// settings.
+type TaxSettingsStatusDetails struct {
+	Pending *TaxSettingsStatusDetailsPending `json:"pending"`
+}
+
+// TaxSettings is the resource representing an account's Stripe Tax settings.
+// There's only ever one per account, so it has no ID.
+// For more details see https://stripe.com/docs/api/tax/settings.
+type TaxSettings struct {
+	APIResource
+	Defaults      *TaxSettingsDefaults      `json:"defaults"`
+	HeadOffice    *TaxSettingsHeadOffice    `json:"head_office"`
+	Live          bool                      `json:"livemode"`
+	Status        TaxSettingsStatus         `json:"status"`
+	StatusDetails *TaxSettingsStatusDetails `json:"status_details"`
+}
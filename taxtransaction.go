@@ -0,0 +1,146 @@
+package stripe
+
+import "encoding/json"
+
+// TaxTransactionReversalMode is the list of allowed values for how a tax
+// transaction is reversed. Allowed values are "full" and "partial".
+type TaxTransactionReversalMode string
+
+// TaxTransactionType is the list of allowed values for the type of a tax
+// transaction. Allowed values are "reversal" and "transaction".
+type TaxTransactionType string
+
+// TaxTransactionParams is the set of parameters that can be used when
+// retrieving a tax transaction.
+// For more details see https://stripe.com/docs/api/tax/transactions/retrieve.
+type TaxTransactionParams struct {
+	Params `form:"*"`
+}
+
+// TaxTransactionCreateFromCalculationParams is the set of parameters that can
+// be used when recording a tax calculation as a tax transaction.
+// For more details see https://stripe.com/docs/api/tax/transactions/create_from_calculation.
+type TaxTransactionCreateFromCalculationParams struct {
+	Params      `form:"*"`
+	Calculation string `form:"calculation"`
+	Reference   string `form:"reference"`
+}
+
+// TaxTransactionReversalLineItemParams is the set of parameters for a line
+// item of a partial tax transaction reversal. Amounts are negative.
+type TaxTransactionReversalLineItemParams struct {
+	Amount           int64  `form:"amount"`
+	AmountTax        int64  `form:"amount_tax"`
+	OriginalLineItem string `form:"original_line_item"`
+	Quantity         uint64 `form:"quantity"`
+	Reference        string `form:"reference"`
+}
+
+// TaxTransactionReversalShippingCostParams is the set of parameters for the
+// shipping cost of a partial tax transaction reversal. Amounts are negative.
+type TaxTransactionReversalShippingCostParams struct {
+	Amount    int64 `form:"amount"`
+	AmountTax int64 `form:"amount_tax"`
+}
+
+// TaxTransactionCreateReversalParams is the set of parameters that can be
+// used when reversing a tax transaction, for example when the payment it
+// records is refunded.
+// For more details see https://stripe.com/docs/api/tax/transactions/create_reversal.
+type TaxTransactionCreateReversalParams struct {
+	Params              `form:"*"`
+	FlatAmount          int64                                     `form:"flat_amount"`
+	LineItems           []*TaxTransactionReversalLineItemParams   `form:"line_items,indexed"`
+	Mode                TaxTransactionReversalMode                `form:"mode"`
+	OriginalTransaction string                                    `form:"original_transaction"`
+	Reference           string                                    `form:"reference"`
+	ShippingCost        *TaxTransactionReversalShippingCostParams `form:"shipping_cost"`
+}
+
+// TaxTransactionLineItemReversal is the line item of the original tax
+// transaction that a reversal line item reverses.
+type TaxTransactionLineItemReversal struct {
+	OriginalLineItem string `json:"original_line_item"`
+}
+
+// TaxTransactionLineItem is the resource representing a line item of a tax
+// transaction.
+// For more details see https://stripe.com/docs/api/tax/transactions/line_item_object.
+type TaxTransactionLineItem struct {
+	Amount      int64                           `json:"amount"`
+	AmountTax   int64                           `json:"amount_tax"`
+	ID          string                          `json:"id"`
+	Live        bool                            `json:"livemode"`
+	Meta        map[string]string               `json:"metadata"`
+	Product     string                          `json:"product"`
+	Quantity    uint64                          `json:"quantity"`
+	Reference   string                          `json:"reference"`
+	Reversal    *TaxTransactionLineItemReversal `json:"reversal"`
+	TaxBehavior TaxBehavior                     `json:"tax_behavior"`
+	TaxCode     string                          `json:"tax_code"`
+	Type        TaxTransactionType              `json:"type"`
+}
+
+// TaxTransactionLineItemList is a list of tax transaction line items as
+// retrieved from a list endpoint.
+type TaxTransactionLineItemList struct {
+	APIResource
+	ListMeta
+	Values []*TaxTransactionLineItem `json:"data"`
+}
+
+// TaxTransactionReversal is the original tax transaction that a reversal
+// reverses.
+type TaxTransactionReversal struct {
+	OriginalTransaction string `json:"original_transaction"`
+}
+
+// TaxTransactionShippingCost is the shipping cost of a tax transaction and
+// the tax recorded for it.
+type TaxTransactionShippingCost struct {
+	Amount       int64       `json:"amount"`
+	AmountTax    int64       `json:"amount_tax"`
+	ShippingRate string      `json:"shipping_rate"`
+	TaxBehavior  TaxBehavior `json:"tax_behavior"`
+	TaxCode      string      `json:"tax_code"`
+}
+
+// TaxTransaction is the resource representing a Stripe tax transaction, the
+// record of tax collected on a sale or given back on a reversal.
+// For more details see https://stripe.com/docs/api/tax/transactions.
+type TaxTransaction struct {
+	APIResource
+	Created         Timestamp                      `json:"created"`
+	Currency        Currency                       `json:"currency"`
+	Customer        string                         `json:"customer"`
+	CustomerDetails *TaxCalculationCustomerDetails `json:"customer_details"`
+	ID              string                         `json:"id"`
+	LineItems       *TaxTransactionLineItemList    `json:"line_items"`
+	Live            bool                           `json:"livemode"`
+	Meta            map[string]string              `json:"metadata"`
+	Reference       string                         `json:"reference"`
+	Reversal        *TaxTransactionReversal        `json:"reversal"`
+	ShippingCost    *TaxTransactionShippingCost    `json:"shipping_cost"`
+	TaxDate         Timestamp                      `json:"tax_date"`
+	Type            TaxTransactionType             `json:"type"`
+}
+
+// UnmarshalJSON handles deserialization of a TaxTransaction.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TaxTransaction) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type taxTransaction TaxTransaction
+	var tt taxTransaction
+	err := json.Unmarshal(data, &tt)
+	if err != nil {
+		return err
+	}
+
+	*t = TaxTransaction(tt)
+	return nil
+}
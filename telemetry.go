@@ -0,0 +1,58 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// telemetryBufferSize is the number of request metrics kept until they're
+// sent. Metrics of requests made while the buffer is full are dropped.
+const telemetryBufferSize = 16
+
+// requestMetrics are the metrics of a completed request, sent to Stripe with
+// a later request in the X-Stripe-Client-Telemetry header.
+type requestMetrics struct {
+	RequestDurationMS int64  `json:"request_duration_ms"`
+	RequestID         string `json:"request_id"`
+}
+
+// requestTelemetry is the value of the X-Stripe-Client-Telemetry header.
+type requestTelemetry struct {
+	LastRequestMetrics requestMetrics `json:"last_request_metrics"`
+}
+
+// prevRequestMetrics holds the metrics of the requests completed by the
+// backends that have EnableTelemetry set, until they're sent with another
+// request.
+var prevRequestMetrics = make(chan requestMetrics, telemetryBufferSize)
+
+// recordTelemetry keeps the metrics of the request that received res so that
+// they're sent with a later request. It never blocks.
+func recordTelemetry(res *http.Response, duration time.Duration) {
+	requestID := res.Header.Get("Request-Id")
+	if requestID == "" {
+		return
+	}
+
+	select {
+	case prevRequestMetrics <- requestMetrics{
+		RequestDurationMS: int64(duration / time.Millisecond),
+		RequestID:         requestID,
+	}:
+	default:
+	}
+}
+
+// addTelemetryHeader sets the X-Stripe-Client-Telemetry header of req to the
+// metrics of a previous request, if any are waiting to be sent.
+func addTelemetryHeader(req *http.Request) {
+	select {
+	case metrics := <-prevRequestMetrics:
+		data, err := json.Marshal(&requestTelemetry{LastRequestMetrics: metrics})
+		if err == nil {
+			req.Header.Set("X-Stripe-Client-Telemetry", string(data))
+		}
+	default:
+	}
+}
@@ -0,0 +1,70 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+// drainTelemetry discards the metrics left over by other tests.
+func drainTelemetry() {
+	for {
+		select {
+		case <-prevRequestMetrics:
+		default:
+			return
+		}
+	}
+}
+
+func TestTelemetry(t *testing.T) {
+	drainTelemetry()
+
+	var headers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("X-Stripe-Client-Telemetry"))
+		w.Header().Set("Request-Id", "req_123")
+		w.Write([]byte(`{"id":"ch_123"}`))
+	}))
+	defer server.Close()
+
+	c := &BackendConfiguration{URL: server.URL + "/v1", HTTPClient: http.DefaultClient, EnableTelemetry: true}
+
+	err := c.Call("GET", "/charges/ch_123", "", nil, nil, &Charge{})
+	assert.NoError(t, err)
+	err = c.Call("GET", "/charges/ch_123", "", nil, nil, &Charge{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(headers))
+	assert.Equal(t, "", headers[0])
+
+	var telemetry requestTelemetry
+	err = json.Unmarshal([]byte(headers[1]), &telemetry)
+	assert.NoError(t, err)
+	assert.Equal(t, "req_123", telemetry.LastRequestMetrics.RequestID)
+	assert.True(t, telemetry.LastRequestMetrics.RequestDurationMS >= 0)
+}
+
+func TestTelemetryDisabled(t *testing.T) {
+	drainTelemetry()
+
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Stripe-Client-Telemetry")
+		w.Header().Set("Request-Id", "req_123")
+		w.Write([]byte(`{"id":"ch_123"}`))
+	}))
+	defer server.Close()
+
+	c := &BackendConfiguration{URL: server.URL + "/v1", HTTPClient: http.DefaultClient}
+
+	for i := 0; i < 2; i++ {
+		err := c.Call("GET", "/charges/ch_123", "", nil, nil, &Charge{})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, "", header)
+	assert.Equal(t, 0, len(prevRequestMetrics))
+}
@@ -0,0 +1,40 @@
+// Package connectiontoken provides the /terminal/connection_tokens APIs
+package connectiontoken
+
+import (
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /terminal/connection_tokens APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new connection token. Connection tokens are short-lived and
+// should be requested each time a Stripe Terminal SDK asks for one.
+// For more details see https://stripe.com/docs/api#create_terminal_connection_token.
+func New(params *stripe.TerminalConnectionTokenParams) (*stripe.TerminalConnectionToken, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TerminalConnectionTokenParams) (*stripe.TerminalConnectionToken, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	connectionToken := &stripe.TerminalConnectionToken{}
+	err := c.B.Call("POST", "/terminal/connection_tokens", c.Key, body, commonParams, connectionToken)
+
+	return connectionToken, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,17 @@
+package connectiontoken
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestTerminalConnectionTokenNew(t *testing.T) {
+	connectionToken, err := New(&stripe.TerminalConnectionTokenParams{
+		Location: "tml_123",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, connectionToken)
+}
@@ -0,0 +1,151 @@
+// Package location provides the /terminal/locations APIs
+package location
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /terminal/locations APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new location.
+// For more details see https://stripe.com/docs/api#create_terminal_location.
+func New(params *stripe.TerminalLocationParams) (*stripe.TerminalLocation, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TerminalLocationParams) (*stripe.TerminalLocation, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	terminalLocation := &stripe.TerminalLocation{}
+	err := c.B.Call("POST", "/terminal/locations", c.Key, body, commonParams, terminalLocation)
+
+	return terminalLocation, err
+}
+
+// Get returns the details of a location.
+// For more details see https://stripe.com/docs/api#retrieve_terminal_location.
+func Get(id string, params *stripe.TerminalLocationParams) (*stripe.TerminalLocation, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TerminalLocationParams) (*stripe.TerminalLocation, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	terminalLocation := &stripe.TerminalLocation{}
+	err := c.B.Call("GET", fmt.Sprintf("/terminal/locations/%v", id), c.Key, body, commonParams, terminalLocation)
+
+	return terminalLocation, err
+}
+
+// Update updates a location's properties.
+// For more details see https://stripe.com/docs/api#update_terminal_location.
+func Update(id string, params *stripe.TerminalLocationParams) (*stripe.TerminalLocation, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.TerminalLocationParams) (*stripe.TerminalLocation, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	terminalLocation := &stripe.TerminalLocation{}
+	err := c.B.Call("POST", fmt.Sprintf("/terminal/locations/%v", id), c.Key, body, commonParams, terminalLocation)
+
+	return terminalLocation, err
+}
+
+// Del removes a location.
+// For more details see https://stripe.com/docs/api#delete_terminal_location.
+func Del(id string, params *stripe.TerminalLocationParams) (*stripe.TerminalLocation, error) {
+	return getC().Del(id, params)
+}
+
+func (c Client) Del(id string, params *stripe.TerminalLocationParams) (*stripe.TerminalLocation, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	terminalLocation := &stripe.TerminalLocation{}
+	err := c.B.Call("DELETE", fmt.Sprintf("/terminal/locations/%v", id), c.Key, body, commonParams, terminalLocation)
+
+	return terminalLocation, err
+}
+
+// List returns a list of locations.
+// For more details see https://stripe.com/docs/api#list_terminal_locations.
+func List(params *stripe.TerminalLocationListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.TerminalLocationListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TerminalLocationList{}
+		err := c.B.Call("GET", "/terminal/locations", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of TerminalLocations.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// TerminalLocation returns the most recent TerminalLocation
+// visited by a call to Next.
+func (i *Iter) TerminalLocation() *stripe.TerminalLocation {
+	return i.Current().(*stripe.TerminalLocation)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,53 @@
+package location
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestTerminalLocationDel(t *testing.T) {
+	location, err := Del("tml_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, location)
+}
+
+func TestTerminalLocationGet(t *testing.T) {
+	location, err := Get("tml_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, location)
+}
+
+func TestTerminalLocationList(t *testing.T) {
+	i := List(&stripe.TerminalLocationListParams{})
+
+	// Verify that we can get at least one location
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.TerminalLocation())
+}
+
+func TestTerminalLocationNew(t *testing.T) {
+	location, err := New(&stripe.TerminalLocationParams{
+		Address: &stripe.AddressParams{
+			City:       "San Francisco",
+			Country:    "US",
+			Line1:      "510 Townsend St",
+			PostalCode: "94103",
+			State:      "CA",
+		},
+		DisplayName: "HQ",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, location)
+}
+
+func TestTerminalLocationUpdate(t *testing.T) {
+	location, err := Update("tml_123", &stripe.TerminalLocationParams{
+		DisplayName: "Headquarters",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, location)
+}
@@ -0,0 +1,211 @@
+// Package reader provides the /terminal/readers APIs
+package reader
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	ActionStatusFailed     stripe.TerminalReaderActionStatus = "failed"
+	ActionStatusInProgress stripe.TerminalReaderActionStatus = "in_progress"
+	ActionStatusSucceeded  stripe.TerminalReaderActionStatus = "succeeded"
+
+	ActionTypeProcessPaymentIntent stripe.TerminalReaderActionType = "process_payment_intent"
+
+	DeviceTypeBBPOSChipper2X stripe.TerminalReaderDeviceType = "bbpos_chipper2x"
+	DeviceTypeBBPOSWisePOSE  stripe.TerminalReaderDeviceType = "bbpos_wisepos_e"
+	DeviceTypeVerifoneP400   stripe.TerminalReaderDeviceType = "verifone_P400"
+
+	StatusOffline stripe.TerminalReaderStatus = "offline"
+	StatusOnline  stripe.TerminalReaderStatus = "online"
+)
+
+// Client is used to invoke /terminal/readers APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New registers a new reader.
+// For more details see https://stripe.com/docs/api#create_terminal_reader.
+func New(params *stripe.TerminalReaderParams) (*stripe.TerminalReader, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TerminalReaderParams) (*stripe.TerminalReader, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	terminalReader := &stripe.TerminalReader{}
+	err := c.B.Call("POST", "/terminal/readers", c.Key, body, commonParams, terminalReader)
+
+	return terminalReader, err
+}
+
+// Get returns the details of a reader.
+// For more details see https://stripe.com/docs/api#retrieve_terminal_reader.
+func Get(id string, params *stripe.TerminalReaderParams) (*stripe.TerminalReader, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TerminalReaderParams) (*stripe.TerminalReader, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	terminalReader := &stripe.TerminalReader{}
+	err := c.B.Call("GET", fmt.Sprintf("/terminal/readers/%v", id), c.Key, body, commonParams, terminalReader)
+
+	return terminalReader, err
+}
+
+// Update updates a reader's properties.
+// For more details see https://stripe.com/docs/api#update_terminal_reader.
+func Update(id string, params *stripe.TerminalReaderParams) (*stripe.TerminalReader, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.TerminalReaderParams) (*stripe.TerminalReader, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	terminalReader := &stripe.TerminalReader{}
+	err := c.B.Call("POST", fmt.Sprintf("/terminal/readers/%v", id), c.Key, body, commonParams, terminalReader)
+
+	return terminalReader, err
+}
+
+// Del removes a reader.
+// For more details see https://stripe.com/docs/api#delete_terminal_reader.
+func Del(id string, params *stripe.TerminalReaderParams) (*stripe.TerminalReader, error) {
+	return getC().Del(id, params)
+}
+
+func (c Client) Del(id string, params *stripe.TerminalReaderParams) (*stripe.TerminalReader, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	terminalReader := &stripe.TerminalReader{}
+	err := c.B.Call("DELETE", fmt.Sprintf("/terminal/readers/%v", id), c.Key, body, commonParams, terminalReader)
+
+	return terminalReader, err
+}
+
+// ProcessPaymentIntent hands a payment intent to a reader, which prompts the
+// customer to present their card.
+// For more details see https://stripe.com/docs/api#process_payment_intent_terminal_reader.
+func ProcessPaymentIntent(id string, params *stripe.TerminalReaderProcessPaymentIntentParams) (*stripe.TerminalReader, error) {
+	return getC().ProcessPaymentIntent(id, params)
+}
+
+func (c Client) ProcessPaymentIntent(id string, params *stripe.TerminalReaderProcessPaymentIntentParams) (*stripe.TerminalReader, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	terminalReader := &stripe.TerminalReader{}
+	err := c.B.Call("POST", fmt.Sprintf("/terminal/readers/%v/process_payment_intent", id), c.Key, body, commonParams, terminalReader)
+
+	return terminalReader, err
+}
+
+// CancelAction cancels the action in progress on a reader.
+// For more details see https://stripe.com/docs/api#cancel_action_terminal_reader.
+func CancelAction(id string, params *stripe.TerminalReaderCancelActionParams) (*stripe.TerminalReader, error) {
+	return getC().CancelAction(id, params)
+}
+
+func (c Client) CancelAction(id string, params *stripe.TerminalReaderCancelActionParams) (*stripe.TerminalReader, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	terminalReader := &stripe.TerminalReader{}
+	err := c.B.Call("POST", fmt.Sprintf("/terminal/readers/%v/cancel_action", id), c.Key, body, commonParams, terminalReader)
+
+	return terminalReader, err
+}
+
+// List returns a list of readers.
+// For more details see https://stripe.com/docs/api#list_terminal_readers.
+func List(params *stripe.TerminalReaderListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.TerminalReaderListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TerminalReaderList{}
+		err := c.B.Call("GET", "/terminal/readers", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of TerminalReaders.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// TerminalReader returns the most recent TerminalReader
+// visited by a call to Next.
+func (i *Iter) TerminalReader() *stripe.TerminalReader {
+	return i.Current().(*stripe.TerminalReader)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,65 @@
+package reader
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestTerminalReaderCancelAction(t *testing.T) {
+	reader, err := CancelAction("tmr_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, reader)
+}
+
+func TestTerminalReaderDel(t *testing.T) {
+	reader, err := Del("tmr_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, reader)
+}
+
+func TestTerminalReaderGet(t *testing.T) {
+	reader, err := Get("tmr_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, reader)
+}
+
+func TestTerminalReaderList(t *testing.T) {
+	i := List(&stripe.TerminalReaderListParams{Location: "tml_123"})
+
+	// Verify that we can get at least one reader
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.TerminalReader())
+}
+
+func TestTerminalReaderNew(t *testing.T) {
+	reader, err := New(&stripe.TerminalReaderParams{
+		Label:            "Front desk",
+		Location:         "tml_123",
+		RegistrationCode: "puppies-plug-could",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, reader)
+}
+
+func TestTerminalReaderProcessPaymentIntent(t *testing.T) {
+	reader, err := ProcessPaymentIntent("tmr_123", &stripe.TerminalReaderProcessPaymentIntentParams{
+		PaymentIntent: "pi_123",
+		ProcessConfig: &stripe.TerminalReaderProcessConfigParams{
+			SkipTipping: true,
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, reader)
+}
+
+func TestTerminalReaderUpdate(t *testing.T) {
+	reader, err := Update("tmr_123", &stripe.TerminalReaderParams{
+		Label: "Back office",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, reader)
+}
@@ -0,0 +1,19 @@
+package stripe
+
+// TerminalConnectionTokenParams is the set of parameters that can be used
+// when creating a terminal connection token.
+// For more details see https://stripe.com/docs/api#create_terminal_connection_token.
+type TerminalConnectionTokenParams struct {
+	Params   `form:"*"`
+	Location string `form:"location"`
+}
+
+// TerminalConnectionToken is the resource representing a Stripe terminal
+// connection token. Its secret is handed to a Stripe Terminal SDK so that it
+// can connect to a reader.
+// For more details see https://stripe.com/docs/api#terminal_connection_tokens.
+type TerminalConnectionToken struct {
+	APIResource
+	Location string `json:"location"`
+	Secret   string `json:"secret"`
+}
@@ -0,0 +1,59 @@
+package stripe
+
+import "encoding/json"
+
+// TerminalLocationParams is the set of parameters that can be used when
+// creating or updating a terminal location.
+// For more details see https://stripe.com/docs/api#create_terminal_location and https://stripe.com/docs/api#update_terminal_location.
+type TerminalLocationParams struct {
+	Params      `form:"*"`
+	Address     *AddressParams `form:"address"`
+	DisplayName string         `form:"display_name"`
+}
+
+// TerminalLocationListParams is the set of parameters that can be used when
+// listing terminal locations.
+// For more details see https://stripe.com/docs/api#list_terminal_locations.
+type TerminalLocationListParams struct {
+	ListParams `form:"*"`
+}
+
+// TerminalLocation is the resource representing a Stripe terminal location.
+// For more details see https://stripe.com/docs/api#terminal_locations.
+type TerminalLocation struct {
+	APIResource
+	Address     *Address          `json:"address"`
+	Deleted     bool              `json:"deleted"`
+	DisplayName string            `json:"display_name"`
+	ID          string            `json:"id"`
+	Live        bool              `json:"livemode"`
+	Meta        map[string]string `json:"metadata"`
+}
+
+// TerminalLocationList is a list of terminal locations as retrieved from a
+// list endpoint.
+type TerminalLocationList struct {
+	APIResource
+	ListMeta
+	Values []*TerminalLocation `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a TerminalLocation.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (l *TerminalLocation) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		l.ID = id
+		return nil
+	}
+
+	type terminalLocation TerminalLocation
+	var ll terminalLocation
+	err := json.Unmarshal(data, &ll)
+	if err != nil {
+		return err
+	}
+
+	*l = TerminalLocation(ll)
+	return nil
+}
@@ -0,0 +1,126 @@
+package stripe
+
+import "encoding/json"
+
+// TerminalReaderActionStatus is the list of allowed values for the status of
+// an action on a terminal reader. Allowed values are "failed", "in_progress",
+// and "succeeded".
+type TerminalReaderActionStatus string
+
+// TerminalReaderActionType is the list of allowed values for the type of an
+// action on a terminal reader. Allowed values are "process_payment_intent".
+type TerminalReaderActionType string
+
+// TerminalReaderDeviceType is the list of allowed values for the device type
+// of a terminal reader. Allowed values are "bbpos_chipper2x", "bbpos_wisepos_e",
+// and "verifone_P400".
+type TerminalReaderDeviceType string
+
+// TerminalReaderStatus is the list of allowed values for the network status
+// of a terminal reader. Allowed values are "offline" and "online".
+type TerminalReaderStatus string
+
+// TerminalReaderParams is the set of parameters that can be used when
+// registering or updating a terminal reader. Location and RegistrationCode
+// can only be set when registering a reader.
+// For more details see https://stripe.com/docs/api#create_terminal_reader and https://stripe.com/docs/api#update_terminal_reader.
+type TerminalReaderParams struct {
+	Params           `form:"*"`
+	Label            string `form:"label"`
+	Location         string `form:"location"`
+	RegistrationCode string `form:"registration_code"`
+}
+
+// TerminalReaderListParams is the set of parameters that can be used when
+// listing terminal readers.
+// For more details see https://stripe.com/docs/api#list_terminal_readers.
+type TerminalReaderListParams struct {
+	ListParams `form:"*"`
+	DeviceType TerminalReaderDeviceType `form:"device_type"`
+	Location   string                   `form:"location"`
+	Status     TerminalReaderStatus     `form:"status"`
+}
+
+// TerminalReaderProcessConfigParams is the set of parameters that configure
+// how a terminal reader processes a payment intent.
+type TerminalReaderProcessConfigParams struct {
+	SkipTipping bool `form:"skip_tipping"`
+}
+
+// TerminalReaderProcessPaymentIntentParams is the set of parameters that can
+// be used to hand a payment intent to a terminal reader for processing.
+// For more details see https://stripe.com/docs/api#process_payment_intent_terminal_reader.
+type TerminalReaderProcessPaymentIntentParams struct {
+	Params        `form:"*"`
+	PaymentIntent string                             `form:"payment_intent"`
+	ProcessConfig *TerminalReaderProcessConfigParams `form:"process_config"`
+}
+
+// TerminalReaderCancelActionParams is the set of parameters that can be used
+// to cancel the action in progress on a terminal reader.
+// For more details see https://stripe.com/docs/api#cancel_action_terminal_reader.
+type TerminalReaderCancelActionParams struct {
+	Params `form:"*"`
+}
+
+// TerminalReaderProcessPaymentIntent contains the details of a payment
+// intent being processed by a terminal reader.
+type TerminalReaderProcessPaymentIntent struct {
+	PaymentIntent string `json:"payment_intent"`
+}
+
+// TerminalReaderAction represents the action in progress on a terminal
+// reader, or the last action that it completed.
+type TerminalReaderAction struct {
+	FailureCode          string                              `json:"failure_code"`
+	FailureMessage       string                              `json:"failure_message"`
+	ProcessPaymentIntent *TerminalReaderProcessPaymentIntent `json:"process_payment_intent"`
+	Status               TerminalReaderActionStatus          `json:"status"`
+	Type                 TerminalReaderActionType            `json:"type"`
+}
+
+// TerminalReader is the resource representing a Stripe terminal reader.
+// For more details see https://stripe.com/docs/api#terminal_readers.
+type TerminalReader struct {
+	APIResource
+	Action          *TerminalReaderAction    `json:"action"`
+	Deleted         bool                     `json:"deleted"`
+	DeviceSwVersion string                   `json:"device_sw_version"`
+	DeviceType      TerminalReaderDeviceType `json:"device_type"`
+	ID              string                   `json:"id"`
+	IPAddress       string                   `json:"ip_address"`
+	Label           string                   `json:"label"`
+	Live            bool                     `json:"livemode"`
+	Location        *TerminalLocation        `json:"location"`
+	Meta            map[string]string        `json:"metadata"`
+	SerialNumber    string                   `json:"serial_number"`
+	Status          TerminalReaderStatus     `json:"status"`
+}
+
+// TerminalReaderList is a list of terminal readers as retrieved from a list
+// endpoint.
+type TerminalReaderList struct {
+	APIResource
+	ListMeta
+	Values []*TerminalReader `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a TerminalReader.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (r *TerminalReader) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		r.ID = id
+		return nil
+	}
+
+	type terminalReader TerminalReader
+	var rr terminalReader
+	err := json.Unmarshal(data, &rr)
+	if err != nil {
+		return err
+	}
+
+	*r = TerminalReader(rr)
+	return nil
+}
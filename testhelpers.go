@@ -0,0 +1,94 @@
+package stripe
+
+// TestHelpersIssuingMerchantDataParams is the set of parameters describing
+// the merchant of a test issuing authorization or transaction.
+type TestHelpersIssuingMerchantDataParams struct {
+	Category   string `form:"category"`
+	City       string `form:"city"`
+	Country    string `form:"country"`
+	Name       string `form:"name"`
+	NetworkID  string `form:"network_id"`
+	PostalCode string `form:"postal_code"`
+	State      string `form:"state"`
+}
+
+// TestHelpersIssuingAuthorizationParams is the set of parameters that can be
+// used when creating a test issuing authorization.
+// For more details see https://stripe.com/docs/api/issuing/authorizations/test_mode_create.
+type TestHelpersIssuingAuthorizationParams struct {
+	Params              `form:"*"`
+	Amount              int64                                 `form:"amount"`
+	AuthorizationMethod IssuingAuthorizationMethod            `form:"authorization_method"`
+	Card                string                                `form:"card"`
+	Currency            Currency                              `form:"currency"`
+	MerchantData        *TestHelpersIssuingMerchantDataParams `form:"merchant_data"`
+	Wallet              string                                `form:"wallet"`
+}
+
+// TestHelpersIssuingAuthorizationCaptureParams is the set of parameters that
+// can be used when capturing a test issuing authorization.
+// For more details see https://stripe.com/docs/api/issuing/authorizations/test_mode_capture.
+type TestHelpersIssuingAuthorizationCaptureParams struct {
+	Params             `form:"*"`
+	CaptureAmount      int64 `form:"capture_amount"`
+	CloseAuthorization bool  `form:"close_authorization"`
+}
+
+// TestHelpersIssuingAuthorizationExpireParams is the set of parameters that
+// can be used when expiring a test issuing authorization.
+// For more details see https://stripe.com/docs/api/issuing/authorizations/test_mode_expire.
+type TestHelpersIssuingAuthorizationExpireParams struct {
+	Params `form:"*"`
+}
+
+// TestHelpersIssuingAuthorizationReverseParams is the set of parameters that
+// can be used when reversing a test issuing authorization.
+// For more details see https://stripe.com/docs/api/issuing/authorizations/test_mode_reverse.
+type TestHelpersIssuingAuthorizationReverseParams struct {
+	Params        `form:"*"`
+	ReverseAmount int64 `form:"reverse_amount"`
+}
+
+// TestHelpersIssuingCardShippingParams is the set of parameters that can be
+// used when moving the shipment of a test issuing card to another status.
+// For more details see https://stripe.com/docs/api/issuing/cards/test_mode_ship.
+type TestHelpersIssuingCardShippingParams struct {
+	Params `form:"*"`
+}
+
+// TestHelpersIssuingTransactionParams is the set of parameters that can be
+// used when creating a test issuing transaction that isn't linked to an
+// authorization, either a force capture or an unlinked refund.
+// For more details see https://stripe.com/docs/api/issuing/transactions/test_mode_create_force_capture.
+type TestHelpersIssuingTransactionParams struct {
+	Params       `form:"*"`
+	Amount       int64                                 `form:"amount"`
+	Card         string                                `form:"card"`
+	Currency     Currency                              `form:"currency"`
+	MerchantData *TestHelpersIssuingMerchantDataParams `form:"merchant_data"`
+}
+
+// TestHelpersIssuingTransactionRefundParams is the set of parameters that can
+// be used when refunding a test issuing transaction.
+// For more details see https://stripe.com/docs/api/issuing/transactions/test_mode_refund.
+type TestHelpersIssuingTransactionRefundParams struct {
+	Params       `form:"*"`
+	RefundAmount int64 `form:"refund_amount"`
+}
+
+// TestHelpersTerminalReaderCardPresentParams is the set of parameters
+// describing the card presented to a test terminal reader.
+type TestHelpersTerminalReaderCardPresentParams struct {
+	Number string `form:"number"`
+}
+
+// TestHelpersTerminalReaderPresentPaymentMethodParams is the set of
+// parameters that can be used when presenting a payment method to a test
+// terminal reader.
+// For more details see https://stripe.com/docs/api/terminal/readers/present_payment_method.
+type TestHelpersTerminalReaderPresentPaymentMethodParams struct {
+	Params      `form:"*"`
+	AmountTip   int64                                       `form:"amount_tip"`
+	CardPresent *TestHelpersTerminalReaderCardPresentParams `form:"card_present"`
+	Type        string                                      `form:"type"`
+}
@@ -0,0 +1,110 @@
+// Package authorization provides the /test_helpers/issuing/authorizations
+// APIs, which simulate card activity on issuing authorizations in test mode.
+package authorization
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /test_helpers/issuing/authorizations APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New creates a test issuing authorization, as if the card had been used
+// with a merchant.
+// For more details see https://stripe.com/docs/api/issuing/authorizations/test_mode_create.
+func New(params *stripe.TestHelpersIssuingAuthorizationParams) (*stripe.IssuingAuthorization, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TestHelpersIssuingAuthorizationParams) (*stripe.IssuingAuthorization, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	authorization := &stripe.IssuingAuthorization{}
+	err := c.B.Call("POST", "/test_helpers/issuing/authorizations", c.Key, body, commonParams, authorization)
+
+	return authorization, err
+}
+
+// Capture captures a test issuing authorization, which creates its
+// transaction.
+// For more details see https://stripe.com/docs/api/issuing/authorizations/test_mode_capture.
+func Capture(id string, params *stripe.TestHelpersIssuingAuthorizationCaptureParams) (*stripe.IssuingAuthorization, error) {
+	return getC().Capture(id, params)
+}
+
+func (c Client) Capture(id string, params *stripe.TestHelpersIssuingAuthorizationCaptureParams) (*stripe.IssuingAuthorization, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	authorization := &stripe.IssuingAuthorization{}
+	err := c.B.Call("POST", fmt.Sprintf("/test_helpers/issuing/authorizations/%v/capture", id), c.Key, body, commonParams, authorization)
+
+	return authorization, err
+}
+
+// Expire expires a test issuing authorization.
+// For more details see https://stripe.com/docs/api/issuing/authorizations/test_mode_expire.
+func Expire(id string, params *stripe.TestHelpersIssuingAuthorizationExpireParams) (*stripe.IssuingAuthorization, error) {
+	return getC().Expire(id, params)
+}
+
+func (c Client) Expire(id string, params *stripe.TestHelpersIssuingAuthorizationExpireParams) (*stripe.IssuingAuthorization, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	authorization := &stripe.IssuingAuthorization{}
+	err := c.B.Call("POST", fmt.Sprintf("/test_helpers/issuing/authorizations/%v/expire", id), c.Key, body, commonParams, authorization)
+
+	return authorization, err
+}
+
+// Reverse reverses all or part of a test issuing authorization.
+// For more details see https://stripe.com/docs/api/issuing/authorizations/test_mode_reverse.
+func Reverse(id string, params *stripe.TestHelpersIssuingAuthorizationReverseParams) (*stripe.IssuingAuthorization, error) {
+	return getC().Reverse(id, params)
+}
+
+func (c Client) Reverse(id string, params *stripe.TestHelpersIssuingAuthorizationReverseParams) (*stripe.IssuingAuthorization, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	authorization := &stripe.IssuingAuthorization{}
+	err := c.B.Call("POST", fmt.Sprintf("/test_helpers/issuing/authorizations/%v/reverse", id), c.Key, body, commonParams, authorization)
+
+	return authorization, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
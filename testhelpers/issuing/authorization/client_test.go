@@ -0,0 +1,62 @@
+package authorization
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestTestHelpersIssuingAuthorizationCapture(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/issuing/authorizations/iauth_123/capture", `{"id": "iauth_123", "status": "closed"}`)
+
+	authorization, err := Client{B: b}.Capture("iauth_123", &stripe.TestHelpersIssuingAuthorizationCaptureParams{
+		CaptureAmount: 500,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.IssuingAuthorizationStatus("closed"), authorization.Status)
+	assert.Equal(t, "500", b.LastCall().Values().Get("capture_amount"))
+}
+
+func TestTestHelpersIssuingAuthorizationExpire(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/issuing/authorizations/iauth_123/expire", `{"id": "iauth_123"}`)
+
+	authorization, err := Client{B: b}.Expire("iauth_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "iauth_123", authorization.ID)
+}
+
+func TestTestHelpersIssuingAuthorizationNew(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/issuing/authorizations", `{"id": "iauth_123", "amount": 1000, "status": "pending"}`)
+
+	authorization, err := Client{B: b}.New(&stripe.TestHelpersIssuingAuthorizationParams{
+		Amount: 1000,
+		Card:   "ic_123",
+		MerchantData: &stripe.TestHelpersIssuingMerchantDataParams{
+			Category: "taxicabs_limousines",
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1000), authorization.Amount)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "ic_123", values.Get("card"))
+	assert.Equal(t, "taxicabs_limousines", values.Get("merchant_data[category]"))
+}
+
+func TestTestHelpersIssuingAuthorizationReverse(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/issuing/authorizations/iauth_123/reverse", `{"id": "iauth_123", "status": "reversed"}`)
+
+	authorization, err := Client{B: b}.Reverse("iauth_123", &stripe.TestHelpersIssuingAuthorizationReverseParams{
+		ReverseAmount: 200,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.IssuingAuthorizationStatus("reversed"), authorization.Status)
+	assert.Equal(t, "200", b.LastCall().Values().Get("reverse_amount"))
+}
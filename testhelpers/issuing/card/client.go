@@ -0,0 +1,76 @@
+// Package card provides the /test_helpers/issuing/cards APIs, which simulate
+// the shipment of physical issuing cards in test mode.
+package card
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /test_helpers/issuing/cards APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Deliver marks the shipment of a test physical card as delivered.
+// For more details see https://stripe.com/docs/api/issuing/cards/test_mode_deliver.
+func Deliver(id string, params *stripe.TestHelpersIssuingCardShippingParams) (*stripe.IssuingCard, error) {
+	return getC().Deliver(id, params)
+}
+
+func (c Client) Deliver(id string, params *stripe.TestHelpersIssuingCardShippingParams) (*stripe.IssuingCard, error) {
+	return c.shipping(id, "deliver", params)
+}
+
+// Fail marks the shipment of a test physical card as failed.
+// For more details see https://stripe.com/docs/api/issuing/cards/test_mode_fail.
+func Fail(id string, params *stripe.TestHelpersIssuingCardShippingParams) (*stripe.IssuingCard, error) {
+	return getC().Fail(id, params)
+}
+
+func (c Client) Fail(id string, params *stripe.TestHelpersIssuingCardShippingParams) (*stripe.IssuingCard, error) {
+	return c.shipping(id, "fail", params)
+}
+
+// Return marks the shipment of a test physical card as returned.
+// For more details see https://stripe.com/docs/api/issuing/cards/test_mode_return.
+func Return(id string, params *stripe.TestHelpersIssuingCardShippingParams) (*stripe.IssuingCard, error) {
+	return getC().Return(id, params)
+}
+
+func (c Client) Return(id string, params *stripe.TestHelpersIssuingCardShippingParams) (*stripe.IssuingCard, error) {
+	return c.shipping(id, "return", params)
+}
+
+// Ship marks the shipment of a test physical card as shipped.
+// For more details see https://stripe.com/docs/api/issuing/cards/test_mode_ship.
+func Ship(id string, params *stripe.TestHelpersIssuingCardShippingParams) (*stripe.IssuingCard, error) {
+	return getC().Ship(id, params)
+}
+
+func (c Client) Ship(id string, params *stripe.TestHelpersIssuingCardShippingParams) (*stripe.IssuingCard, error) {
+	return c.shipping(id, "ship", params)
+}
+
+func (c Client) shipping(id, action string, params *stripe.TestHelpersIssuingCardShippingParams) (*stripe.IssuingCard, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	issuingCard := &stripe.IssuingCard{}
+	err := c.B.Call("POST", fmt.Sprintf("/test_helpers/issuing/cards/%v/shipping/%v", id, action), c.Key, body, commonParams, issuingCard)
+
+	return issuingCard, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,46 @@
+package card
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestTestHelpersIssuingCardDeliver(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/issuing/cards/ic_123/shipping/deliver", `{"id": "ic_123", "shipping": {"status": "delivered"}}`)
+
+	card, err := Client{B: b}.Deliver("ic_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.IssuingCardShippingStatus("delivered"), card.Shipping.Status)
+}
+
+func TestTestHelpersIssuingCardFail(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/issuing/cards/ic_123/shipping/fail", `{"id": "ic_123", "shipping": {"status": "failure"}}`)
+
+	card, err := Client{B: b}.Fail("ic_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.IssuingCardShippingStatus("failure"), card.Shipping.Status)
+}
+
+func TestTestHelpersIssuingCardReturn(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/issuing/cards/ic_123/shipping/return", `{"id": "ic_123", "shipping": {"status": "returned"}}`)
+
+	card, err := Client{B: b}.Return("ic_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.IssuingCardShippingStatus("returned"), card.Shipping.Status)
+}
+
+func TestTestHelpersIssuingCardShip(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/issuing/cards/ic_123/shipping/ship", `{"id": "ic_123", "shipping": {"status": "shipped"}}`)
+
+	card, err := Client{B: b}.Ship("ic_123", &stripe.TestHelpersIssuingCardShippingParams{})
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.IssuingCardShippingStatus("shipped"), card.Shipping.Status)
+}
@@ -0,0 +1,81 @@
+// Package transaction provides the /test_helpers/issuing/transactions APIs,
+// which simulate issuing transactions in test mode.
+package transaction
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /test_helpers/issuing/transactions APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// CreateForceCapture creates a test issuing transaction that isn't linked to
+// an authorization, as if a merchant had captured a payment without asking
+// for one.
+// For more details see https://stripe.com/docs/api/issuing/transactions/test_mode_create_force_capture.
+func CreateForceCapture(params *stripe.TestHelpersIssuingTransactionParams) (*stripe.IssuingTransaction, error) {
+	return getC().CreateForceCapture(params)
+}
+
+func (c Client) CreateForceCapture(params *stripe.TestHelpersIssuingTransactionParams) (*stripe.IssuingTransaction, error) {
+	return c.create("/test_helpers/issuing/transactions/create_force_capture", params)
+}
+
+// CreateUnlinkedRefund creates a test issuing refund transaction that isn't
+// linked to an earlier transaction.
+// For more details see https://stripe.com/docs/api/issuing/transactions/test_mode_create_unlinked_refund.
+func CreateUnlinkedRefund(params *stripe.TestHelpersIssuingTransactionParams) (*stripe.IssuingTransaction, error) {
+	return getC().CreateUnlinkedRefund(params)
+}
+
+func (c Client) CreateUnlinkedRefund(params *stripe.TestHelpersIssuingTransactionParams) (*stripe.IssuingTransaction, error) {
+	return c.create("/test_helpers/issuing/transactions/create_unlinked_refund", params)
+}
+
+// Refund refunds all or part of a test issuing transaction.
+// For more details see https://stripe.com/docs/api/issuing/transactions/test_mode_refund.
+func Refund(id string, params *stripe.TestHelpersIssuingTransactionRefundParams) (*stripe.IssuingTransaction, error) {
+	return getC().Refund(id, params)
+}
+
+func (c Client) Refund(id string, params *stripe.TestHelpersIssuingTransactionRefundParams) (*stripe.IssuingTransaction, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	transaction := &stripe.IssuingTransaction{}
+	err := c.B.Call("POST", fmt.Sprintf("/test_helpers/issuing/transactions/%v/refund", id), c.Key, body, commonParams, transaction)
+
+	return transaction, err
+}
+
+func (c Client) create(path string, params *stripe.TestHelpersIssuingTransactionParams) (*stripe.IssuingTransaction, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	transaction := &stripe.IssuingTransaction{}
+	err := c.B.Call("POST", path, c.Key, body, commonParams, transaction)
+
+	return transaction, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,47 @@
+package transaction
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestTestHelpersIssuingTransactionCreateForceCapture(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/issuing/transactions/create_force_capture", `{"id": "ipi_123", "amount": -1000}`)
+
+	transaction, err := Client{B: b}.CreateForceCapture(&stripe.TestHelpersIssuingTransactionParams{
+		Amount: 1000,
+		Card:   "ic_123",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-1000), transaction.Amount)
+	assert.Equal(t, "ic_123", b.LastCall().Values().Get("card"))
+}
+
+func TestTestHelpersIssuingTransactionCreateUnlinkedRefund(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/issuing/transactions/create_unlinked_refund", `{"id": "ipi_123", "type": "refund"}`)
+
+	transaction, err := Client{B: b}.CreateUnlinkedRefund(&stripe.TestHelpersIssuingTransactionParams{
+		Amount: 1000,
+		Card:   "ic_123",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.IssuingTransactionType("refund"), transaction.Type)
+}
+
+func TestTestHelpersIssuingTransactionRefund(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/issuing/transactions/ipi_123/refund", `{"id": "ipi_123"}`)
+
+	transaction, err := Client{B: b}.Refund("ipi_123", &stripe.TestHelpersIssuingTransactionRefundParams{
+		RefundAmount: 300,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "ipi_123", transaction.ID)
+	assert.Equal(t, "300", b.LastCall().Values().Get("refund_amount"))
+}
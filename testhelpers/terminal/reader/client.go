@@ -0,0 +1,44 @@
+// Package reader provides the /test_helpers/terminal/readers APIs, which
+// simulate cardholder activity on terminal readers in test mode.
+package reader
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /test_helpers/terminal/readers APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// PresentPaymentMethod presents a test card to a simulated reader, which
+// completes the action it's waiting on, like the one started by
+// reader.ProcessPaymentIntent.
+// For more details see https://stripe.com/docs/api/terminal/readers/present_payment_method.
+func PresentPaymentMethod(id string, params *stripe.TestHelpersTerminalReaderPresentPaymentMethodParams) (*stripe.TerminalReader, error) {
+	return getC().PresentPaymentMethod(id, params)
+}
+
+func (c Client) PresentPaymentMethod(id string, params *stripe.TestHelpersTerminalReaderPresentPaymentMethodParams) (*stripe.TerminalReader, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	terminalReader := &stripe.TerminalReader{}
+	err := c.B.Call("POST", fmt.Sprintf("/test_helpers/terminal/readers/%v/present_payment_method", id), c.Key, body, commonParams, terminalReader)
+
+	return terminalReader, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
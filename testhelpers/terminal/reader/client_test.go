@@ -0,0 +1,28 @@
+package reader
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestTestHelpersTerminalReaderPresentPaymentMethod(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/terminal/readers/tmr_123/present_payment_method", `{
+		"id": "tmr_123",
+		"action": {"status": "succeeded", "type": "process_payment_intent"}
+	}`)
+
+	reader, err := Client{B: b}.PresentPaymentMethod("tmr_123", &stripe.TestHelpersTerminalReaderPresentPaymentMethodParams{
+		CardPresent: &stripe.TestHelpersTerminalReaderCardPresentParams{
+			Number: "4242424242424242",
+		},
+		Type: "card_present",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.TerminalReaderActionStatus("succeeded"), reader.Action.Status)
+	assert.Equal(t, "4242424242424242", b.LastCall().Values().Get("card_present[number]"))
+}
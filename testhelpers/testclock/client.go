@@ -0,0 +1,180 @@
+// Package testclock provides the /test_helpers/test_clocks APIs
+package testclock
+
+import (
+	"context"
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	StatusAdvancing       stripe.TestHelpersTestClockStatus = "advancing"
+	StatusInternalFailure stripe.TestHelpersTestClockStatus = "internal_failure"
+	StatusReady           stripe.TestHelpersTestClockStatus = "ready"
+)
+
+// terminalStatuses are the statuses in which a test clock isn't advancing.
+var terminalStatuses = []string{string(StatusInternalFailure), string(StatusReady)}
+
+// Client is used to invoke /test_helpers/test_clocks APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New creates a new test clock.
+// For more details see https://stripe.com/docs/api/test_clocks/create.
+func New(params *stripe.TestHelpersTestClockParams) (*stripe.TestHelpersTestClock, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TestHelpersTestClockParams) (*stripe.TestHelpersTestClock, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	testClock := &stripe.TestHelpersTestClock{}
+	err := c.B.Call("POST", "/test_helpers/test_clocks", c.Key, body, commonParams, testClock)
+
+	return testClock, err
+}
+
+// Get returns the details of a test clock.
+// For more details see https://stripe.com/docs/api/test_clocks/retrieve.
+func Get(id string, params *stripe.TestHelpersTestClockParams) (*stripe.TestHelpersTestClock, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TestHelpersTestClockParams) (*stripe.TestHelpersTestClock, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	testClock := &stripe.TestHelpersTestClock{}
+	err := c.B.Call("GET", fmt.Sprintf("/test_helpers/test_clocks/%v", id), c.Key, body, commonParams, testClock)
+
+	return testClock, err
+}
+
+// Advance starts moving a test clock forward to params.FrozenTime. The clock
+// is returned while it's still advancing; use Wait to block until the
+// objects attached to it have caught up.
+// For more details see https://stripe.com/docs/api/test_clocks/advance.
+func Advance(id string, params *stripe.TestHelpersTestClockAdvanceParams) (*stripe.TestHelpersTestClock, error) {
+	return getC().Advance(id, params)
+}
+
+func (c Client) Advance(id string, params *stripe.TestHelpersTestClockAdvanceParams) (*stripe.TestHelpersTestClock, error) {
+	if params == nil || params.FrozenTime == 0 {
+		return nil, fmt.Errorf("params.FrozenTime must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	testClock := &stripe.TestHelpersTestClock{}
+	err := c.B.Call("POST", fmt.Sprintf("/test_helpers/test_clocks/%v/advance", id), c.Key, body, &params.Params, testClock)
+
+	return testClock, err
+}
+
+// Wait polls a test clock until it's not advancing anymore and returns it. A
+// nil policy means stripe.DefaultPollPolicy. See stripe.Poll for details.
+func Wait(ctx context.Context, id string, params *stripe.TestHelpersTestClockParams, policy *stripe.PollPolicy) (*stripe.TestHelpersTestClock, error) {
+	return getC().Wait(ctx, id, params, policy)
+}
+
+func (c Client) Wait(ctx context.Context, id string, params *stripe.TestHelpersTestClockParams, policy *stripe.PollPolicy) (*stripe.TestHelpersTestClock, error) {
+	obj, err := stripe.Poll(ctx, func() (interface{}, string, error) {
+		testClock, err := c.Get(id, params)
+		if err != nil {
+			return nil, "", err
+		}
+		return testClock, string(testClock.Status), nil
+	}, terminalStatuses, policy)
+
+	testClock, _ := obj.(*stripe.TestHelpersTestClock)
+	return testClock, err
+}
+
+// Del removes a test clock, along with the customers attached to it.
+// For more details see https://stripe.com/docs/api/test_clocks/delete.
+func Del(id string, params *stripe.TestHelpersTestClockParams) (*stripe.TestHelpersTestClock, error) {
+	return getC().Del(id, params)
+}
+
+func (c Client) Del(id string, params *stripe.TestHelpersTestClockParams) (*stripe.TestHelpersTestClock, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	testClock := &stripe.TestHelpersTestClock{}
+	err := c.B.Call("DELETE", fmt.Sprintf("/test_helpers/test_clocks/%v", id), c.Key, body, commonParams, testClock)
+
+	return testClock, err
+}
+
+// List returns a list of test clocks.
+// For more details see https://stripe.com/docs/api/test_clocks/list.
+func List(params *stripe.TestHelpersTestClockListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.TestHelpersTestClockListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TestHelpersTestClockList{}
+		err := c.B.Call("GET", "/test_helpers/test_clocks", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of TestHelpersTestClocks.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// TestHelpersTestClock returns the most recent TestHelpersTestClock
+// visited by a call to Next.
+func (i *Iter) TestHelpersTestClock() *stripe.TestHelpersTestClock {
+	return i.Current().(*stripe.TestHelpersTestClock)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,68 @@
+package testclock
+
+import (
+	"context"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestTestClockAdvance(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/test_clocks/clock_123/advance", `{"id": "clock_123", "status": "advancing"}`)
+
+	testClock, err := Client{B: b}.Advance("clock_123", &stripe.TestHelpersTestClockAdvanceParams{
+		FrozenTime: 1580515200,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, StatusAdvancing, testClock.Status)
+	assert.Equal(t, "1580515200", b.LastCall().Values().Get("frozen_time"))
+
+	_, err = Client{B: b}.Advance("clock_123", nil)
+	assert.NotNil(t, err)
+}
+
+func TestTestClockDel(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("DELETE", "/test_helpers/test_clocks/clock_123", `{"id": "clock_123", "deleted": true}`)
+
+	testClock, err := Client{B: b}.Del("clock_123", nil)
+	assert.Nil(t, err)
+	assert.True(t, testClock.Deleted)
+}
+
+func TestTestClockList(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/test_helpers/test_clocks", `{"data": [{"id": "clock_123"}]}`)
+
+	i := Client{B: b}.List(&stripe.TestHelpersTestClockListParams{})
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.Equal(t, "clock_123", i.TestHelpersTestClock().ID)
+}
+
+func TestTestClockNew(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/test_helpers/test_clocks", `{"id": "clock_123", "frozen_time": 1577836800, "status": "ready"}`)
+
+	testClock, err := Client{B: b}.New(&stripe.TestHelpersTestClockParams{
+		FrozenTime: 1577836800,
+		Name:       "monthly renewal",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.Timestamp(1577836800), testClock.FrozenTime)
+	assert.Equal(t, "monthly renewal", b.LastCall().Values().Get("name"))
+}
+
+func TestTestClockWait(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/test_helpers/test_clocks/clock_123", `{"id": "clock_123", "status": "ready"}`)
+
+	testClock, err := Client{B: b}.Wait(context.Background(), "clock_123", nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, StatusReady, testClock.Status)
+	assert.Equal(t, 1, len(b.Calls()))
+}
@@ -0,0 +1,76 @@
+package stripe
+
+import "encoding/json"
+
+// TestHelpersTestClockStatus is the list of allowed values for the status of
+// a test clock. Allowed values are "advancing", "internal_failure" and "ready".
+type TestHelpersTestClockStatus string
+
+// TestHelpersTestClockParams is the set of parameters that can be used when
+// creating a test clock.
+// For more details see https://stripe.com/docs/api/test_clocks/create.
+type TestHelpersTestClockParams struct {
+	Params     `form:"*"`
+	FrozenTime int64  `form:"frozen_time"`
+	Name       string `form:"name"`
+}
+
+// TestHelpersTestClockAdvanceParams is the set of parameters that can be used
+// when advancing a test clock.
+// For more details see https://stripe.com/docs/api/test_clocks/advance.
+type TestHelpersTestClockAdvanceParams struct {
+	Params     `form:"*"`
+	FrozenTime int64 `form:"frozen_time"`
+}
+
+// TestHelpersTestClockListParams is the set of parameters that can be used
+// when listing test clocks.
+// For more details see https://stripe.com/docs/api/test_clocks/list.
+type TestHelpersTestClockListParams struct {
+	ListParams `form:"*"`
+}
+
+// TestHelpersTestClock is the resource representing a Stripe test clock. A
+// test clock holds the current time of the customers attached to it, and of
+// their subscriptions and invoices, so that billing can be simulated in test
+// mode by moving the clock forward.
+// For more details see https://stripe.com/docs/api/test_clocks.
+type TestHelpersTestClock struct {
+	APIResource
+	Created      Timestamp                  `json:"created"`
+	Deleted      bool                       `json:"deleted"`
+	DeletesAfter Timestamp                  `json:"deletes_after"`
+	FrozenTime   Timestamp                  `json:"frozen_time"`
+	ID           string                     `json:"id"`
+	Live         bool                       `json:"livemode"`
+	Name         string                     `json:"name"`
+	Status       TestHelpersTestClockStatus `json:"status"`
+}
+
+// TestHelpersTestClockList is a list of test clocks as retrieved from a list
+// endpoint.
+type TestHelpersTestClockList struct {
+	APIResource
+	ListMeta
+	Values []*TestHelpersTestClock `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a TestHelpersTestClock.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TestHelpersTestClock) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type testClock TestHelpersTestClock
+	var tt testClock
+	err := json.Unmarshal(data, &tt)
+	if err != nil {
+		return err
+	}
+
+	*t = TestHelpersTestClock(tt)
+	return nil
+}
@@ -0,0 +1,155 @@
+package testbackend
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	stripe "github.com/stripe/stripe-go"
+)
+
+// capabilityStatuses are the statuses that CapabilityPermutations gives
+// capabilities.
+var capabilityStatuses = []stripe.AccountCapabilityStatus{
+	stripe.AccountCapabilityStatusActive,
+	stripe.AccountCapabilityStatusDisabled,
+	stripe.AccountCapabilityStatusInactive,
+	stripe.AccountCapabilityStatusPending,
+	stripe.AccountCapabilityStatusUnrequested,
+}
+
+// requirementStates is the number of states that RequirementsPermutations
+// gives fields: provided, currently due, eventually due, past due and
+// pending verification.
+const requirementStates = 5
+
+// SimulateAccount registers the responses describing a connected account in
+// the given state, so that onboarding flows can be exercised against states
+// that are hard to reach with real test accounts. The account, its
+// capabilities and each of them can then be retrieved with the account and
+// capability packages:
+//
+//	b.SimulateAccount(&stripe.Account{
+//	    ID:           "acct_123",
+//	    Capabilities: map[string]stripe.AccountCapabilityStatus{"transfers": "pending"},
+//	    Requirements: &stripe.AccountRequirements{CurrentlyDue: []string{"external_account"}},
+//	})
+//
+// Capabilities share the requirements of the account.
+func (b *Backend) SimulateAccount(account *stripe.Account) {
+	requirements := account.Requirements
+	if requirements == nil {
+		requirements = &stripe.AccountRequirements{}
+	}
+
+	b.Respond("GET", "/accounts/"+account.ID, mustMarshal(map[string]interface{}{
+		"id":              account.ID,
+		"object":          "account",
+		"capabilities":    account.Capabilities,
+		"charges_enabled": account.ChargesEnabled,
+		"payouts_enabled": account.PayoutsEnabled,
+		"requirements":    requirements,
+	}))
+
+	names := make([]string, 0, len(account.Capabilities))
+	for name := range account.Capabilities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	capabilities := make([]interface{}, len(names))
+	for i, name := range names {
+		status := account.Capabilities[name]
+		capability := map[string]interface{}{
+			"id":           name,
+			"object":       "capability",
+			"account":      account.ID,
+			"requested":    status != stripe.AccountCapabilityStatusUnrequested,
+			"requirements": requirements,
+			"status":       status,
+		}
+		capabilities[i] = capability
+
+		b.Respond("GET", fmt.Sprintf("/accounts/%v/capabilities/%v", account.ID, name), mustMarshal(capability))
+	}
+
+	b.Respond("GET", fmt.Sprintf("/accounts/%v/capabilities", account.ID), mustMarshal(map[string]interface{}{
+		"object":   "list",
+		"data":     capabilities,
+		"has_more": false,
+	}))
+}
+
+// CapabilityPermutations returns every combination of statuses of the
+// capabilities with the given names, like "card_payments" and "transfers",
+// to be given to SimulateAccount.
+func CapabilityPermutations(names ...string) []map[string]stripe.AccountCapabilityStatus {
+	permutations := []map[string]stripe.AccountCapabilityStatus{{}}
+
+	for _, name := range names {
+		var next []map[string]stripe.AccountCapabilityStatus
+		for _, permutation := range permutations {
+			for _, status := range capabilityStatuses {
+				capabilities := make(map[string]stripe.AccountCapabilityStatus, len(permutation)+1)
+				for k, v := range permutation {
+					capabilities[k] = v
+				}
+				capabilities[name] = status
+				next = append(next, capabilities)
+			}
+		}
+		permutations = next
+	}
+
+	return permutations
+}
+
+// RequirementsPermutations returns the requirements of an account for every
+// combination of states of the given fields, like "external_account", to be
+// given to SimulateAccount. Each field is either provided, currently due,
+// eventually due, past due or pending verification. Accounts with past due
+// fields are disabled with "requirements.past_due".
+func RequirementsPermutations(fields ...string) []*stripe.AccountRequirements {
+	count := 1
+	for range fields {
+		count *= requirementStates
+	}
+
+	permutations := make([]*stripe.AccountRequirements, count)
+	for i := range permutations {
+		requirements := &stripe.AccountRequirements{}
+
+		state := i
+		for _, field := range fields {
+			switch state % requirementStates {
+			case 1:
+				requirements.CurrentlyDue = append(requirements.CurrentlyDue, field)
+				requirements.EventuallyDue = append(requirements.EventuallyDue, field)
+			case 2:
+				requirements.EventuallyDue = append(requirements.EventuallyDue, field)
+			case 3:
+				requirements.CurrentlyDue = append(requirements.CurrentlyDue, field)
+				requirements.EventuallyDue = append(requirements.EventuallyDue, field)
+				requirements.PastDue = append(requirements.PastDue, field)
+			case 4:
+				requirements.PendingVerification = append(requirements.PendingVerification, field)
+			}
+			state /= requirementStates
+		}
+
+		if len(requirements.PastDue) > 0 {
+			requirements.DisabledReason = stripe.AccountRequirementsDisabledReasonRequirementsPastDue
+		}
+		permutations[i] = requirements
+	}
+
+	return permutations
+}
+
+func mustMarshal(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
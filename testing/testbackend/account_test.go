@@ -0,0 +1,73 @@
+package testbackend
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/account"
+	"github.com/stripe/stripe-go/capability"
+)
+
+func TestCapabilityPermutations(t *testing.T) {
+	permutations := CapabilityPermutations("card_payments", "transfers")
+	assert.Equal(t, 25, len(permutations))
+
+	seen := make(map[stripe.AccountCapabilityStatus]bool)
+	for _, p := range permutations {
+		assert.Equal(t, 2, len(p))
+		seen[p["transfers"]] = true
+	}
+	assert.Equal(t, 5, len(seen))
+}
+
+func TestRequirementsPermutations(t *testing.T) {
+	permutations := RequirementsPermutations("external_account", "tos_acceptance.date")
+	assert.Equal(t, 25, len(permutations))
+
+	// The first permutation has every field provided
+	assert.Equal(t, 0, len(permutations[0].EventuallyDue))
+	assert.Equal(t, stripe.AccountRequirementsDisabledReason(""), permutations[0].DisabledReason)
+
+	var disabled int
+	for _, p := range permutations {
+		if p.DisabledReason == stripe.AccountRequirementsDisabledReasonRequirementsPastDue {
+			assert.NotEqual(t, 0, len(p.PastDue))
+			disabled++
+		}
+	}
+	assert.Equal(t, 9, disabled)
+}
+
+func TestSimulateAccount(t *testing.T) {
+	b := New()
+	b.SimulateAccount(&stripe.Account{
+		ID: "acct_123",
+		Capabilities: map[string]stripe.AccountCapabilityStatus{
+			"card_payments": stripe.AccountCapabilityStatusActive,
+			"transfers":     stripe.AccountCapabilityStatusPending,
+		},
+		Requirements: &stripe.AccountRequirements{
+			CurrentlyDue: []string{"external_account"},
+		},
+	})
+
+	acct, err := account.Client{B: b, Key: "sk_test_123"}.GetByID("acct_123", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, stripe.AccountCapabilityStatusPending, acct.Capabilities["transfers"])
+	assert.Equal(t, []string{"external_account"}, acct.Requirements.CurrentlyDue)
+
+	c := capability.Client{B: b, Key: "sk_test_123"}
+	got, err := c.Get("transfers", &stripe.CapabilityParams{Account: "acct_123"})
+	assert.NoError(t, err)
+	assert.Equal(t, stripe.AccountCapabilityStatusPending, got.Status)
+	assert.True(t, got.Requested)
+
+	i := c.List(&stripe.CapabilityListParams{Account: "acct_123"})
+	var names []string
+	for i.Next() {
+		names = append(names, i.Capability().ID)
+	}
+	assert.NoError(t, i.Err())
+	assert.Equal(t, []string{"card_payments", "transfers"}, names)
+}
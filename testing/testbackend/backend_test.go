@@ -0,0 +1,52 @@
+package testbackend
+
+import (
+	"bytes"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/charge"
+	"github.com/stripe/stripe-go/form"
+)
+
+func TestBackend(t *testing.T) {
+	b := New()
+	b.Respond("POST", "/charges", `{"id": "ch_123", "amount": 100}`)
+
+	c := charge.Client{B: b, Key: "sk_test_123"}
+	ch, err := c.New(&stripe.ChargeParams{Amount: 100, Currency: "usd"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ch_123", ch.ID)
+	assert.Equal(t, uint64(100), ch.Amount)
+
+	call := b.LastCall()
+	assert.Equal(t, "POST", call.Method)
+	assert.Equal(t, "/charges", call.Path)
+	assert.Equal(t, "sk_test_123", call.Key)
+	assert.Equal(t, "100", call.Values().Get("amount"))
+	assert.Equal(t, "usd", call.Values().Get("currency"))
+}
+
+func TestBackendMultipart(t *testing.T) {
+	b := New()
+	b.Respond("POST", "/files", `{"id": "file_123"}`)
+
+	v := &stripe.File{}
+	err := b.CallMultipart("POST", "/files", "sk_test_123", "boundary", bytes.NewBufferString("data"), nil, v)
+	assert.NoError(t, err)
+	assert.Equal(t, "file_123", v.ID)
+	assert.Equal(t, []byte("data"), b.LastCall().Multipart)
+	assert.Equal(t, 0, len(b.LastCall().Values()))
+}
+
+func TestBackendNoResponse(t *testing.T) {
+	b := New()
+	assert.Nil(t, b.LastCall())
+
+	err := b.Call("GET", "/charges/ch_123", "sk_test_123", &form.Values{}, nil, &stripe.Charge{})
+	stripeErr, ok := err.(*stripe.Error)
+	assert.True(t, ok)
+	assert.Equal(t, 404, stripeErr.HTTPStatusCode)
+	assert.Equal(t, 1, len(b.Calls()))
+}
@@ -35,15 +35,12 @@ func init() {
 	// malformed param struct is detected
 	form.Strict = true
 
-	port := os.Getenv("STRIPE_MOCK_PORT")
-	if port == "" {
-		port = "12111"
-	}
+	url := MockURL()
 
-	resp, err := http.Get("http://localhost:" + port)
+	resp, err := http.Get(url)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Couldn't reach stripe-mock at `localhost:%s`. Is "+
-			"it running? Please see README for setup instructions.\n", port)
+		fmt.Fprintf(os.Stderr, "Couldn't reach stripe-mock at `%s`. Is "+
+			"it running? Please see README for setup instructions.\n", url)
 		os.Exit(1)
 	}
 	version := resp.Header.Get("Stripe-Mock-Version")
@@ -55,9 +52,32 @@ func init() {
 	}
 
 	stripe.Key = "sk_test_myTestKey"
-	stripe.SetBackend("api", stripe.BackendConfiguration{
+	UseMock(url)
+}
+
+// MockURL returns the base URL of the stripe-mock instance that tests run
+// against. It's taken from STRIPE_MOCK_URL if it's set, like
+// "http://stripe-mock:12111", and otherwise points at localhost on the port
+// in STRIPE_MOCK_PORT, which defaults to 12111.
+func MockURL() string {
+	if url := os.Getenv("STRIPE_MOCK_URL"); url != "" {
+		return strings.TrimSuffix(url, "/")
+	}
+
+	port := os.Getenv("STRIPE_MOCK_PORT")
+	if port == "" {
+		port = "12111"
+	}
+	return "http://localhost:" + port
+}
+
+// UseMock points the API backend at the stripe-mock instance with the given
+// base URL. Importing this package already does so for the URL returned by
+// MockURL.
+func UseMock(url string) {
+	stripe.SetBackend(stripe.APIBackend, stripe.BackendConfiguration{
 		Type:       stripe.APIBackend,
-		URL:        "http://localhost:" + port + "/v1",
+		URL:        url + "/v1",
 		HTTPClient: &http.Client{},
 	})
 }
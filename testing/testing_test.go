@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"os"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
@@ -24,3 +25,18 @@ func TestCompareVersions(t *testing.T) {
 	assert.Equal(t, 1, compareVersions("1", "1.22.3"))
 	assert.Equal(t, -1, compareVersions("1.22.3", "1"))
 }
+
+func TestMockURL(t *testing.T) {
+	defer os.Setenv("STRIPE_MOCK_PORT", os.Getenv("STRIPE_MOCK_PORT"))
+	defer os.Setenv("STRIPE_MOCK_URL", os.Getenv("STRIPE_MOCK_URL"))
+
+	os.Setenv("STRIPE_MOCK_URL", "")
+	os.Setenv("STRIPE_MOCK_PORT", "")
+	assert.Equal(t, "http://localhost:12111", MockURL())
+
+	os.Setenv("STRIPE_MOCK_PORT", "12112")
+	assert.Equal(t, "http://localhost:12112", MockURL())
+
+	os.Setenv("STRIPE_MOCK_URL", "http://stripe-mock:12111/")
+	assert.Equal(t, "http://stripe-mock:12111", MockURL())
+}
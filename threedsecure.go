@@ -16,10 +16,11 @@ type ThreeDSecureParams struct {
 // ThreeDSecure is the resource representing a Stripe 3DS object
 // For more details see https://stripe.com/docs/api#three_d_secure.
 type ThreeDSecure struct {
+	APIResource
 	Amount        uint64             `json:"amount"`
 	Authenticated bool               `json:"authenticated"`
 	Card          *Card              `json:"card"`
-	Created       int64              `json:"created"`
+	Created       Timestamp          `json:"created"`
 	Currency      Currency           `json:"currency"`
 	ID            string             `json:"id"`
 	Live          bool               `json:"livemode"`
@@ -50,5 +50,5 @@ func (c Client) Get(id string, params *stripe.ThreeDSecureParams) (*stripe.Three
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
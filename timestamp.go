@@ -0,0 +1,45 @@
+package stripe
+
+import "time"
+
+// Timestamp is a time as returned by the API, in seconds since the Unix
+// epoch. Its zero value means that the time isn't set, like the CanceledAt of
+// a subscription that was never canceled.
+type Timestamp int64
+
+// Time returns the time represented by t in the local time zone, or the zero
+// time.Time if t isn't set, so that IsZero can be used on either.
+func (t Timestamp) Time() time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(t), 0)
+}
+
+// IsZero reports whether t isn't set.
+func (t Timestamp) IsZero() bool {
+	return t == 0
+}
+
+// Unix returns t as seconds since the Unix epoch.
+func (t Timestamp) Unix() int64 {
+	return int64(t)
+}
+
+// Before reports whether t is before u.
+func (t Timestamp) Before(u time.Time) bool {
+	return t.Time().Before(u)
+}
+
+// After reports whether t is after u.
+func (t Timestamp) After(u time.Time) bool {
+	return t.Time().After(u)
+}
+
+// NewTimestamp returns the Timestamp of tm, or 0 if tm is the zero time.
+func NewTimestamp(tm time.Time) Timestamp {
+	if tm.IsZero() {
+		return 0
+	}
+	return Timestamp(tm.Unix())
+}
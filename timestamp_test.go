@@ -0,0 +1,33 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestTimestamp(t *testing.T) {
+	var ts Timestamp
+	assert.True(t, ts.IsZero())
+	assert.True(t, ts.Time().IsZero())
+	assert.Equal(t, Timestamp(0), NewTimestamp(time.Time{}))
+
+	ts = Timestamp(1500000000)
+	assert.False(t, ts.IsZero())
+	assert.Equal(t, int64(1500000000), ts.Unix())
+	assert.True(t, ts.Time().Equal(time.Unix(1500000000, 0)))
+	assert.Equal(t, ts, NewTimestamp(ts.Time()))
+
+	assert.True(t, ts.Before(time.Unix(1500000001, 0)))
+	assert.True(t, ts.After(time.Unix(1499999999, 0)))
+}
+
+func TestTimestampUnmarshal(t *testing.T) {
+	var sub Sub
+	err := json.Unmarshal([]byte(`{"created": 1500000000, "canceled_at": null}`), &sub)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1500000000, 0).UTC(), sub.Created.Time().UTC())
+	assert.True(t, sub.Canceled.IsZero())
+}
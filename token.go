@@ -22,10 +22,11 @@ type TokenParams struct {
 // Token is the resource representing a Stripe token.
 // For more details see https://stripe.com/docs/api#tokens.
 type Token struct {
+	APIResource
 	Bank     *BankAccount `json:"bank_account"`
 	Card     *Card        `json:"card"`
 	ClientIP string       `json:"client_ip"`
-	Created  int64        `json:"created"`
+	Created  Timestamp    `json:"created"`
 
 	// Email is an undocumented field but included for all tokens created
 	// with Stripe Checkout.
@@ -0,0 +1,85 @@
+package stripe
+
+import "encoding/json"
+
+// TopupDestinationBalance is the list of allowed values for the balance a
+// top-up adds funds to. Allowed values are "issuing" and "payments".
+type TopupDestinationBalance string
+
+// TopupStatus is the list of allowed values for the top-up's status.
+// Allowed values are "canceled", "failed", "pending", "reversed", "succeeded".
+type TopupStatus string
+
+// TopupParams is the set of parameters that can be used when creating or updating a top-up.
+// For more details see https://stripe.com/docs/api#create_topup and https://stripe.com/docs/api#update_topup.
+type TopupParams struct {
+	Params              `form:"*"`
+	Amount              int64                   `form:"amount"`
+	Currency            Currency                `form:"currency"`
+	Desc                string                  `form:"description"`
+	DestinationBalance  TopupDestinationBalance `form:"destination_balance"`
+	Source              string                  `form:"source"`
+	StatementDescriptor string                  `form:"statement_descriptor"`
+	TransferGroup       string                  `form:"transfer_group"`
+}
+
+// TopupListParams is the set of parameters that can be used when listing top-ups.
+// For more details see https://stripe.com/docs/api#list_topups.
+type TopupListParams struct {
+	ListParams   `form:"*"`
+	Amount       int64             `form:"amount"`
+	AmountRange  *RangeQueryParams `form:"amount"`
+	Created      int64             `form:"created"`
+	CreatedRange *RangeQueryParams `form:"created"`
+	Status       TopupStatus       `form:"status"`
+}
+
+// Topup is the resource representing a Stripe top-up, which adds funds to the
+// balance of an account from a bank account.
+// For more details see https://stripe.com/docs/api#topups.
+type Topup struct {
+	APIResource
+	Amount                   int64                   `json:"amount"`
+	BalanceTransaction       *Transaction            `json:"balance_transaction"`
+	Created                  Timestamp               `json:"created"`
+	Currency                 Currency                `json:"currency"`
+	Desc                     string                  `json:"description"`
+	DestinationBalance       TopupDestinationBalance `json:"destination_balance"`
+	ExpectedAvailabilityDate Timestamp               `json:"expected_availability_date"`
+	FailCode                 string                  `json:"failure_code"`
+	FailMessage              string                  `json:"failure_message"`
+	ID                       string                  `json:"id"`
+	Live                     bool                    `json:"livemode"`
+	Meta                     map[string]string       `json:"metadata"`
+	Source                   *Source                 `json:"source"`
+	StatementDescriptor      string                  `json:"statement_descriptor"`
+	Status                   TopupStatus             `json:"status"`
+	TransferGroup            string                  `json:"transfer_group"`
+}
+
+// TopupList is a list of top-ups as retrieved from a list endpoint.
+type TopupList struct {
+	APIResource
+	ListMeta
+	Values []*Topup `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a Topup.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *Topup) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type topup Topup
+	var tt topup
+	err := json.Unmarshal(data, &tt)
+	if err != nil {
+		return err
+	}
+
+	*t = Topup(tt)
+	return nil
+}
@@ -0,0 +1,187 @@
+// Package topup provides the /topups APIs
+package topup
+
+import (
+	"context"
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	DestinationBalanceIssuing  stripe.TopupDestinationBalance = "issuing"
+	DestinationBalancePayments stripe.TopupDestinationBalance = "payments"
+
+	StatusCanceled  stripe.TopupStatus = "canceled"
+	StatusFailed    stripe.TopupStatus = "failed"
+	StatusPending   stripe.TopupStatus = "pending"
+	StatusReversed  stripe.TopupStatus = "reversed"
+	StatusSucceeded stripe.TopupStatus = "succeeded"
+)
+
+// terminalStatuses are the statuses in which a top-up has been processed.
+var terminalStatuses = []string{string(StatusCanceled), string(StatusFailed), string(StatusReversed), string(StatusSucceeded)}
+
+// Client is used to invoke /topups APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new top-up. In test mode, a top-up is the way to fund the
+// balance that test payouts or, with DestinationBalanceIssuing, test Issuing
+// authorizations draw from.
+// For more details see https://stripe.com/docs/api#create_topup.
+func New(params *stripe.TopupParams) (*stripe.Topup, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.TopupParams) (*stripe.Topup, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	topup := &stripe.Topup{}
+	err := c.B.Call("POST", "/topups", c.Key, body, commonParams, topup)
+
+	return topup, err
+}
+
+// Get returns the details of a top-up.
+// For more details see https://stripe.com/docs/api#retrieve_topup.
+func Get(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	topup := &stripe.Topup{}
+	err := c.B.Call("GET", fmt.Sprintf("/topups/%v", id), c.Key, body, commonParams, topup)
+
+	return topup, err
+}
+
+// Update updates a top-up's properties.
+// For more details see https://stripe.com/docs/api#update_topup.
+func Update(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	topup := &stripe.Topup{}
+	err := c.B.Call("POST", fmt.Sprintf("/topups/%v", id), c.Key, body, commonParams, topup)
+
+	return topup, err
+}
+
+// Cancel cancels a pending top-up.
+// For more details see https://stripe.com/docs/api#cancel_topup.
+func Cancel(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.TopupParams) (*stripe.Topup, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	topup := &stripe.Topup{}
+	err := c.B.Call("POST", fmt.Sprintf("/topups/%v/cancel", id), c.Key, body, commonParams, topup)
+
+	return topup, err
+}
+
+// Wait polls a top-up until it's not pending anymore and returns it. A nil policy
+// means stripe.DefaultPollPolicy. See stripe.Poll for details.
+func Wait(ctx context.Context, id string, params *stripe.TopupParams, policy *stripe.PollPolicy) (*stripe.Topup, error) {
+	return getC().Wait(ctx, id, params, policy)
+}
+
+func (c Client) Wait(ctx context.Context, id string, params *stripe.TopupParams, policy *stripe.PollPolicy) (*stripe.Topup, error) {
+	obj, err := stripe.Poll(ctx, func() (interface{}, string, error) {
+		topup, err := c.Get(id, params)
+		if err != nil {
+			return nil, "", err
+		}
+		return topup, string(topup.Status), nil
+	}, terminalStatuses, policy)
+
+	topup, _ := obj.(*stripe.Topup)
+	return topup, err
+}
+
+// List returns a list of top-ups.
+// For more details see https://stripe.com/docs/api#list_topups.
+func List(params *stripe.TopupListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.TopupListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.TopupList{}
+		err := c.B.Call("GET", "/topups", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of Topups.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// Topup returns the most recent Topup
+// visited by a call to Next.
+func (i *Iter) Topup() *stripe.Topup {
+	return i.Current().(*stripe.Topup)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,63 @@
+package topup
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestTopupCancel(t *testing.T) {
+	topup, err := Cancel("tu_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, topup)
+}
+
+func TestTopupGet(t *testing.T) {
+	topup, err := Get("tu_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, topup)
+}
+
+func TestTopupList(t *testing.T) {
+	i := List(&stripe.TopupListParams{Status: StatusPending})
+
+	// Verify that we can get at least one top-up
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.Topup())
+}
+
+func TestTopupNew(t *testing.T) {
+	topup, err := New(&stripe.TopupParams{
+		Amount:   123,
+		Currency: "usd",
+		Source:   "src_123",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, topup)
+}
+
+func TestTopupNew_DestinationBalance(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/topups", `{"id": "tu_123", "destination_balance": "issuing"}`)
+
+	topup, err := Client{B: b}.New(&stripe.TopupParams{
+		Amount:             1000,
+		Currency:           "usd",
+		DestinationBalance: DestinationBalanceIssuing,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, DestinationBalanceIssuing, topup.DestinationBalance)
+	assert.Equal(t, "issuing", b.LastCall().Values().Get("destination_balance"))
+}
+
+func TestTopupUpdate(t *testing.T) {
+	topup, err := Update("tu_123", &stripe.TopupParams{
+		Desc: "Top-up for the week",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, topup)
+}
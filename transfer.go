@@ -20,6 +20,7 @@ type TransferParams struct {
 	Params        `form:"*"`
 	Amount        int64              `form:"amount"`
 	Currency      Currency           `form:"currency"`
+	Desc          string             `form:"description"`
 	Dest          string             `form:"destination"`
 	SourceTx      string             `form:"source_transaction"`
 	SourceType    TransferSourceType `form:"source_type"`
@@ -40,18 +41,21 @@ type TransferListParams struct {
 // Transfer is the resource representing a Stripe transfer.
 // For more details see https://stripe.com/docs/api#transfers.
 type Transfer struct {
+	APIResource
 	Amount         int64               `json:"amount"`
 	AmountReversed int64               `json:"amount_reversed"`
-	Created        int64               `json:"created"`
+	Created        Timestamp           `json:"created"`
 	Currency       Currency            `json:"currency"`
+	Desc           string              `json:"description"`
 	Dest           TransferDestination `json:"destination"`
-	DestPayment    string              `json:"destination_payment"`
+	DestPayment    *Charge             `json:"destination_payment"`
 	ID             string              `json:"id"`
 	Live           bool                `json:"livemode"`
 	Meta           map[string]string   `json:"metadata"`
 	Reversals      *ReversalList       `json:"reversals"`
 	Reversed       bool                `json:"reversed"`
 	SourceTx       *TransactionSource  `json:"source_transaction"`
+	SourceType     TransferSourceType  `json:"source_type"`
 	Statement      string              `json:"statement_descriptor"`
 	TransferGroup  string              `json:"transfer_group"`
 	Tx             *Transaction        `json:"balance_transaction"`
@@ -59,6 +63,7 @@ type Transfer struct {
 
 // TransferList is a list of transfers as retrieved from a list endpoint.
 type TransferList struct {
+	APIResource
 	ListMeta
 	Values []*Transfer `json:"data"`
 }
@@ -67,16 +72,19 @@ type TransferList struct {
 // This custom unmarshaling is needed because the resulting
 // property may be an id or the full struct if it was expanded.
 func (t *Transfer) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
 	type transfer Transfer
 	var tb transfer
 	err := json.Unmarshal(data, &tb)
-	if err == nil {
-		*t = Transfer(tb)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		t.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
+	*t = Transfer(tb)
 	return nil
 }
 
@@ -84,19 +92,23 @@ func (t *Transfer) UnmarshalJSON(data []byte) error {
 // This custom unmarshaling is needed because the specific
 // type of destination it refers to is specified in the JSON
 func (d *TransferDestination) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		d.ID = id
+		return nil
+	}
+
 	type dest TransferDestination
 	var dd dest
 	err := json.Unmarshal(data, &dd)
-	if err == nil {
-		*d = TransferDestination(dd)
-
-		json.Unmarshal(data, &d.Account)
-	} else {
-		// the id is surrounded by "\" characters, so strip them
-		d.ID = string(data[1 : len(data)-1])
+	if err != nil {
+		return err
 	}
 
-	return nil
+	*d = TransferDestination(dd)
+
+	err = json.Unmarshal(data, &d.Account)
+
+	return err
 }
 
 // MarshalJSON handles serialization of a TransferDestination.
@@ -126,5 +126,5 @@ func (i *Iter) Transfer() *stripe.Transfer {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -6,8 +6,29 @@ import (
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go"
 	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
 )
 
+func TestTransferExpandDestinationPayment(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("GET", "/transfers/tr_123", `{
+		"id": "tr_123",
+		"destination_payment": {"id": "py_123", "amount": 1000},
+		"source_transaction": "ch_123",
+		"transfer_group": "order_123"
+	}`)
+
+	params := &stripe.TransferParams{}
+	params.Expand("destination_payment")
+	transfer, err := Client{B: b}.Get("tr_123", params)
+	assert.Nil(t, err)
+	assert.Equal(t, "py_123", transfer.DestPayment.ID)
+	assert.Equal(t, uint64(1000), transfer.DestPayment.Amount)
+	assert.Equal(t, "ch_123", transfer.SourceTx.ID)
+	assert.Equal(t, "order_123", transfer.TransferGroup)
+	assert.Equal(t, "destination_payment", b.LastCall().Values().Get("expand[]"))
+}
+
 func TestTransferGet(t *testing.T) {
 	transfer, err := Get("tr_123", nil)
 	assert.Nil(t, err)
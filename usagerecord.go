@@ -0,0 +1,29 @@
+package stripe
+
+// UsageRecordAction is the list of allowed values for the action of a usage
+// record. Allowed values are "increment", "set".
+type UsageRecordAction string
+
+// UsageRecordParams is the set of parameters that can be used when creating
+// a usage record.
+// For more details see https://stripe.com/docs/api#usage_record_create.
+type UsageRecordParams struct {
+	Params           `form:"*"`
+	Action           UsageRecordAction `form:"action"`
+	Quantity         uint64            `form:"quantity"`
+	QuantityZero     bool              `form:"quantity,zero"`
+	SubscriptionItem string            `form:"-"` // Included in URL
+	Timestamp        int64             `form:"timestamp"`
+}
+
+// UsageRecord is the resource representing the usage of a metered
+// subscription item at a given time.
+// For more details see https://stripe.com/docs/api#usage_records.
+type UsageRecord struct {
+	APIResource
+	ID               string    `json:"id"`
+	Live             bool      `json:"livemode"`
+	Quantity         uint64    `json:"quantity"`
+	SubscriptionItem string    `json:"subscription_item"`
+	Timestamp        Timestamp `json:"timestamp"`
+}
@@ -0,0 +1,49 @@
+// Package usagerecord provides the /subscription_items/usage_records APIs
+package usagerecord
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+const (
+	ActionIncrement stripe.UsageRecordAction = "increment"
+	ActionSet       stripe.UsageRecordAction = "set"
+)
+
+// Client is used to invoke /subscription_items/usage_records APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new usage record for a metered subscription item. Records
+// with ActionIncrement add their quantity to the usage already reported at
+// Timestamp while records with ActionSet replace it.
+// For more details see https://stripe.com/docs/api#usage_record_create.
+func New(params *stripe.UsageRecordParams) (*stripe.UsageRecord, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.UsageRecordParams) (*stripe.UsageRecord, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params cannot be nil")
+	}
+	if params.SubscriptionItem == "" {
+		return nil, fmt.Errorf("params.SubscriptionItem must be set")
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+
+	usageRecord := &stripe.UsageRecord{}
+	err := c.B.Call("POST", fmt.Sprintf("/subscription_items/%v/usage_records", params.SubscriptionItem), c.Key, body, &params.Params, usageRecord)
+
+	return usageRecord, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
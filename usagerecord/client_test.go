@@ -0,0 +1,52 @@
+package usagerecord
+
+import (
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+	"github.com/stripe/stripe-go/testing/testbackend"
+)
+
+func TestUsageRecordNew(t *testing.T) {
+	usageRecord, err := New(&stripe.UsageRecordParams{
+		Action:           ActionIncrement,
+		Quantity:         10,
+		SubscriptionItem: "si_123",
+		Timestamp:        time.Now().Unix(),
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, usageRecord)
+}
+
+func TestUsageRecordNewNoSubscriptionItem(t *testing.T) {
+	_, err := New(&stripe.UsageRecordParams{Quantity: 10})
+	assert.NotNil(t, err)
+}
+
+func TestUsageRecordNewSetZero(t *testing.T) {
+	b := testbackend.New()
+	b.Respond("POST", "/subscription_items/si_123/usage_records", `{
+		"id": "mbur_123",
+		"quantity": 0,
+		"subscription_item": "si_123",
+		"timestamp": 1546300800
+	}`)
+
+	c := Client{B: b, Key: "sk_test_123"}
+	usageRecord, err := c.New(&stripe.UsageRecordParams{
+		Action:           ActionSet,
+		QuantityZero:     true,
+		SubscriptionItem: "si_123",
+		Timestamp:        1546300800,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "si_123", usageRecord.SubscriptionItem)
+
+	values := b.LastCall().Values()
+	assert.Equal(t, "set", values.Get("action"))
+	assert.Equal(t, "0", values.Get("quantity"))
+	assert.Equal(t, "1546300800", values.Get("timestamp"))
+}
@@ -0,0 +1,30 @@
+package stripe
+
+// UsageRecordSummaryListParams is the set of parameters that can be used
+// when listing the usage record summaries of a subscription item.
+// For more details see https://stripe.com/docs/api#usage_record_summary_list.
+type UsageRecordSummaryListParams struct {
+	ListParams       `form:"*"`
+	SubscriptionItem string `form:"-"` // Included in URL
+}
+
+// UsageRecordSummary is the resource representing the total usage of a
+// metered subscription item over a billing period.
+// For more details see https://stripe.com/docs/api#usage_record_summaries.
+type UsageRecordSummary struct {
+	APIResource
+	ID               string  `json:"id"`
+	Invoice          string  `json:"invoice"`
+	Live             bool    `json:"livemode"`
+	Period           *Period `json:"period"`
+	SubscriptionItem string  `json:"subscription_item"`
+	TotalUsage       uint64  `json:"total_usage"`
+}
+
+// UsageRecordSummaryList is a list of usage record summaries as retrieved
+// from a list endpoint.
+type UsageRecordSummaryList struct {
+	APIResource
+	ListMeta
+	Values []*UsageRecordSummary `json:"data"`
+}
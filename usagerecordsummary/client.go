@@ -0,0 +1,61 @@
+// Package usagerecordsummary provides the /subscription_items/usage_record_summaries APIs
+package usagerecordsummary
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /subscription_items/usage_record_summaries APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// List returns a list of usage record summaries of a subscription item, one
+// per billing period, most recent first.
+// For more details see https://stripe.com/docs/api#usage_record_summary_list.
+func List(params *stripe.UsageRecordSummaryListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.UsageRecordSummaryListParams) *Iter {
+	body := &form.Values{}
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	form.AppendTo(body, params)
+	lp = &params.ListParams
+	p = params.ToParams()
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.UsageRecordSummaryList{}
+		err := c.B.Call("GET", fmt.Sprintf("/subscription_items/%v/usage_record_summaries", params.SubscriptionItem), c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of UsageRecordSummaries.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// UsageRecordSummary returns the most recent UsageRecordSummary
+// visited by a call to Next.
+func (i *Iter) UsageRecordSummary() *stripe.UsageRecordSummary {
+	return i.Current().(*stripe.UsageRecordSummary)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,20 @@
+package usagerecordsummary
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go"
+	_ "github.com/stripe/stripe-go/testing"
+)
+
+func TestUsageRecordSummaryList(t *testing.T) {
+	i := List(&stripe.UsageRecordSummaryListParams{
+		SubscriptionItem: "si_123",
+	})
+
+	// Verify that we can get at least one summary
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.UsageRecordSummary())
+}
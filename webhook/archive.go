@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/stripe/stripe-go"
+)
+
+// EventArchiver is the interface implemented by stores that durably keep the
+// events received by a Router, for instance in a database or an object store,
+// so that they can be audited or replayed later.
+type EventArchiver interface {
+	// Received is called with every event once its signature has been
+	// verified and before it's handled. payload is the body of the request
+	// as it was signed by Stripe. If Received returns an error, the event
+	// isn't handled and Stripe will deliver it again later.
+	Received(event *stripe.Event, payload []byte) error
+
+	// Handled is called once the handler for the event has run, with the
+	// error that it returned, if any. It's also called for events that have
+	// no handler.
+	Handled(event *stripe.Event, handlerErr error) error
+}
+
+// DirArchiver is an EventArchiver that writes every event to a file of its
+// own in a directory. Each file holds the payload of the event, and the
+// outcome of its handling is written next to it.
+type DirArchiver struct {
+	Dir string
+}
+
+// archivedOutcome is the content of the outcome file written by DirArchiver.
+type archivedOutcome struct {
+	Error     string `json:"error,omitempty"`
+	HandledAt int64  `json:"handled_at"`
+}
+
+// Received is the EventArchiver.Received implementation for DirArchiver. It
+// writes the payload of the event to <Dir>/<event ID>.json.
+func (a *DirArchiver) Received(event *stripe.Event, payload []byte) error {
+	return writeFileAtomic(a.path(event, ".json"), payload)
+}
+
+// Handled is the EventArchiver.Handled implementation for DirArchiver. It
+// writes the outcome of the handling of the event to
+// <Dir>/<event ID>.outcome.json.
+func (a *DirArchiver) Handled(event *stripe.Event, handlerErr error) error {
+	outcome := archivedOutcome{HandledAt: time.Now().Unix()}
+	if handlerErr != nil {
+		outcome.Error = handlerErr.Error()
+	}
+
+	data, err := json.Marshal(outcome)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(a.path(event, ".outcome.json"), data)
+}
+
+func (a *DirArchiver) path(event *stripe.Event, suffix string) string {
+	// Event IDs only hold letters, digits and underscores, but make sure an
+	// unexpected one can't escape the directory.
+	return filepath.Join(a.Dir, filepath.Base(event.ID)+suffix)
+}
+
+// writeFileAtomic writes data to a temporary file and then renames it into
+// place so that a crash never leaves a partially written file behind.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
@@ -110,7 +110,7 @@ func ConstructEvent(payload []byte, header string, secret string) (stripe.Event,
 // https://dashboard.stripe.com/webhooks
 //
 func ConstructEventWithTolerance(payload []byte, header string, secret string, tolerance time.Duration) (stripe.Event, error) {
-	return constructEvent(payload, header, secret, tolerance, true)
+	return constructEvent(payload, header, []string{secret}, tolerance, true)
 }
 
 // Initializes an Event object from a JSON webhook payload, validating the
@@ -123,10 +123,21 @@ func ConstructEventWithTolerance(payload []byte, header string, secret string, t
 // https://dashboard.stripe.com/webhooks
 //
 func ConstructEventIgnoringTolerance(payload []byte, header string, secret string) (stripe.Event, error) {
-	return constructEvent(payload, header, secret, 0*time.Second, false)
+	return constructEvent(payload, header, []string{secret}, 0*time.Second, false)
 }
 
-func constructEvent(payload []byte, sigHeader string, secret string, tolerance time.Duration, enforceTolerance bool) (stripe.Event, error) {
+// Initializes an Event object from a JSON webhook payload, validating the
+// Stripe-Signature header against each of the specified signing secrets in
+// turn. This is useful while an endpoint's secret is being rotated and events
+// may be signed with either the old or the new secret. Returns an error if the
+// body or Stripe-Signature header provided are unreadable, if the signature
+// doesn't match any of the secrets, or if the timestamp for the signature is
+// older than DefaultTolerance.
+func ConstructEventWithSecrets(payload []byte, header string, secrets []string) (stripe.Event, error) {
+	return constructEvent(payload, header, secrets, DefaultTolerance, true)
+}
+
+func constructEvent(payload []byte, sigHeader string, secrets []string, tolerance time.Duration, enforceTolerance bool) (stripe.Event, error) {
 	e := stripe.Event{}
 
 	if err := json.Unmarshal(payload, &e); err != nil {
@@ -138,16 +149,19 @@ func constructEvent(payload []byte, sigHeader string, secret string, tolerance t
 		return e, err
 	}
 
-	expectedSignature := computeSignature(header.timestamp, payload, secret)
 	expiredTimestamp := time.Since(header.timestamp) > tolerance
 	if enforceTolerance && expiredTimestamp {
 		return e, ErrTooOld
 	}
 
-	// Check all given v1 signatures, multiple signatures will be sent temporarily in the case of a rolled signature secret
-	for _, sig := range header.signatures {
-		if hmac.Equal(expectedSignature, sig) {
-			return e, nil
+	for _, secret := range secrets {
+		expectedSignature := computeSignature(header.timestamp, payload, secret)
+
+		// Check all given v1 signatures, multiple signatures will be sent temporarily in the case of a rolled signature secret
+		for _, sig := range header.signatures {
+			if hmac.Equal(expectedSignature, sig) {
+				return e, nil
+			}
 		}
 	}
 
@@ -1,11 +1,13 @@
 package webhook_test
 
 import (
+	"database/sql"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 
+	"github.com/stripe/stripe-go"
 	"github.com/stripe/stripe-go/webhook"
 )
 
@@ -32,3 +34,53 @@ func Example() {
 	})
 	log.Fatal(http.ListenAndServe(":8080", nil))
 }
+
+// sqlArchiver is an EventArchiver keeping events in a SQL database, in a
+// table created with:
+//
+//	CREATE TABLE webhook_events (
+//	  id text PRIMARY KEY,
+//	  type text NOT NULL,
+//	  payload jsonb NOT NULL,
+//	  handled_at timestamptz,
+//	  error text
+//	);
+type sqlArchiver struct {
+	db *sql.DB
+}
+
+func (a *sqlArchiver) Received(event *stripe.Event, payload []byte) error {
+	// Events may be delivered more than once, so keep the first copy
+	_, err := a.db.Exec(`INSERT INTO webhook_events (id, type, payload) VALUES ($1, $2, $3)
+		ON CONFLICT (id) DO NOTHING`, event.ID, event.Type, payload)
+	return err
+}
+
+func (a *sqlArchiver) Handled(event *stripe.Event, handlerErr error) error {
+	var msg sql.NullString
+	if handlerErr != nil {
+		msg = sql.NullString{String: handlerErr.Error(), Valid: true}
+	}
+
+	_, err := a.db.Exec(`UPDATE webhook_events SET handled_at = now(), error = $2 WHERE id = $1`,
+		event.ID, msg)
+	return err
+}
+
+func ExampleRouter() {
+	db, err := sql.Open("postgres", "postgres://localhost/payments")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	router := webhook.NewRouter("whsec_DaLRHCRs35vEXqOE8uTEAXGLGUOnyaFf")
+	router.Archiver = &sqlArchiver{db: db}
+
+	router.On("charge.succeeded", func(event *stripe.Event) error {
+		log.Printf("Charge %v succeeded", event.GetObjValue("id"))
+		return nil
+	})
+
+	http.Handle("/webhook", router)
+	log.Fatal(http.ListenAndServe(":8080", nil))
+}
@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"sync"
+
+	"github.com/stripe/stripe-go"
+)
+
+// SecretRotation holds the set of signing secrets that incoming events are
+// verified against while the secret of a webhook endpoint is being rotated.
+//
+// A typical rotation adds the secret of a new endpoint (see
+// webhookendpoint.Rotate), waits until events signed with the old secret are
+// no longer being delivered, deletes the old endpoint, and then retires its
+// secret. SecretRotation is safe for concurrent use so secrets may be added
+// and retired while events are being verified.
+type SecretRotation struct {
+	mu      sync.RWMutex
+	secrets []string
+}
+
+// NewSecretRotation returns a SecretRotation accepting the given secrets.
+func NewSecretRotation(secrets ...string) *SecretRotation {
+	return &SecretRotation{secrets: append([]string(nil), secrets...)}
+}
+
+// Add starts accepting events signed with the given secret.
+func (r *SecretRotation) Add(secret string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range r.secrets {
+		if s == secret {
+			return
+		}
+	}
+	r.secrets = append(r.secrets, secret)
+}
+
+// Retire stops accepting events signed with the given secret.
+func (r *SecretRotation) Retire(secret string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	secrets := r.secrets[:0]
+	for _, s := range r.secrets {
+		if s != secret {
+			secrets = append(secrets, s)
+		}
+	}
+	r.secrets = secrets
+}
+
+// Secrets returns the secrets currently being accepted.
+func (r *SecretRotation) Secrets() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]string(nil), r.secrets...)
+}
+
+// ConstructEvent initializes an Event object from a JSON webhook payload,
+// validating the Stripe-Signature header against all the secrets currently
+// being accepted. See ConstructEventWithSecrets.
+func (r *SecretRotation) ConstructEvent(payload []byte, header string) (stripe.Event, error) {
+	return ConstructEventWithSecrets(payload, header, r.Secrets())
+}
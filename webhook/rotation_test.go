@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"testing"
+)
+
+func TestConstructEventWithSecrets(t *testing.T) {
+	p := newSignedPayload()
+
+	_, err := ConstructEventWithSecrets(p.payload, p.header, []string{"whsec_other", p.secret})
+	if err != nil {
+		t.Errorf("Expected signature matching one of the secrets to be valid, got %v", err)
+	}
+
+	_, err = ConstructEventWithSecrets(p.payload, p.header, []string{"whsec_other"})
+	if err != ErrNoValidSignature {
+		t.Errorf("Expected ErrNoValidSignature when no secret matches, got %v", err)
+	}
+
+	_, err = ConstructEventWithSecrets(p.payload, p.header, nil)
+	if err != ErrNoValidSignature {
+		t.Errorf("Expected ErrNoValidSignature without secrets, got %v", err)
+	}
+}
+
+func TestSecretRotation(t *testing.T) {
+	oldPayload := newSignedPayload(func(p *SignedPayload) {
+		p.secret = "whsec_old"
+	})
+	newPayload := newSignedPayload(func(p *SignedPayload) {
+		p.secret = "whsec_new"
+	})
+
+	r := NewSecretRotation("whsec_old")
+	if _, err := r.ConstructEvent(newPayload.payload, newPayload.header); err != ErrNoValidSignature {
+		t.Errorf("Expected new secret to be rejected before being added, got %v", err)
+	}
+
+	r.Add("whsec_new")
+	r.Add("whsec_new")
+	if len(r.Secrets()) != 2 {
+		t.Errorf("Expected two secrets, got %v", r.Secrets())
+	}
+	if _, err := r.ConstructEvent(oldPayload.payload, oldPayload.header); err != nil {
+		t.Errorf("Expected old secret to be accepted during rollover, got %v", err)
+	}
+	if _, err := r.ConstructEvent(newPayload.payload, newPayload.header); err != nil {
+		t.Errorf("Expected new secret to be accepted during rollover, got %v", err)
+	}
+
+	r.Retire("whsec_old")
+	if _, err := r.ConstructEvent(oldPayload.payload, oldPayload.header); err != ErrNoValidSignature {
+		t.Errorf("Expected old secret to be rejected once retired, got %v", err)
+	}
+	if _, err := r.ConstructEvent(newPayload.payload, newPayload.header); err != nil {
+		t.Errorf("Expected new secret to be accepted once old one is retired, got %v", err)
+	}
+}
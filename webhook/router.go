@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/stripe/stripe-go"
+)
+
+// HandlerFunc handles a verified event.
+type HandlerFunc func(event *stripe.Event) error
+
+// Middleware wraps the handling of every event by a Router, like to log it,
+// measure it or trace it. It's given the next step of the handling, which it
+// must call for the event to be handled, and returns whether it succeeded.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Router is an http.Handler that verifies incoming webhook events and
+// dispatches them to the handler registered for their type. Events without
+// a handler are acknowledged and otherwise ignored.
+//
+// When a handler returns an error, Router responds with a 500 so that Stripe
+// delivers the event again later.
+type Router struct {
+	// Archiver, if set, is given every event after it has been verified. See
+	// EventArchiver.
+	Archiver EventArchiver
+
+	// Secrets holds the signing secrets that events are verified against.
+	Secrets *SecretRotation
+
+	handlers   map[string]HandlerFunc
+	limits     map[string]chan struct{}
+	middleware []Middleware
+	mu         sync.RWMutex
+}
+
+// NewRouter returns a Router verifying events against the given secrets.
+func NewRouter(secrets ...string) *Router {
+	return &Router{Secrets: NewSecretRotation(secrets...)}
+}
+
+// On registers the handler for events of the given type, like
+// "charge.succeeded". It replaces any handler previously registered for that
+// type.
+func (r *Router) On(eventType string, h HandlerFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.handlers == nil {
+		r.handlers = make(map[string]HandlerFunc)
+	}
+	r.handlers[eventType] = h
+}
+
+// Use adds middleware that wraps the handling of every event, including
+// events without a handler. The middleware added first is the outermost, so
+// it sees the event first and the outcome last.
+func (r *Router) Use(mw ...Middleware) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.middleware = append(r.middleware, mw...)
+}
+
+// Limit caps to n the number of events of the given type that are handled at
+// the same time, so that a flood of one type of event, like
+// "invoice.payment_succeeded" during a billing run, can't take all the
+// resources that the handlers of other types need. Events over the limit
+// wait for one to be handled, and are refused with a 503 so that they're
+// delivered again later if Stripe gives up on the request in the meantime.
+// A limit of 0 removes the cap.
+//
+// Limit must be called before the router starts serving events of that type.
+func (r *Router) Limit(eventType string, n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if n <= 0 {
+		delete(r.limits, eventType)
+		return
+	}
+
+	if r.limits == nil {
+		r.limits = make(map[string]chan struct{})
+	}
+	r.limits[eventType] = make(chan struct{}, n)
+}
+
+// ServeHTTP verifies the event in the body of req and dispatches it.
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	defer req.Body.Close()
+
+	payload, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	event, err := r.Secrets.ConstructEvent(payload, req.Header.Get("Stripe-Signature"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	r.mu.RLock()
+	h := r.handlers[event.Type]
+	limit := r.limits[event.Type]
+	middleware := r.middleware
+	r.mu.RUnlock()
+
+	if limit != nil {
+		select {
+		case limit <- struct{}{}:
+			defer func() { <-limit }()
+		case <-req.Context().Done():
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	if r.Archiver != nil {
+		// An event that couldn't be archived is refused so that it's delivered
+		// again rather than lost.
+		if err := r.Archiver.Received(&event, payload); err != nil {
+			if stripe.LogLevel > 0 {
+				stripe.Logger.Printf("Cannot archive event %v: %v\n", event.ID, err)
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if h == nil {
+		h = func(event *stripe.Event) error { return nil }
+	}
+	for i := len(middleware) - 1; i >= 0; i-- {
+		h = middleware[i](h)
+	}
+
+	handlerErr := h(&event)
+
+	if r.Archiver != nil {
+		// The event was already handled, so failing to record the outcome
+		// isn't a reason to have it delivered again.
+		if err := r.Archiver.Handled(&event, handlerErr); err != nil && stripe.LogLevel > 0 {
+			stripe.Logger.Printf("Cannot archive the outcome of event %v: %v\n", event.ID, err)
+		}
+	}
+
+	if handlerErr != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,214 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go"
+)
+
+// recordingArchiver is an archiver that records the calls made to it.
+type recordingArchiver struct {
+	handled    []string
+	handlerErr error
+	received   []string
+	receiveErr error
+}
+
+func (a *recordingArchiver) Received(event *stripe.Event, payload []byte) error {
+	a.received = append(a.received, event.ID)
+	return a.receiveErr
+}
+
+func (a *recordingArchiver) Handled(event *stripe.Event, handlerErr error) error {
+	a.handled = append(a.handled, event.ID)
+	a.handlerErr = handlerErr
+	return nil
+}
+
+var routerPayload = []byte(`{
+  "id": "evt_test_webhook",
+  "object": "event",
+  "type": "charge.succeeded"
+}`)
+
+func serveSigned(r *Router, secret string) *httptest.ResponseRecorder {
+	p := newSignedPayload(func(p *SignedPayload) {
+		p.payload = routerPayload
+		p.secret = secret
+	})
+
+	req := httptest.NewRequest("POST", "/webhook", bytes.NewReader(p.payload))
+	req.Header.Set("Stripe-Signature", p.header)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestRouter(t *testing.T) {
+	archiver := &recordingArchiver{}
+	r := NewRouter(testSecret)
+	r.Archiver = archiver
+
+	var handled []string
+	r.On("charge.succeeded", func(event *stripe.Event) error {
+		handled = append(handled, event.ID)
+		return nil
+	})
+
+	w := serveSigned(r, testSecret)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"evt_test_webhook"}, handled)
+	assert.Equal(t, []string{"evt_test_webhook"}, archiver.received)
+	assert.Equal(t, []string{"evt_test_webhook"}, archiver.handled)
+	assert.Nil(t, archiver.handlerErr)
+}
+
+func TestRouterHandlerError(t *testing.T) {
+	archiver := &recordingArchiver{}
+	r := NewRouter(testSecret)
+	r.Archiver = archiver
+
+	handlerErr := errors.New("database unavailable")
+	r.On("charge.succeeded", func(event *stripe.Event) error {
+		return handlerErr
+	})
+
+	w := serveSigned(r, testSecret)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, handlerErr, archiver.handlerErr)
+}
+
+func TestRouterInvalidSignature(t *testing.T) {
+	archiver := &recordingArchiver{}
+	r := NewRouter(testSecret)
+	r.Archiver = archiver
+
+	w := serveSigned(r, "whsec_other_secret")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, 0, len(archiver.received))
+}
+
+func TestRouterReceiveError(t *testing.T) {
+	archiver := &recordingArchiver{receiveErr: errors.New("disk full")}
+	r := NewRouter(testSecret)
+	r.Archiver = archiver
+
+	called := false
+	r.On("charge.succeeded", func(event *stripe.Event) error {
+		called = true
+		return nil
+	})
+
+	// The event must not be handled unless it was archived
+	w := serveSigned(r, testSecret)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.False(t, called)
+	assert.Equal(t, 0, len(archiver.handled))
+}
+
+func TestRouterLimit(t *testing.T) {
+	r := NewRouter(testSecret)
+	r.Limit("charge.succeeded", 1)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	r.On("charge.succeeded", func(event *stripe.Event) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	})
+
+	first := make(chan int)
+	go func() { first <- serveSigned(r, testSecret).Code }()
+	<-started
+
+	// A second event of the same type waits for the first one, and is refused
+	// if the request is abandoned in the meantime
+	p := newSignedPayload(func(p *SignedPayload) {
+		p.payload = routerPayload
+		p.secret = testSecret
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest("POST", "/webhook", bytes.NewReader(p.payload)).WithContext(ctx)
+	req.Header.Set("Stripe-Signature", p.header)
+	cancel()
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	close(release)
+	assert.Equal(t, http.StatusOK, <-first)
+}
+
+func TestRouterUse(t *testing.T) {
+	r := NewRouter(testSecret)
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next HandlerFunc) HandlerFunc {
+			return func(event *stripe.Event) error {
+				calls = append(calls, name+" before "+event.Type)
+				err := next(event)
+				calls = append(calls, name+" after")
+				return err
+			}
+		}
+	}
+	r.Use(trace("outer"), trace("inner"))
+
+	// Middleware also sees events without a handler
+	w := serveSigned(r, testSecret)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{
+		"outer before charge.succeeded",
+		"inner before charge.succeeded",
+		"inner after",
+		"outer after",
+	}, calls)
+
+	calls = nil
+	r.On("charge.succeeded", func(event *stripe.Event) error {
+		calls = append(calls, "handler")
+		return errors.New("database unavailable")
+	})
+
+	w = serveSigned(r, testSecret)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, []string{
+		"outer before charge.succeeded",
+		"inner before charge.succeeded",
+		"handler",
+		"inner after",
+		"outer after",
+	}, calls)
+}
+
+func TestDirArchiver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	r := NewRouter(testSecret)
+	r.Archiver = &DirArchiver{Dir: dir}
+
+	w := serveSigned(r, testSecret)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	payload, err := ioutil.ReadFile(filepath.Join(dir, "evt_test_webhook.json"))
+	assert.NoError(t, err)
+	assert.Equal(t, routerPayload, payload)
+
+	_, err = os.Stat(filepath.Join(dir, "evt_test_webhook.outcome.json"))
+	assert.NoError(t, err)
+}
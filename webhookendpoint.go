@@ -0,0 +1,74 @@
+package stripe
+
+import "encoding/json"
+
+// WebhookEndpointParams is the set of parameters that can be used when
+// creating or updating a webhook endpoint. APIVersion and Connect can only be
+// set when the endpoint is created, and Disabled only when it's updated.
+// For more details see https://stripe.com/docs/api#create_webhook_endpoint.
+type WebhookEndpointParams struct {
+	Params        `form:"*"`
+	APIVersion    string   `form:"api_version"`
+	Connect       bool     `form:"connect"`
+	Desc          string   `form:"description"`
+	Disabled      bool     `form:"disabled"`
+	EnabledEvents []string `form:"enabled_events"`
+	URL           string   `form:"url"`
+}
+
+// WebhookEndpointListParams is the set of parameters that can be used when
+// listing webhook endpoints.
+// For more details see https://stripe.com/docs/api#list_webhook_endpoints.
+type WebhookEndpointListParams struct {
+	ListParams `form:"*"`
+}
+
+// WebhookEndpoint is the resource representing a Stripe webhook endpoint.
+// For more details see https://stripe.com/docs/api#webhook_endpoints.
+type WebhookEndpoint struct {
+	APIResource
+	APIVersion    string    `json:"api_version"`
+	Application   string    `json:"application"`
+	Connect       bool      `json:"connect"`
+	Created       Timestamp `json:"created"`
+	Deleted       bool      `json:"deleted"`
+	Desc          string    `json:"description"`
+	EnabledEvents []string  `json:"enabled_events"`
+	ID            string    `json:"id"`
+	Live          bool      `json:"livemode"`
+
+	// Secret is the endpoint's signing secret. It's only returned when the
+	// endpoint is created.
+	Secret string `json:"secret"`
+
+	Status string `json:"status"`
+	URL    string `json:"url"`
+}
+
+// WebhookEndpointList is a list of webhook endpoints as retrieved from a list
+// endpoint.
+type WebhookEndpointList struct {
+	APIResource
+	ListMeta
+	Values []*WebhookEndpoint `json:"data"`
+}
+
+// UnmarshalJSON handles deserialization of a WebhookEndpoint.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (w *WebhookEndpoint) UnmarshalJSON(data []byte) error {
+	if id, ok := parseID(data); ok {
+		w.ID = id
+		return nil
+	}
+
+	type webhookEndpoint WebhookEndpoint
+	var ww webhookEndpoint
+	err := json.Unmarshal(data, &ww)
+	if err != nil {
+		return err
+	}
+
+	*w = WebhookEndpoint(ww)
+	return nil
+}
@@ -0,0 +1,192 @@
+// Package webhookendpoint provides the /webhook_endpoints APIs
+package webhookendpoint
+
+import (
+	"fmt"
+
+	stripe "github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/form"
+)
+
+// Client is used to invoke /webhook_endpoints APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new webhook endpoint.
+// For more details see https://stripe.com/docs/api#create_webhook_endpoint.
+func New(params *stripe.WebhookEndpointParams) (*stripe.WebhookEndpoint, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.WebhookEndpointParams) (*stripe.WebhookEndpoint, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	webhookEndpoint := &stripe.WebhookEndpoint{}
+	err := c.B.Call("POST", "/webhook_endpoints", c.Key, body, commonParams, webhookEndpoint)
+
+	return webhookEndpoint, err
+}
+
+// Get returns the details of a webhook endpoint.
+// For more details see https://stripe.com/docs/api#retrieve_webhook_endpoint.
+func Get(id string, params *stripe.WebhookEndpointParams) (*stripe.WebhookEndpoint, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.WebhookEndpointParams) (*stripe.WebhookEndpoint, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	webhookEndpoint := &stripe.WebhookEndpoint{}
+	err := c.B.Call("GET", fmt.Sprintf("/webhook_endpoints/%v", id), c.Key, body, commonParams, webhookEndpoint)
+
+	return webhookEndpoint, err
+}
+
+// Update updates a webhook endpoint's properties.
+// For more details see https://stripe.com/docs/api#update_webhook_endpoint.
+func Update(id string, params *stripe.WebhookEndpointParams) (*stripe.WebhookEndpoint, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.WebhookEndpointParams) (*stripe.WebhookEndpoint, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	webhookEndpoint := &stripe.WebhookEndpoint{}
+	err := c.B.Call("POST", fmt.Sprintf("/webhook_endpoints/%v", id), c.Key, body, commonParams, webhookEndpoint)
+
+	return webhookEndpoint, err
+}
+
+// Del removes a webhook endpoint.
+// For more details see https://stripe.com/docs/api#delete_webhook_endpoint.
+func Del(id string, params *stripe.WebhookEndpointParams) (*stripe.WebhookEndpoint, error) {
+	return getC().Del(id, params)
+}
+
+func (c Client) Del(id string, params *stripe.WebhookEndpointParams) (*stripe.WebhookEndpoint, error) {
+	var body *form.Values
+	var commonParams *stripe.Params
+
+	if params != nil {
+		commonParams = &params.Params
+		body = &form.Values{}
+		form.AppendTo(body, params)
+	}
+
+	webhookEndpoint := &stripe.WebhookEndpoint{}
+	err := c.B.Call("DELETE", fmt.Sprintf("/webhook_endpoints/%v", id), c.Key, body, commonParams, webhookEndpoint)
+
+	return webhookEndpoint, err
+}
+
+// Rotate creates a new webhook endpoint configured like the existing one with
+// the given ID so that events start being signed with a new secret, which is
+// available on the returned endpoint. Values set on params override the ones
+// copied from the existing endpoint.
+//
+// Both endpoints receive every event until the old one is retired with Del,
+// so incoming events should be verified against both secrets in the meantime
+// (see webhook.SecretRotation) and deduplicated by their event ID.
+func Rotate(id string, params *stripe.WebhookEndpointParams) (*stripe.WebhookEndpoint, error) {
+	return getC().Rotate(id, params)
+}
+
+func (c Client) Rotate(id string, params *stripe.WebhookEndpointParams) (*stripe.WebhookEndpoint, error) {
+	old, err := c.Get(id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p := stripe.WebhookEndpointParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.APIVersion == "" {
+		p.APIVersion = old.APIVersion
+	}
+	if !p.Connect {
+		p.Connect = old.Connect
+	}
+	if len(p.EnabledEvents) == 0 {
+		p.EnabledEvents = old.EnabledEvents
+	}
+	if p.Desc == "" {
+		p.Desc = old.Desc
+	}
+	if p.URL == "" {
+		p.URL = old.URL
+	}
+
+	return c.New(&p)
+}
+
+// List returns a list of webhook endpoints.
+// For more details see https://stripe.com/docs/api#list_webhook_endpoints.
+func List(params *stripe.WebhookEndpointListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.WebhookEndpointListParams) *Iter {
+	var body *form.Values
+	var lp *stripe.ListParams
+	var p *stripe.Params
+
+	if params != nil {
+		body = &form.Values{}
+		form.AppendTo(body, params)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b *form.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.WebhookEndpointList{}
+		err := c.B.Call("GET", "/webhook_endpoints", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// Iter is an iterator for lists of WebhookEndpoints.
+// The embedded Iter carries methods with it;
+// see its documentation for details.
+type Iter struct {
+	*stripe.Iter
+}
+
+// WebhookEndpoint returns the most recent WebhookEndpoint
+// visited by a call to Next.
+func (i *Iter) WebhookEndpoint() *stripe.WebhookEndpoint {
+	return i.Current().(*stripe.WebhookEndpoint)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}